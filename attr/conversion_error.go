@@ -0,0 +1,58 @@
+package attr
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ConversionError is returned when a value can't be converted between a Go
+// value and a Value, like when State.Get can't store an attribute in a
+// struct field, or State.Set can't build an attribute from one. Use
+// errors.As to get it from the errors returned:
+//
+//	var convErr attr.ConversionError
+//	if errors.As(err, &convErr) {
+//		// convErr.FieldPath is the attribute that couldn't be converted
+//	}
+type ConversionError struct {
+	// FieldPath is the path of the value that couldn't be converted,
+	// relative to the value being converted. It is never nil.
+	FieldPath *tftypes.AttributePath
+
+	// GoType is the type of the Go value being converted to or from, or
+	// nil if the error isn't about a particular Go type.
+	GoType reflect.Type
+
+	// AttrType is the Type the value is converted with, or nil if the
+	// error isn't about a particular Type.
+	AttrType Type
+
+	// Err describes what went wrong.
+	Err error
+}
+
+// Error returns Err prefixed with FieldPath, the same way a
+// tftypes.AttributePathError is formatted.
+func (e ConversionError) Error() string {
+	return e.pathError().Error()
+}
+
+// Unwrap returns a tftypes.AttributePathError holding FieldPath and wrapping
+// Err, so errors.As can find the path of the value like it can for other
+// errors with paths. If FieldPath is empty, it returns Err, so the error can
+// still be given a path by tftypes.AttributePath.NewError.
+func (e ConversionError) Unwrap() error {
+	if e.FieldPath == nil || len(e.FieldPath.Steps()) == 0 {
+		return e.Err
+	}
+	return e.pathError()
+}
+
+func (e ConversionError) pathError() error {
+	path := e.FieldPath
+	if path == nil {
+		path = tftypes.NewAttributePath()
+	}
+	return path.NewError(e.Err)
+}
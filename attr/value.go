@@ -15,4 +15,54 @@ type Value interface {
 	// Equal must return true if the Value is considered semantically equal
 	// to the Value passed as an argument.
 	Equal(Value) bool
+
+	// IsNull returns true if the Value is null, so code handling values
+	// of any type can check for null values without a type switch.
+	IsNull() bool
+
+	// IsUnknown returns true if the Value is not yet known. Values holding
+	// unknown values, like a known list with an unknown element, are not
+	// unknown themselves.
+	IsUnknown() bool
+}
+
+// ValueWithType extends the Value interface to include a Type method, used
+// to retrieve the Type that produces the Value. Values implementing it can be
+// used as struct fields or other reflection targets even when the Type in the
+// schema is a different Type with the same Terraform type, allowing custom
+// Values to be used wherever the types package's Values can be.
+type ValueWithType interface {
+	Value
+
+	// Type returns the Type that produces the Value. It must be safe to
+	// call on the zero value of the Value.
+	Type(context.Context) Type
+}
+
+// ValueWithHash extends the Value interface to include a Hash method, used
+// to key maps and caches by Values. Values that don't implement it can be
+// hashed from their structure using the valuehash package.
+type ValueWithHash interface {
+	Value
+
+	// Hash returns a hash of the Value. Values that are Equal must
+	// return the same hash.
+	Hash(context.Context) (uint64, error)
+}
+
+// ValueWithSemanticEquals extends the Value interface to include a
+// SemanticEquals method, for Values with more than one representation of the
+// same data, like JSON documents that differ only in formatting, or strings
+// compared without regard to case. When a resource's Read, Create, or Update
+// method returns a Value that isn't Equal to the one in the prior state or
+// plan, the framework keeps the prior Value if SemanticEquals says they mean
+// the same thing, so the difference doesn't show up as a change.
+type ValueWithSemanticEquals interface {
+	Value
+
+	// SemanticEquals returns true if the Value means the same thing as
+	// the prior Value passed as an argument, which is a known, non-null
+	// Value of the same Type. It is only called for known, non-null
+	// Values.
+	SemanticEquals(context.Context, Value) (bool, error)
 }
@@ -0,0 +1,110 @@
+// Package valueconvert provides helpers for converting attr.Values between
+// attr.Types that share a Terraform type, like converting a types.String to a
+// custom string type, so custom types can be introduced into existing schemas
+// gradually.
+package valueconvert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Convert returns `value` as a value of `typ`. The Terraform type of `value`
+// must be the same as the Terraform type of `typ`. Null and unknown values
+// stay null and unknown.
+//
+// If `typ` is an attr.TypeWithValidate, the value is validated before it is
+// converted, and an error is returned if validation returns any error
+// diagnostics. Warning diagnostics are ignored.
+//
+// The Terraform type of `value` can only be checked for the values in the
+// types package and values that implement attr.ValueWithType. Other values
+// are only checked by making sure their Terraform representation is valid
+// for `typ`, which means null values of them can be converted to any type.
+func Convert(ctx context.Context, value attr.Value, typ attr.Type) (attr.Value, error) {
+	if value == nil {
+		return nil, fmt.Errorf("can't convert nil to %T", typ)
+	}
+	if typ == nil {
+		return nil, fmt.Errorf("can't convert %T to a nil type", value)
+	}
+	tfType := typ.TerraformType(ctx)
+	if fromType := terraformType(ctx, value); fromType != nil && !fromType.Is(tfType) {
+		return nil, fmt.Errorf("can't convert %T to %T, %s is not %s", value, typ, fromType, tfType)
+	}
+
+	raw, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error running ToTerraformValue on %T: %w", value, err)
+	}
+	tfValue, err := reflect.NewTerraformValue(tfType, raw)
+	if err != nil {
+		return nil, fmt.Errorf("can't convert %T to %T: %w", value, typ, err)
+	}
+
+	if t, ok := typ.(attr.TypeWithValidate); ok {
+		if err := diagnosticsError(t.Validate(ctx, tfValue)); err != nil {
+			return nil, fmt.Errorf("value is not valid for %T: %w", typ, err)
+		}
+	}
+
+	res, err := typ.ValueFromTerraform(ctx, tfValue)
+	if err != nil {
+		return nil, fmt.Errorf("error converting %T to %T: %w", value, typ, err)
+	}
+	return res, nil
+}
+
+// terraformType returns the Terraform type of `value`, or nil if it can't be
+// determined.
+func terraformType(ctx context.Context, value attr.Value) tftypes.Type {
+	switch v := value.(type) {
+	case types.String:
+		return tftypes.String
+	case types.Bool:
+		return tftypes.Bool
+	case types.Number:
+		return tftypes.Number
+	case types.List:
+		return types.ListType{ElemType: v.ElemType}.TerraformType(ctx)
+	case types.Map:
+		return types.MapType{ElemType: v.ElemType}.TerraformType(ctx)
+	case types.Object:
+		return types.ObjectType{AttrTypes: v.AttrTypes}.TerraformType(ctx)
+	case attr.ValueWithType:
+		if typ := v.Type(ctx); typ != nil {
+			return typ.TerraformType(ctx)
+		}
+	}
+	return nil
+}
+
+// diagnosticsError returns an error describing the error diagnostics in
+// `diags`, or nil if there aren't any.
+func diagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	var msgs []string
+	for _, diag := range diags {
+		if diag == nil || diag.Severity != tfprotov6.DiagnosticSeverityError {
+			continue
+		}
+		msg := diag.Summary
+		if diag.Detail != "" {
+			msg += ": " + diag.Detail
+		}
+		if diag.Attribute != nil && len(diag.Attribute.Steps()) > 0 {
+			msg = diag.Attribute.String() + ": " + msg
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(msgs, "; "))
+}
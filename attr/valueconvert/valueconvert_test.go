@@ -0,0 +1,144 @@
+package valueconvert_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/valueconvert"
+	"github.com/hashicorp/terraform-plugin-framework/testing/attrmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	lowercaseType := attrmock.Type{
+		ValidateFunc: func(_ context.Context, in tftypes.Value) []*tfprotov6.Diagnostic {
+			var s string
+			if !in.IsKnown() || in.IsNull() {
+				return nil
+			}
+			if err := in.As(&s); err != nil {
+				return []*tfprotov6.Diagnostic{{Severity: tfprotov6.DiagnosticSeverityError, Summary: err.Error()}}
+			}
+			if s != strings.ToLower(s) {
+				return []*tfprotov6.Diagnostic{{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Invalid value",
+					Detail:   "must be lowercase",
+				}}
+			}
+			return []*tfprotov6.Diagnostic{{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "Ignored warning",
+			}}
+		},
+	}
+
+	type testCase struct {
+		value         attr.Value
+		typ           attr.Type
+		expectedRaw   interface{}
+		expected      attr.Value
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"string-to-custom": {
+			value:       types.String{Value: "hello"},
+			typ:         lowercaseType,
+			expectedRaw: "hello",
+		},
+		"null-string-to-custom": {
+			value:       types.String{Null: true},
+			typ:         lowercaseType,
+			expectedRaw: nil,
+		},
+		"unknown-string-to-custom": {
+			value:       types.String{Unknown: true},
+			typ:         lowercaseType,
+			expectedRaw: tftypes.UnknownValue,
+		},
+		"custom-to-string": {
+			value:    attrmock.Value{MockType: lowercaseType, Raw: "hello"},
+			typ:      types.StringType,
+			expected: types.String{Value: "hello"},
+		},
+		"object-to-object": {
+			value: types.Object{
+				AttrTypes: map[string]attr.Type{"size": types.NumberType},
+				Attrs:     map[string]attr.Value{"size": types.Number{Value: big.NewFloat(2)}},
+			},
+			typ: types.ObjectType{AttrTypes: map[string]attr.Type{"size": types.NumberType}},
+			expected: types.Object{
+				AttrTypes: map[string]attr.Type{"size": types.NumberType},
+				Attrs:     map[string]attr.Value{"size": types.Number{Value: big.NewFloat(2)}},
+			},
+		},
+		"dynamic-to-dynamic": {
+			value:    types.NewDynamic(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a")})),
+			typ:      types.DynamicType,
+			expected: types.NewDynamic(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a")})),
+		},
+		"invalid": {
+			value:         types.String{Value: "Hello"},
+			typ:           lowercaseType,
+			expectedError: "value is not valid for attrmock.Type: Invalid value: must be lowercase",
+		},
+		"mismatched-types": {
+			value:         types.String{Value: "hello"},
+			typ:           types.NumberType,
+			expectedError: "can't convert types.String to types.primitive, tftypes.String is not tftypes.Number",
+		},
+		"mismatched-null": {
+			value:         types.String{Null: true},
+			typ:           types.BoolType,
+			expectedError: "can't convert types.String to types.primitive, tftypes.String is not tftypes.Bool",
+		},
+		"nil": {
+			value:         nil,
+			typ:           types.StringType,
+			expectedError: "can't convert nil to types.primitive",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := valueconvert.Convert(context.Background(), tc.value, tc.typ)
+			if tc.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error %q, got none", tc.expectedError)
+				}
+				if err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %q", tc.expectedError, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if tc.expected != nil {
+				if diff := cmp.Diff(got, tc.expected); diff != "" {
+					t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+				}
+				return
+			}
+			mockVal, ok := got.(attrmock.Value)
+			if !ok {
+				t.Fatalf("Expected an attrmock.Value, got %T", got)
+			}
+			if diff := cmp.Diff(mockVal.Raw, tc.expectedRaw); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Package valuecopy provides helpers for copying attr.Values, so the copies can
+// be modified without affecting the values they were copied from.
+package valuecopy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DeepCopy returns a copy of `value` that shares no maps, slices, or pointers
+// with `value`, recursing into the elements of lists and maps and the
+// attributes of objects. Modifying the copy, or any value nested in it, will
+// not modify `value`.
+//
+// The values in the types package are copied directly. Other values must
+// implement attr.ValueWithType, and are copied by converting them to their
+// Terraform representation and back using their type.
+func DeepCopy(ctx context.Context, value attr.Value) (attr.Value, error) {
+	return deepCopy(ctx, value, tftypes.NewAttributePath())
+}
+
+func deepCopy(ctx context.Context, value attr.Value, path *tftypes.AttributePath) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case types.String, types.Bool, types.Int64, types.Float64:
+		// these don't hold any references, so they're copied by
+		// being passed by value
+		return v, nil
+	case types.Dynamic:
+		// tftypes.Values can't be modified once they're created, so
+		// the copy can share them
+		return v, nil
+	case types.Number:
+		if v.Value != nil {
+			v.Value = new(big.Float).Copy(v.Value)
+		}
+		return v, nil
+	case types.List:
+		v.ElemType = copyType(v.ElemType)
+		if v.Elems == nil {
+			return v, nil
+		}
+		elems := make([]attr.Value, 0, len(v.Elems))
+		for pos, elem := range v.Elems {
+			c, err := deepCopy(ctx, elem, path.WithElementKeyInt(int64(pos)))
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, c)
+		}
+		v.Elems = elems
+		return v, nil
+	case types.Map:
+		v.ElemType = copyType(v.ElemType)
+		if v.Elems == nil {
+			return v, nil
+		}
+		elems := make(map[string]attr.Value, len(v.Elems))
+		for key, elem := range v.Elems {
+			c, err := deepCopy(ctx, elem, path.WithElementKeyString(key))
+			if err != nil {
+				return nil, err
+			}
+			elems[key] = c
+		}
+		v.Elems = elems
+		return v, nil
+	case types.Object:
+		v.AttrTypes = copyAttrTypes(v.AttrTypes)
+		if v.Attrs == nil {
+			return v, nil
+		}
+		attrs := make(map[string]attr.Value, len(v.Attrs))
+		for name, a := range v.Attrs {
+			c, err := deepCopy(ctx, a, path.WithAttributeName(name))
+			if err != nil {
+				return nil, err
+			}
+			attrs[name] = c
+		}
+		v.Attrs = attrs
+		return v, nil
+	case attr.ValueWithType:
+		return copyViaTerraform(ctx, v, path)
+	default:
+		return nil, path.NewErrorf("can't copy %T, it must be a value from the types package or implement attr.ValueWithType", value)
+	}
+}
+
+// copyViaTerraform copies `value` by converting it to a tftypes.Value and
+// converting that back into an attr.Value using the value's type.
+func copyViaTerraform(ctx context.Context, value attr.ValueWithType, path *tftypes.AttributePath) (attr.Value, error) {
+	typ := value.Type(ctx)
+	if typ == nil {
+		return nil, path.NewErrorf("can't copy %T, its Type method returned nil", value)
+	}
+	raw, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, path.NewError(err)
+	}
+	tfType := typ.TerraformType(ctx)
+	tfVal, err := reflect.NewTerraformValue(tfType, raw)
+	if err != nil {
+		return nil, path.NewError(err)
+	}
+	res, err := typ.ValueFromTerraform(ctx, tfVal)
+	if err != nil {
+		return nil, path.NewError(fmt.Errorf("error copying %T: %w", value, err))
+	}
+	return res, nil
+}
+
+// copyType returns a copy of `typ` that shares no maps with it. Types other
+// than the types package's collection types hold no references, and are
+// returned as-is.
+func copyType(typ attr.Type) attr.Type {
+	switch t := typ.(type) {
+	case types.ListType:
+		t.ElemType = copyType(t.ElemType)
+		return t
+	case types.MapType:
+		t.ElemType = copyType(t.ElemType)
+		return t
+	case types.ObjectType:
+		t.AttrTypes = copyAttrTypes(t.AttrTypes)
+		return t
+	default:
+		return typ
+	}
+}
+
+// copyAttrTypes returns a copy of `in`, copying each of its types with
+// copyType.
+func copyAttrTypes(in map[string]attr.Type) map[string]attr.Type {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]attr.Type, len(in))
+	for name, typ := range in {
+		out[name] = copyType(typ)
+	}
+	return out
+}
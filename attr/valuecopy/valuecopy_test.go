@@ -0,0 +1,182 @@
+package valuecopy_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/valuecopy"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDeepCopy(t *testing.T) {
+	t.Parallel()
+
+	original := types.Object{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"size": types.NumberType,
+			"tags": types.MapType{ElemType: types.StringType},
+			"disks": types.ListType{ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id": types.StringType,
+				},
+			}},
+		},
+		Attrs: map[string]attr.Value{
+			"name": types.String{Value: "hello"},
+			"size": types.Number{Value: big.NewFloat(10)},
+			"tags": types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"env": types.String{Value: "prod"},
+				},
+			},
+			"disks": types.List{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"id": types.StringType,
+					},
+				},
+				Elems: []attr.Value{
+					types.Object{
+						AttrTypes: map[string]attr.Type{
+							"id": types.StringType,
+						},
+						Attrs: map[string]attr.Value{
+							"id": types.String{Value: "disk-1"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got, err := valuecopy.DeepCopy(context.Background(), original)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(original, got); diff != "" {
+		t.Fatalf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// modify everything we can reach in the copy, and make sure the
+	// original doesn't change
+	copied := got.(types.Object)
+	copied.Attrs["name"] = types.String{Value: "goodbye"}
+	copied.Attrs["size"].(types.Number).Value.SetInt64(20)
+	copied.Attrs["tags"].(types.Map).Elems["env"] = types.String{Value: "dev"}
+	copied.Attrs["disks"].(types.List).Elems[0].(types.Object).Attrs["id"] = types.String{Value: "disk-2"}
+	copied.AttrTypes["name"] = types.NumberType
+
+	if !original.Attrs["name"].Equal(types.String{Value: "hello"}) {
+		t.Errorf("Copy shares attributes with the original: %s", original.Attrs["name"])
+	}
+	if !original.Attrs["size"].Equal(types.Number{Value: big.NewFloat(10)}) {
+		t.Errorf("Copy shares numbers with the original: %s", original.Attrs["size"])
+	}
+	if !original.Attrs["tags"].(types.Map).Elems["env"].Equal(types.String{Value: "prod"}) {
+		t.Errorf("Copy shares map elements with the original: %s", original.Attrs["tags"])
+	}
+	if !original.Attrs["disks"].(types.List).Elems[0].(types.Object).Attrs["id"].Equal(types.String{Value: "disk-1"}) {
+		t.Errorf("Copy shares list elements with the original: %s", original.Attrs["disks"])
+	}
+	if original.AttrTypes["name"] != types.StringType {
+		t.Errorf("Copy shares attribute types with the original: %s", original.AttrTypes["name"])
+	}
+}
+
+func TestDeepCopy_nullAndUnknown(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]attr.Value{
+		"null-list":      types.List{ElemType: types.StringType, Null: true},
+		"unknown-map":    types.Map{ElemType: types.StringType, Unknown: true},
+		"null-object":    types.Object{AttrTypes: map[string]attr.Type{"a": types.StringType}, Null: true},
+		"unknown-number": types.Number{Unknown: true},
+	}
+	for name, value := range tests {
+		name, value := name, value
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := valuecopy.DeepCopy(context.Background(), value)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(value, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeepCopy_primitives(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]attr.Value{
+		"int64":           types.Int64{Value: 42},
+		"null-int64":      types.Int64{Null: true},
+		"unknown-int64":   types.Int64{Unknown: true},
+		"float64":         types.Float64{Value: 1.5},
+		"null-float64":    types.Float64{Null: true},
+		"unknown-float64": types.Float64{Unknown: true},
+		"dynamic": types.NewDynamic(tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "hello"),
+		})),
+		"null-dynamic":    types.DynamicNull(),
+		"unknown-dynamic": types.DynamicUnknown(),
+	}
+	for name, value := range tests {
+		name, value := name, value
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := valuecopy.DeepCopy(context.Background(), value)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(value, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+type unsupportedValue struct{}
+
+func (u unsupportedValue) ToTerraformValue(_ context.Context) (interface{}, error) {
+	return nil, nil
+}
+
+func (u unsupportedValue) Equal(o attr.Value) bool {
+	_, ok := o.(unsupportedValue)
+	return ok
+}
+
+func (u unsupportedValue) IsNull() bool {
+	return true
+}
+
+func (u unsupportedValue) IsUnknown() bool {
+	return false
+}
+
+func TestDeepCopy_unsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := valuecopy.DeepCopy(context.Background(), types.List{
+		ElemType: types.StringType,
+		Elems:    []attr.Value{unsupportedValue{}},
+	})
+	expected := "ElementKeyInt(0): can't copy valuecopy_test.unsupportedValue, it must be a value from the types package or implement attr.ValueWithType"
+	if err == nil {
+		t.Fatalf("Expected error %q, got nil", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
@@ -0,0 +1,297 @@
+// Package valuehash provides helpers for hashing attr.Values, so they can be
+// used to key maps and caches, like when deduplicating set elements or
+// memoizing lookups, without being serialized to strings first.
+package valuehash
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// tags written before each value, so values of different kinds that are
+// written as the same bytes don't hash the same
+const (
+	tagNull byte = iota
+	tagUnknown
+	tagString
+	tagNumber
+	tagBool
+	tagList
+	tagSet
+	tagMap
+)
+
+// Hash returns a hash of `value`. Values that are Equal have the same hash,
+// but values with the same hash aren't always Equal, so code using the hash
+// as a key should still compare values with Equal.
+//
+// Values implementing attr.ValueWithHash are hashed using their Hash
+// method, including when they're elements of the lists and maps or
+// attributes of the objects in the types package. Other values are hashed
+// from their Terraform representation. Elements of sets are hashed without
+// regard to their order, and so are the elements of lists that aren't in the
+// types package, as their Terraform representation doesn't say whether
+// they're a list or a set.
+func Hash(ctx context.Context, value attr.Value) (uint64, error) {
+	return hashValue(ctx, value, tftypes.NewAttributePath())
+}
+
+func hashValue(ctx context.Context, value attr.Value, path *tftypes.AttributePath) (uint64, error) {
+	switch v := value.(type) {
+	case nil:
+		return hashTag(tagNull), nil
+	case attr.ValueWithHash:
+		sum, err := v.Hash(ctx)
+		if err != nil {
+			return 0, path.NewError(err)
+		}
+		return sum, nil
+	case types.List:
+		if v.Null || v.Unknown {
+			return hashState(v.Null), nil
+		}
+		h := newHasher(tagList)
+		for pos, elem := range v.Elems {
+			sum, err := hashValue(ctx, elem, path.WithElementKeyInt(int64(pos)))
+			if err != nil {
+				return 0, err
+			}
+			h.writeUint64(sum)
+		}
+		return h.Sum64(), nil
+	case types.Map:
+		if v.Null || v.Unknown {
+			return hashState(v.Null), nil
+		}
+		return hashAttrValues(ctx, v.Elems, path.WithElementKeyString)
+	case types.Object:
+		if v.Null || v.Unknown {
+			return hashState(v.Null), nil
+		}
+		return hashAttrValues(ctx, v.Attrs, path.WithAttributeName)
+	}
+	raw, err := value.ToTerraformValue(ctx)
+	if err != nil {
+		return 0, path.NewError(err)
+	}
+	sum, err := hashRaw(raw)
+	if err != nil {
+		return 0, path.NewError(err)
+	}
+	return sum, nil
+}
+
+// hashAttrValues returns the hash of a map or object with the elements or
+// attributes `values`, using `step` to build the path to each of them.
+func hashAttrValues(ctx context.Context, values map[string]attr.Value, step func(string) *tftypes.AttributePath) (uint64, error) {
+	h := newHasher(tagMap)
+	for _, key := range sortedKeys(values) {
+		sum, err := hashValue(ctx, values[key], step(key))
+		if err != nil {
+			return 0, err
+		}
+		h.writeString(key)
+		h.writeUint64(sum)
+	}
+	return h.Sum64(), nil
+}
+
+// hashRaw returns the hash of `raw`, a value returned by ToTerraformValue.
+func hashRaw(raw interface{}) (uint64, error) {
+	switch v := raw.(type) {
+	case nil:
+		return hashTag(tagNull), nil
+	case tftypes.Value:
+		return hashTerraform(v)
+	case string:
+		h := newHasher(tagString)
+		h.writeString(v)
+		return h.Sum64(), nil
+	case bool:
+		return hashBool(v), nil
+	case *big.Float:
+		return hashNumber(v), nil
+	case []tftypes.Value:
+		return hashUnordered(tagSet, v)
+	case map[string]tftypes.Value:
+		return hashTerraformMap(v)
+	}
+	if raw == tftypes.UnknownValue {
+		return hashTag(tagUnknown), nil
+	}
+	return 0, fmt.Errorf("can't hash values of type %T", raw)
+}
+
+// hashTerraform returns the hash of `val`.
+func hashTerraform(val tftypes.Value) (uint64, error) {
+	if val.IsNull() || !val.IsKnown() {
+		return hashState(val.IsNull()), nil
+	}
+	typ := val.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := val.As(&s); err != nil {
+			return 0, err
+		}
+		return hashRaw(s)
+	case typ.Is(tftypes.Number):
+		n := big.NewFloat(0)
+		if err := val.As(&n); err != nil {
+			return 0, err
+		}
+		return hashNumber(n), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := val.As(&b); err != nil {
+			return 0, err
+		}
+		return hashBool(b), nil
+	}
+	switch typ.(type) {
+	case tftypes.List, tftypes.Tuple:
+		var elems []tftypes.Value
+		if err := val.As(&elems); err != nil {
+			return 0, err
+		}
+		h := newHasher(tagList)
+		for _, elem := range elems {
+			sum, err := hashTerraform(elem)
+			if err != nil {
+				return 0, err
+			}
+			h.writeUint64(sum)
+		}
+		return h.Sum64(), nil
+	case tftypes.Set:
+		var elems []tftypes.Value
+		if err := val.As(&elems); err != nil {
+			return 0, err
+		}
+		return hashUnordered(tagSet, elems)
+	case tftypes.Map, tftypes.Object:
+		var elems map[string]tftypes.Value
+		if err := val.As(&elems); err != nil {
+			return 0, err
+		}
+		return hashTerraformMap(elems)
+	}
+	return 0, fmt.Errorf("can't hash values of type %s", typ)
+}
+
+// hashUnordered returns the hash of `elems`, without regard to their order.
+func hashUnordered(tag byte, elems []tftypes.Value) (uint64, error) {
+	sums := make([]uint64, 0, len(elems))
+	for _, elem := range elems {
+		sum, err := hashTerraform(elem)
+		if err != nil {
+			return 0, err
+		}
+		sums = append(sums, sum)
+	}
+	sort.Slice(sums, func(i, j int) bool { return sums[i] < sums[j] })
+	h := newHasher(tag)
+	for _, sum := range sums {
+		h.writeUint64(sum)
+	}
+	return h.Sum64(), nil
+}
+
+// hashTerraformMap returns the hash of a map or object with the elements or
+// attributes `elems`.
+func hashTerraformMap(elems map[string]tftypes.Value) (uint64, error) {
+	keys := make([]string, 0, len(elems))
+	for key := range elems {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h := newHasher(tagMap)
+	for _, key := range keys {
+		sum, err := hashTerraform(elems[key])
+		if err != nil {
+			return 0, err
+		}
+		h.writeString(key)
+		h.writeUint64(sum)
+	}
+	return h.Sum64(), nil
+}
+
+// hashNumber returns the hash of `n`. Numbers are written in an exact
+// format that doesn't depend on their precision, so numbers that compare
+// equal hash the same.
+func hashNumber(n *big.Float) uint64 {
+	if n == nil {
+		return hashTag(tagNull)
+	}
+	h := newHasher(tagNumber)
+	if n.Sign() == 0 {
+		// -0 and 0 are equal
+		h.writeString("0")
+	} else {
+		h.writeString(n.Text('p', 0))
+	}
+	return h.Sum64()
+}
+
+func hashBool(b bool) uint64 {
+	h := newHasher(tagBool)
+	if b {
+		h.Write([]byte{1})
+	} else {
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+// hashState returns the hash of a null value if `null` is true, and of an
+// unknown value otherwise.
+func hashState(null bool) uint64 {
+	if null {
+		return hashTag(tagNull)
+	}
+	return hashTag(tagUnknown)
+}
+
+func hashTag(tag byte) uint64 {
+	return newHasher(tag).Sum64()
+}
+
+type hasher struct {
+	hash.Hash64
+}
+
+func newHasher(tag byte) hasher {
+	h := hasher{fnv.New64a()}
+	h.Write([]byte{tag})
+	return h
+}
+
+func (h hasher) writeUint64(v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	h.Write(b[:])
+}
+
+func (h hasher) writeString(s string) {
+	h.writeUint64(uint64(len(s)))
+	h.Write([]byte(s))
+}
+
+func sortedKeys(m map[string]attr.Value) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
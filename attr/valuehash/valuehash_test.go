@@ -0,0 +1,104 @@
+package valuehash_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/valuehash"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// caseInsensitiveString is a string value that hashes without regard to
+// case.
+type caseInsensitiveString struct {
+	types.String
+}
+
+func (s caseInsensitiveString) Hash(_ context.Context) (uint64, error) {
+	if len(s.Value) == 0 {
+		return 0, nil
+	}
+	return uint64(s.Value[0] | 0x20), nil
+}
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	object := func(name string, size *big.Float) types.Object {
+		return types.Object{
+			AttrTypes: map[string]attr.Type{
+				"name": types.StringType,
+				"size": types.NumberType,
+			},
+			Attrs: map[string]attr.Value{
+				"name": types.String{Value: name},
+				"size": types.Number{Value: size},
+			},
+		}
+	}
+	list := func(elems ...attr.Value) types.List {
+		return types.List{ElemType: types.StringType, Elems: elems}
+	}
+
+	type testCase struct {
+		a, b  attr.Value
+		equal bool
+	}
+	tests := map[string]testCase{
+		"same-object": {
+			a:     object("example", big.NewFloat(1)),
+			b:     object("example", big.NewFloat(1)),
+			equal: true,
+		},
+		"number-precision": {
+			a:     types.Number{Value: big.NewFloat(0.5)},
+			b:     types.Number{Value: new(big.Float).SetPrec(200).SetFloat64(0.5)},
+			equal: true,
+		},
+		"different-object": {
+			a: object("example", big.NewFloat(1)),
+			b: object("example", big.NewFloat(2)),
+		},
+		"null-unknown": {
+			a: types.String{Null: true},
+			b: types.String{Unknown: true},
+		},
+		"list-order": {
+			a: list(types.String{Value: "a"}, types.String{Value: "b"}),
+			b: list(types.String{Value: "b"}, types.String{Value: "a"}),
+		},
+		"string-bool": {
+			a: types.String{Value: "true"},
+			b: types.Bool{Value: true},
+		},
+		"nested-hashable": {
+			a:     list(caseInsensitiveString{types.String{Value: "Example"}}),
+			b:     list(caseInsensitiveString{types.String{Value: "example"}}),
+			equal: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, err := valuehash.Hash(context.Background(), test.a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			b, err := valuehash.Hash(context.Background(), test.b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if test.equal && a != b {
+				t.Errorf("Expected equal hashes, got %d and %d", a, b)
+			}
+			if !test.equal && a == b {
+				t.Errorf("Expected different hashes, got %d for both", a)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Package diag converts between the framework's diagnostics and Go error
+// values, so code using the framework can work with libraries that return or
+// expect errors.
+package diag
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Diagnostics is a list of diagnostics. It has the same underlying type as
+// the Diagnostics fields of the framework's requests and responses, so they
+// can be converted to and from each other, like:
+//
+//	if err := diag.Diagnostics(resp.Diagnostics).Err(); err != nil {
+//		return err
+//	}
+type Diagnostics []*tfprotov6.Diagnostic
+
+// HasError returns true if any of the diagnostics are errors.
+func (d Diagnostics) HasError() bool {
+	for _, diag := range d {
+		if diag != nil && diag.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns an error holding every error diagnostic in `d`, or nil if there
+// are none. Warnings are left out. A single error diagnostic is returned as an
+// *Error, and several are returned as a MultiError of *Errors.
+func (d Diagnostics) Err() error {
+	var errs MultiError
+	for _, diag := range d {
+		if diag == nil || diag.Severity != tfprotov6.DiagnosticSeverityError {
+			continue
+		}
+		errs = append(errs, &Error{Diagnostic: diag})
+	}
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	return errs
+}
+
+// FromMulti returns an error diagnostic for each error in `err`. Errors made
+// of several errors, like MultiError or any error with an `Unwrap() []error`
+// or `WrappedErrors() []error` method, are split into one diagnostic per
+// error.
+//
+// Errors wrapping an *Error become its diagnostic. Other errors get
+// `summary` as their summary and their message as their detail, and errors
+// wrapping a tftypes.AttributePathError are associated with its path. A nil
+// `err` returns no diagnostics.
+func FromMulti(summary string, err error) Diagnostics {
+	if err == nil {
+		return nil
+	}
+	if errs, ok := wrappedErrors(err); ok {
+		var diags Diagnostics
+		for _, err := range errs {
+			diags = append(diags, FromMulti(summary, err)...)
+		}
+		return diags
+	}
+	var diagErr *Error
+	if errors.As(err, &diagErr) && diagErr.Diagnostic != nil {
+		return Diagnostics{diagErr.Diagnostic}
+	}
+	diag := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}
+	var pathErr tftypes.AttributePathError
+	if errors.As(err, &pathErr) {
+		diag.Attribute = pathErr.Path
+	}
+	return Diagnostics{diag}
+}
+
+// wrappedErrors returns the errors `err` is made of, if it is made of
+// several errors.
+func wrappedErrors(err error) ([]error, bool) {
+	switch err := err.(type) {
+	case interface{ Unwrap() []error }:
+		return err.Unwrap(), true
+	case interface{ WrappedErrors() []error }:
+		return err.WrappedErrors(), true
+	}
+	return nil, false
+}
+
+// Error is an error holding a diagnostic.
+type Error struct {
+	Diagnostic *tfprotov6.Diagnostic
+}
+
+// Error returns the diagnostic's summary and detail, prefixed with its
+// attribute path if it has one.
+func (e *Error) Error() string {
+	if e.Diagnostic == nil {
+		return "<nil diagnostic>"
+	}
+	msg := e.Diagnostic.Summary
+	if e.Diagnostic.Detail != "" {
+		msg += ": " + e.Diagnostic.Detail
+	}
+	if e.Diagnostic.Attribute != nil && len(e.Diagnostic.Attribute.Steps()) > 0 {
+		msg = e.Diagnostic.Attribute.String() + ": " + msg
+	}
+	return msg
+}
+
+// MultiError is an error made of several errors. errors.Is and errors.As
+// match it if they match any of its errors.
+type MultiError []error
+
+// Error returns the messages of all the errors, one per line.
+func (m MultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		if err == nil {
+			continue
+		}
+		msgs = append(msgs, "* "+strings.ReplaceAll(err.Error(), "\n", "\n  "))
+	}
+	return fmt.Sprintf("%d errors occurred:\n%s", len(msgs), strings.Join(msgs, "\n"))
+}
+
+// Unwrap returns the errors the MultiError is made of.
+func (m MultiError) Unwrap() []error {
+	return m
+}
+
+// Is returns true if any of the errors match `target`.
+func (m MultiError) Is(target error) bool {
+	for _, err := range m {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors that matches `target`, and if one does,
+// sets `target` to it and returns true.
+func (m MultiError) As(target interface{}) bool {
+	for _, err := range m {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
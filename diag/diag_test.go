@@ -0,0 +1,156 @@
+package diag
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiagnosticsErr(t *testing.T) {
+	t.Parallel()
+
+	warning := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "Deprecated",
+	}
+	first := &tfprotov6.Diagnostic{
+		Severity:  tfprotov6.DiagnosticSeverityError,
+		Summary:   "Invalid name",
+		Detail:    "Names can't be empty.",
+		Attribute: tftypes.NewAttributePath().WithAttributeName("name"),
+	}
+	second := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "API error",
+	}
+
+	type testCase struct {
+		diags          Diagnostics
+		expected       string
+		expectedErrors Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"none": {},
+		"warnings": {
+			diags: Diagnostics{warning},
+		},
+		"one": {
+			diags:          Diagnostics{warning, first},
+			expected:       `AttributeName("name"): Invalid name: Names can't be empty.`,
+			expectedErrors: Diagnostics{first},
+		},
+		"several": {
+			diags:          Diagnostics{first, warning, second},
+			expected:       "2 errors occurred:\n* AttributeName(\"name\"): Invalid name: Names can't be empty.\n* API error",
+			expectedErrors: Diagnostics{first, second},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.diags.Err()
+			if tc.expected == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %q", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error %q, got none", tc.expected)
+			}
+			if err.Error() != tc.expected {
+				t.Errorf("Expected error %q, got %q", tc.expected, err.Error())
+			}
+			if diff := cmp.Diff(FromMulti("unused", err), tc.expectedErrors); diff != "" {
+				t.Errorf("Expected FromMulti to return the original diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromMulti(t *testing.T) {
+	t.Parallel()
+
+	sentinel := errors.New("not found")
+	path := tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0)
+
+	type testCase struct {
+		err      error
+		expected Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"nil": {},
+		"plain": {
+			err: sentinel,
+			expected: Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error reading resource",
+					Detail:   "not found",
+				},
+			},
+		},
+		"path": {
+			err: path.NewError(sentinel),
+			expected: Diagnostics{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Error reading resource",
+					Detail:    `AttributeName("disks").ElementKeyInt(0): not found`,
+					Attribute: path,
+				},
+			},
+		},
+		"multi": {
+			err: MultiError{sentinel, nil, MultiError{errors.New("timed out")}},
+			expected: Diagnostics{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error reading resource",
+					Detail:   "not found",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error reading resource",
+					Detail:   "timed out",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FromMulti("Error reading resource", tc.err)
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMultiErrorIsAs(t *testing.T) {
+	t.Parallel()
+
+	sentinel := errors.New("not found")
+	diagErr := &Error{Diagnostic: &tfprotov6.Diagnostic{Summary: "API error"}}
+	err := MultiError{errors.New("other"), sentinel, diagErr}
+
+	if !errors.Is(err, sentinel) {
+		t.Error("Expected errors.Is to match an error in the MultiError")
+	}
+	var target *Error
+	if !errors.As(err, &target) || target != diagErr {
+		t.Errorf("Expected errors.As to find %v, got %v", diagErr, target)
+	}
+}
@@ -0,0 +1,143 @@
+// Package render formats diagnostics for people and programs, so standalone
+// tools built on the framework's schemas and values, like documentation
+// generators and linters, can present them consistently.
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TextOptions change how Text formats diagnostics.
+type TextOptions struct {
+	// Colorize, if set, is called with the severity and the label of
+	// each diagnostic, like "Error", and returns the label to write, so
+	// tools can add terminal colors to it.
+	Colorize func(severity tfprotov6.DiagnosticSeverity, label string) string
+}
+
+// Text writes `diags` to `w` as plain text, one after another, in the form:
+//
+//	Error: Invalid value
+//	  with AttributeName("name")
+//
+//	The detail of the diagnostic.
+//
+// Nil diagnostics are skipped.
+func Text(w io.Writer, diags diag.Diagnostics, opts TextOptions) error {
+	var b strings.Builder
+	for _, d := range diags {
+		if d == nil {
+			continue
+		}
+		label := severityLabel(d.Severity)
+		if opts.Colorize != nil {
+			label = opts.Colorize(d.Severity, label)
+		}
+		fmt.Fprintf(&b, "%s: %s\n", label, d.Summary)
+		if hasPath(d.Attribute) {
+			fmt.Fprintf(&b, "  with %s\n", d.Attribute)
+		}
+		if d.Detail != "" {
+			fmt.Fprintf(&b, "\n%s\n", d.Detail)
+		}
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Diagnostic is the JSON form of a diagnostic.
+type Diagnostic struct {
+	// Severity is "error", "warning", or "invalid".
+	Severity string `json:"severity"`
+
+	Summary string `json:"summary"`
+	Detail  string `json:"detail,omitempty"`
+
+	// Path is the diagnostic's attribute path, formatted like
+	// `AttributeName("tags").ElementKeyString("env")`, and Steps are its
+	// steps, for programs that need to follow it. Steps is left out of
+	// paths into sets, as set elements are identified by their values.
+	Path  string     `json:"path,omitempty"`
+	Steps []PathStep `json:"steps,omitempty"`
+}
+
+// PathStep is the JSON form of a step of an attribute path. Exactly one of
+// its fields is set.
+type PathStep struct {
+	AttributeName    *string `json:"attribute_name,omitempty"`
+	ElementKeyString *string `json:"element_key_string,omitempty"`
+	ElementKeyInt    *int64  `json:"element_key_int,omitempty"`
+}
+
+// JSON returns `diags` as a JSON array of Diagnostics. Nil diagnostics are
+// skipped.
+func JSON(diags diag.Diagnostics) ([]byte, error) {
+	result := make([]Diagnostic, 0, len(diags))
+	for _, d := range diags {
+		if d == nil {
+			continue
+		}
+		j := Diagnostic{
+			Severity: strings.ToLower(severityLabel(d.Severity)),
+			Summary:  d.Summary,
+			Detail:   d.Detail,
+		}
+		if hasPath(d.Attribute) {
+			j.Path = d.Attribute.String()
+			j.Steps = pathSteps(d.Attribute)
+		}
+		result = append(result, j)
+	}
+	// paths contain < and >, which are only worth escaping in HTML
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(result); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// pathSteps returns the steps of `path`, or nil if it goes into a set.
+func pathSteps(path *tftypes.AttributePath) []PathStep {
+	var steps []PathStep
+	for _, step := range path.Steps() {
+		switch s := step.(type) {
+		case tftypes.AttributeName:
+			name := string(s)
+			steps = append(steps, PathStep{AttributeName: &name})
+		case tftypes.ElementKeyString:
+			key := string(s)
+			steps = append(steps, PathStep{ElementKeyString: &key})
+		case tftypes.ElementKeyInt:
+			key := int64(s)
+			steps = append(steps, PathStep{ElementKeyInt: &key})
+		default:
+			return nil
+		}
+	}
+	return steps
+}
+
+func severityLabel(severity tfprotov6.DiagnosticSeverity) string {
+	switch severity {
+	case tfprotov6.DiagnosticSeverityError:
+		return "Error"
+	case tfprotov6.DiagnosticSeverityWarning:
+		return "Warning"
+	}
+	return "Invalid"
+}
+
+func hasPath(path *tftypes.AttributePath) bool {
+	return path != nil && len(path.Steps()) > 0
+}
@@ -0,0 +1,76 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/diag/render"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testDiags = diag.Diagnostics{
+	{
+		Severity:  tfprotov6.DiagnosticSeverityError,
+		Summary:   "Invalid value",
+		Detail:    "The value must be lowercase.",
+		Attribute: tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyString("env"),
+	},
+	nil,
+	{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "Deprecated",
+	},
+	{
+		Severity:  tfprotov6.DiagnosticSeverityError,
+		Summary:   "Invalid element",
+		Attribute: tftypes.NewAttributePath().WithAttributeName("ports").WithElementKeyValue(tftypes.NewValue(tftypes.Number, 80)),
+	},
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+
+	var b strings.Builder
+	err := render.Text(&b, testDiags, render.TextOptions{
+		Colorize: func(severity tfprotov6.DiagnosticSeverity, label string) string {
+			if severity == tfprotov6.DiagnosticSeverityError {
+				return "[red]" + label + "[reset]"
+			}
+			return label
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := `[red]Error[reset]: Invalid value
+  with AttributeName("tags").ElementKeyString("env")
+
+The value must be lowercase.
+
+Warning: Deprecated
+
+[red]Error[reset]: Invalid element
+  with AttributeName("ports").ElementKeyValue(tftypes.Number<"80">)
+
+`
+	if b.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, b.String())
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	got, err := render.JSON(testDiags)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := `[{"severity":"error","summary":"Invalid value","detail":"The value must be lowercase.","path":"AttributeName(\"tags\").ElementKeyString(\"env\")","steps":[{"attribute_name":"tags"},{"element_key_string":"env"}]},` +
+		`{"severity":"warning","summary":"Deprecated"},` +
+		`{"severity":"error","summary":"Invalid element","path":"AttributeName(\"ports\").ElementKeyValue(tftypes.Number<\"80\">)"}]`
+	if string(got) != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, got)
+	}
+}
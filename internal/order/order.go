@@ -0,0 +1,82 @@
+// Package order helps the framework visit Go maps and attribute paths in a
+// stable order, so the errors, diagnostics, and schemas it builds from them
+// are the same every time.
+package order
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Keys returns the keys of `m`, which must be a map with string keys, sorted.
+// It panics if `m` is any other type. A nil map has no keys.
+func Keys(m interface{}) []string {
+	val := reflect.ValueOf(m)
+	if val.Kind() != reflect.Map || val.Type().Key().Kind() != reflect.String {
+		panic("order.Keys called with " + val.Type().String() + ", not a map with string keys")
+	}
+	keys := make([]string, 0, val.Len())
+	iter := val.MapRange()
+	for iter.Next() {
+		keys = append(keys, iter.Key().String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// PathLess reports whether `a` sorts before `b`. Paths are compared step
+// by step, so list elements are ordered by their numeric index rather than
+// by their string representation, and a path sorts before any path nested
+// inside it.
+func PathLess(a, b *tftypes.AttributePath) bool {
+	aSteps, bSteps := a.Steps(), b.Steps()
+	for pos := 0; pos < len(aSteps) && pos < len(bSteps); pos++ {
+		if cmp := compareSteps(aSteps[pos], bSteps[pos]); cmp != 0 {
+			return cmp < 0
+		}
+	}
+	return len(aSteps) < len(bSteps)
+}
+
+// compareSteps returns a negative number if `a` sorts before `b`, a
+// positive number if it sorts after, and zero if they're equal. Steps of
+// different kinds are ordered by kind.
+func compareSteps(a, b tftypes.AttributePathStep) int {
+	aKind, bKind := stepKind(a), stepKind(b)
+	if aKind != bKind {
+		return aKind - bKind
+	}
+	switch a := a.(type) {
+	case tftypes.AttributeName:
+		return strings.Compare(string(a), string(b.(tftypes.AttributeName)))
+	case tftypes.ElementKeyString:
+		return strings.Compare(string(a), string(b.(tftypes.ElementKeyString)))
+	case tftypes.ElementKeyInt:
+		switch b := b.(tftypes.ElementKeyInt); {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	case tftypes.ElementKeyValue:
+		return strings.Compare(tftypes.Value(a).String(), tftypes.Value(b.(tftypes.ElementKeyValue)).String())
+	}
+	return 0
+}
+
+func stepKind(step tftypes.AttributePathStep) int {
+	switch step.(type) {
+	case tftypes.AttributeName:
+		return 0
+	case tftypes.ElementKeyString:
+		return 1
+	case tftypes.ElementKeyInt:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -0,0 +1,117 @@
+package order_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type label string
+
+func TestKeys(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		m        interface{}
+		expected []string
+	}
+	tests := map[string]testCase{
+		"nil": {
+			m:        map[string]int(nil),
+			expected: []string{},
+		},
+		"strings": {
+			m:        map[string]bool{"b": true, "c": false, "a": true},
+			expected: []string{"a", "b", "c"},
+		},
+		"named-keys": {
+			m:        map[label]struct{}{"z": {}, "y": {}},
+			expected: []string{"y", "z"},
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := order.Keys(tc.m)
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeys_notMap(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic")
+		}
+	}()
+	order.Keys([]string{"a"})
+}
+
+func TestPathLess(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		a, b     *tftypes.AttributePath
+		expected bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			a:        tftypes.NewAttributePath().WithAttributeName("a"),
+			b:        tftypes.NewAttributePath().WithAttributeName("a"),
+			expected: false,
+		},
+		"attribute-names": {
+			a:        tftypes.NewAttributePath().WithAttributeName("a"),
+			b:        tftypes.NewAttributePath().WithAttributeName("b"),
+			expected: true,
+		},
+		"element-indexes": {
+			a:        tftypes.NewAttributePath().WithAttributeName("a").WithElementKeyInt(2),
+			b:        tftypes.NewAttributePath().WithAttributeName("a").WithElementKeyInt(10),
+			expected: true,
+		},
+		"element-indexes-reversed": {
+			a:        tftypes.NewAttributePath().WithAttributeName("a").WithElementKeyInt(10),
+			b:        tftypes.NewAttributePath().WithAttributeName("a").WithElementKeyInt(2),
+			expected: false,
+		},
+		"element-keys": {
+			a:        tftypes.NewAttributePath().WithElementKeyString("a"),
+			b:        tftypes.NewAttributePath().WithElementKeyString("b"),
+			expected: true,
+		},
+		"prefix": {
+			a:        tftypes.NewAttributePath().WithAttributeName("a"),
+			b:        tftypes.NewAttributePath().WithAttributeName("a").WithElementKeyInt(0),
+			expected: true,
+		},
+		"nested": {
+			a:        tftypes.NewAttributePath().WithAttributeName("a").WithElementKeyInt(0),
+			b:        tftypes.NewAttributePath().WithAttributeName("a"),
+			expected: false,
+		},
+		"different-kinds": {
+			a:        tftypes.NewAttributePath().WithAttributeName("b"),
+			b:        tftypes.NewAttributePath().WithElementKeyInt(0),
+			expected: true,
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := order.PathLess(tc.a, tc.b); got != tc.expected {
+				t.Errorf("Expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -3,8 +3,8 @@ package proto6
 import (
 	"context"
 	"errors"
-	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
 	"github.com/hashicorp/terraform-plugin-framework/schema"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -18,22 +18,14 @@ func Schema(ctx context.Context, s schema.Schema) (*tfprotov6.Schema, error) {
 		Version: s.Version,
 	}
 	var attrs []*tfprotov6.SchemaAttribute
-	for name, attr := range s.Attributes {
+	for _, name := range order.Keys(s.Attributes) {
+		attr := s.Attributes[name]
 		a, err := Attribute(ctx, name, attr, tftypes.NewAttributePath().WithAttributeName(name))
 		if err != nil {
 			return nil, err
 		}
 		attrs = append(attrs, a)
 	}
-	sort.Slice(attrs, func(i, j int) bool {
-		if attrs[i] == nil {
-			return true
-		}
-		if attrs[j] == nil {
-			return false
-		}
-		return attrs[i].Name < attrs[j].Name
-	})
 	if len(attrs) < 1 {
 		return nil, errors.New("must have at least one attribute in the schema")
 	}
@@ -69,11 +61,19 @@ func Attribute(ctx context.Context, name string, attr schema.Attribute, path *tf
 		a.Deprecated = true
 	}
 	if attr.Description != "" {
-		a.Description = attr.Description
+		description, err := attr.RenderDescription(ctx, name)
+		if err != nil {
+			return nil, path.NewError(err)
+		}
+		a.Description = description
 		a.DescriptionKind = tfprotov6.StringKindPlain
 	}
 	if attr.MarkdownDescription != "" {
-		a.Description = attr.MarkdownDescription
+		description, err := attr.RenderMarkdownDescription(ctx, name)
+		if err != nil {
+			return nil, path.NewError(err)
+		}
+		a.Description = description
 		a.DescriptionKind = tfprotov6.StringKindMarkdown
 	}
 	if attr.Type != nil && attr.Attributes == nil {
@@ -97,22 +97,14 @@ func Attribute(ctx context.Context, name string, attr schema.Attribute, path *tf
 			return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
 		}
 		attrs := attr.Attributes.GetAttributes()
-		for nestedName, nestedAttr := range attrs {
+		for _, nestedName := range order.Keys(attrs) {
+			nestedAttr := attrs[nestedName]
 			nestedA, err := Attribute(ctx, nestedName, nestedAttr, path.WithAttributeName(nestedName))
 			if err != nil {
 				return nil, err
 			}
 			object.Attributes = append(object.Attributes, nestedA)
 		}
-		sort.Slice(object.Attributes, func(i, j int) bool {
-			if object.Attributes[i] == nil {
-				return true
-			}
-			if object.Attributes[j] == nil {
-				return false
-			}
-			return object.Attributes[i].Name < object.Attributes[j].Name
-		})
 		a.NestedType = object
 	} else if attr.Attributes != nil && len(attr.Attributes.GetAttributes()) > 0 && attr.Type != nil {
 		return nil, path.NewErrorf("can't have both Attributes and Type set")
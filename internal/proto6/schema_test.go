@@ -487,6 +487,35 @@ func TestAttribute(t *testing.T) {
 				DescriptionKind: tfprotov6.StringKindPlain,
 			},
 		},
+		"description-template": {
+			name: "id",
+			attr: schema.Attribute{
+				Type:                 types.StringType,
+				Computed:             true,
+				Description:          "The ID.{{ range .PlanModifiers }} {{ . }}{{ end }}",
+				PlanModifiers:        []schema.AttributePlanModifier{schema.UseStateForUnknown()},
+				DescriptionTemplates: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name:            "id",
+				Type:            tftypes.String,
+				Computed:        true,
+				Description:     "The ID. Once set, the value of this attribute in state will not change.",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+		},
+		"description-template-invalid": {
+			name: "string",
+			attr: schema.Attribute{
+				Type:                 types.StringType,
+				Optional:             true,
+				Description:          "A {{ .Nope }} attribute",
+				DescriptionTemplates: true,
+			},
+			path:        tftypes.NewAttributePath().WithAttributeName("string"),
+			expectedErr: `AttributeName("string"): error rendering description template: template: string:1:5: executing "string" at <.Nope>: can't evaluate field Nope in type schema.DescriptionData`,
+		},
 		"description-markdown": {
 			name: "string",
 			attr: schema.Attribute{
@@ -1092,3 +1121,25 @@ func TestAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestSchema_errorOrder(t *testing.T) {
+	t.Parallel()
+
+	// every attribute is invalid, so the error must always be for the
+	// first attribute by name
+	input := schema.Schema{
+		Attributes: map[string]schema.Attribute{},
+	}
+	for _, name := range []string{"e", "d", "c", "b", "a"} {
+		input.Attributes[name] = schema.Attribute{Optional: true}
+	}
+	for i := 0; i < 20; i++ {
+		_, err := Schema(context.Background(), input)
+		if err == nil {
+			t.Fatal("Expected error, got none")
+		}
+		if diff := cmp.Diff(`AttributeName("a"): must have Attributes or Type set`, err.Error()); diff != "" {
+			t.Fatalf("Unexpected error (+wanted, -got): %s", diff)
+		}
+	}
+}
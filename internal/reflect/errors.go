@@ -0,0 +1,34 @@
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// conversionError returns an attr.ConversionError for the value at `path`,
+// which couldn't be converted between the Go type `goType` and `typ` because
+// of `err`. Either type may be nil if the error isn't about it. If `err`
+// already is an attr.ConversionError, for a value nested in the one at
+// `path`, it is returned unchanged.
+func conversionError(path *tftypes.AttributePath, goType reflect.Type, typ attr.Type, err error) error {
+	var convErr attr.ConversionError
+	if errors.As(err, &convErr) {
+		return err
+	}
+	return attr.ConversionError{
+		FieldPath: path,
+		GoType:    goType,
+		AttrType:  typ,
+		Err:       err,
+	}
+}
+
+// conversionErrorf is like conversionError, but builds the error from a
+// format string, like fmt.Errorf.
+func conversionErrorf(path *tftypes.AttributePath, goType reflect.Type, typ attr.Type, format string, a ...interface{}) error {
+	return conversionError(path, goType, typ, fmt.Errorf(format, a...))
+}
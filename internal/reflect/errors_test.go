@@ -0,0 +1,106 @@
+package reflect_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConversionError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"tags": types.ListType{ElemType: types.StringType},
+		},
+	}
+	type tagsStruct struct {
+		Tags []bool `tfsdk:"tags"`
+	}
+
+	type testCase struct {
+		convert          func() error
+		expectedPath     *tftypes.AttributePath
+		expectedGoType   reflect.Type
+		expectedAttrType attr.Type
+		expectedError    string
+	}
+	tests := map[string]testCase{
+		"into": {
+			convert: func() error {
+				var target tagsStruct
+				val := tftypes.NewValue(objType.TerraformType(ctx), map[string]tftypes.Value{
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "a"),
+					}),
+				})
+				return refl.Into(ctx, objType, val, &target, refl.Options{})
+			},
+			expectedPath:     tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(0),
+			expectedGoType:   reflect.TypeOf(false),
+			expectedAttrType: types.StringType,
+			expectedError:    `AttributeName("tags").ElementKeyInt(0): can't unmarshal tftypes.String into *bool, expected boolean`,
+		},
+		"outof": {
+			convert: func() error {
+				_, err := refl.OutOf(ctx, objType, tagsStruct{Tags: []bool{true}})
+				return err
+			},
+			expectedPath:     tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(0),
+			expectedGoType:   reflect.TypeOf(false),
+			expectedAttrType: types.StringType,
+			expectedError:    `AttributeName("tags").ElementKeyInt(0): can't unmarshal tftypes.Bool into *string, expected string`,
+		},
+		"not-a-pointer": {
+			convert: func() error {
+				return refl.Into(ctx, objType, tftypes.NewValue(objType.TerraformType(ctx), nil), tagsStruct{}, refl.Options{})
+			},
+			expectedPath:     tftypes.NewAttributePath(),
+			expectedGoType:   reflect.TypeOf(tagsStruct{}),
+			expectedAttrType: objType,
+			expectedError:    "target must be a pointer, got reflect_test.tagsStruct, which is a struct",
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.convert()
+			var convErr attr.ConversionError
+			if !errors.As(err, &convErr) {
+				t.Fatalf("Expected an attr.ConversionError, got %T: %v", err, err)
+			}
+			if !convErr.FieldPath.Equal(tc.expectedPath) {
+				t.Errorf("Expected path %s, got %s", tc.expectedPath, convErr.FieldPath)
+			}
+			if convErr.GoType != tc.expectedGoType {
+				t.Errorf("Expected Go type %s, got %s", tc.expectedGoType, convErr.GoType)
+			}
+			if !tc.expectedAttrType.Equal(convErr.AttrType) {
+				t.Errorf("Expected attr.Type %T, got %T", tc.expectedAttrType, convErr.AttrType)
+			}
+			if diff := cmp.Diff(tc.expectedError, err.Error()); diff != "" {
+				t.Errorf("Unexpected error (+wanted, -got): %s", diff)
+			}
+
+			// the path can also be found as a tftypes.AttributePathError
+			if len(tc.expectedPath.Steps()) == 0 {
+				return
+			}
+			var pathErr tftypes.AttributePathError
+			if !errors.As(err, &pathErr) || !pathErr.Path.Equal(tc.expectedPath) {
+				t.Errorf("Expected a tftypes.AttributePathError with path %s, got %v", tc.expectedPath, pathErr.Path)
+			}
+		})
+	}
+}
@@ -42,13 +42,28 @@ func commaSeparatedString(in []string) string {
 	}
 }
 
-// getStructTags returns a map of Terraform field names to their position in
+// StructTags returns a map of Terraform field names to their position in
 // the tags of the struct `in`. `in` must be a struct.
-func getStructTags(ctx context.Context, in reflect.Value, path *tftypes.AttributePath) (map[string]int, error) {
-	tags := map[string]int{}
+func StructTags(ctx context.Context, in reflect.Value, path *tftypes.AttributePath) (map[string]int, error) {
+	fields, err := StructFields(ctx, in, path)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]int, len(fields))
+	for name, field := range fields {
+		tags[name] = field.Index
+	}
+	return tags, nil
+}
+
+// StructFields returns a map of Terraform field names to the position and
+// tag options of the corresponding fields of the struct `in`. `in` must be a
+// struct.
+func StructFields(ctx context.Context, in reflect.Value, path *tftypes.AttributePath) (map[string]StructField, error) {
+	fields := map[string]StructField{}
 	typ := trueReflectValue(in).Type()
 	if typ.Kind() != reflect.Struct {
-		return nil, path.NewErrorf("can't get struct tags of %s, is not a struct", in.Type())
+		return nil, conversionErrorf(path, in.Type(), nil, "can't get struct tags of %s, is not a struct", in.Type())
 	}
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
@@ -57,23 +72,30 @@ func getStructTags(ctx context.Context, in reflect.Value, path *tftypes.Attribut
 			continue
 		}
 		tag := field.Tag.Get(`tfsdk`)
-		if tag == "-" {
+		if tag == "" {
+			return nil, conversionErrorf(path, in.Type(), nil, `need a struct tag for "tfsdk" on %s`, field.Name)
+		}
+		name, opts, err := parseStructTag(field, tag, path)
+		if err != nil {
+			return nil, err
+		}
+		if name == "-" {
 			// skip explicitly excluded fields
 			continue
 		}
-		if tag == "" {
-			return nil, path.NewErrorf(`need a struct tag for "tfsdk" on %s`, field.Name)
+		path := path.WithAttributeName(name)
+		if !isValidFieldName(name) {
+			return nil, conversionError(path, in.Type(), nil, errors.New("invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter"))
 		}
-		path := path.WithAttributeName(tag)
-		if !isValidFieldName(tag) {
-			return nil, path.NewError(errors.New("invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter"))
+		if other, ok := fields[name]; ok {
+			return nil, conversionErrorf(path, in.Type(), nil, "can't use field name for both %s and %s", typ.Field(other.Index).Name, field.Name)
 		}
-		if other, ok := tags[tag]; ok {
-			return nil, path.NewErrorf("can't use field name for both %s and %s", typ.Field(other).Name, field.Name)
+		fields[name] = StructField{
+			Index:   i,
+			Options: opts,
 		}
-		tags[tag] = i
 	}
-	return tags, nil
+	return fields, nil
 }
 
 // isValidFieldName returns true if `name` can be used as a field name in a
@@ -94,3 +116,18 @@ func canBeNil(target reflect.Value) bool {
 		return false
 	}
 }
+
+// NewTerraformValue returns `raw`, returned by the ToTerraformValue method of
+// an attr.Value, as a tftypes.Value of the type `typ`. Values of
+// tftypes.DynamicPseudoType are returned as the tftypes.Value of their
+// concrete type, as tftypes.NewValue would drop it.
+func NewTerraformValue(typ tftypes.Type, raw interface{}) (tftypes.Value, error) {
+	if v, ok := raw.(tftypes.Value); ok && typ.Is(tftypes.DynamicPseudoType) {
+		return v, nil
+	}
+	err := tftypes.ValidateValue(typ, raw)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return tftypes.NewValue(typ, raw), nil
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/testing/attrmock"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -103,7 +105,7 @@ func TestGetStructTags_success(t *testing.T) {
 		ExportedAndExcluded string `tfsdk:"-"`
 	}
 
-	res, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
+	res, err := StructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err)
 	}
@@ -120,7 +122,7 @@ func TestGetStructTags_untagged(t *testing.T) {
 	type testStruct struct {
 		ExportedAndUntagged string
 	}
-	_, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
+	_, err := StructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
 	if err == nil {
 		t.Error("Expected error, got nil")
 	}
@@ -135,7 +137,7 @@ func TestGetStructTags_invalidTag(t *testing.T) {
 	type testStruct struct {
 		InvalidTag string `tfsdk:"invalidTag"`
 	}
-	_, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
+	_, err := StructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
 	if err == nil {
 		t.Errorf("Expected error, got nil")
 	}
@@ -151,7 +153,7 @@ func TestGetStructTags_duplicateTag(t *testing.T) {
 		Field1 string `tfsdk:"my_field"`
 		Field2 string `tfsdk:"my_field"`
 	}
-	_, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
+	_, err := StructTags(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
 	if err == nil {
 		t.Errorf("Expected error, got nil")
 	}
@@ -161,11 +163,134 @@ func TestGetStructTags_duplicateTag(t *testing.T) {
 	}
 }
 
+func TestGetStructTags_options(t *testing.T) {
+	t.Parallel()
+	type testStruct struct {
+		Name      string    `tfsdk:"name,omitempty"`
+		CreatedAt time.Time `tfsdk:"created_at,omitempty,format=rfc3339"`
+	}
+
+	res, err := StructFields(context.Background(), reflect.ValueOf(testStruct{}), tftypes.NewAttributePath())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := map[string]StructField{
+		"name": {
+			Index:   0,
+			Options: TagOptions{"omitempty": ""},
+		},
+		"created_at": {
+			Index:   1,
+			Options: TagOptions{"omitempty": "", "format": "rfc3339"},
+		},
+	}
+	if !reflect.DeepEqual(expected, res) {
+		t.Errorf("Expected %+v, got %+v", expected, res)
+	}
+}
+
+func TestGetStructTags_invalidOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		field    reflect.StructField
+		expected string
+	}{
+		"unknown": {
+			field: reflect.StructField{
+				Name: "Name",
+				Type: reflect.TypeOf(""),
+				Tag:  `tfsdk:"name,omitnull"`,
+			},
+			expected: `unknown option "omitnull" in struct tag for Name, must be one of "format", "lazy", or "omitempty"`,
+		},
+		"duplicate": {
+			field: reflect.StructField{
+				Name: "Name",
+				Type: reflect.TypeOf(""),
+				Tag:  `tfsdk:"name,omitempty,omitempty"`,
+			},
+			expected: `option "omitempty" set more than once in struct tag for Name`,
+		},
+		"missing-value": {
+			field: reflect.StructField{
+				Name: "CreatedAt",
+				Type: reflect.TypeOf(time.Time{}),
+				Tag:  `tfsdk:"created_at,format"`,
+			},
+			expected: `option "format" in struct tag for CreatedAt needs a value, use format=<value>`,
+		},
+		"unexpected-value": {
+			field: reflect.StructField{
+				Name: "Name",
+				Type: reflect.TypeOf(""),
+				Tag:  `tfsdk:"name,omitempty=true"`,
+			},
+			expected: `option "omitempty" in struct tag for Name doesn't take a value`,
+		},
+		"format-not-time": {
+			field: reflect.StructField{
+				Name: "Name",
+				Type: reflect.TypeOf(""),
+				Tag:  `tfsdk:"name,format=rfc3339"`,
+			},
+			expected: `invalid option "format" in struct tag for Name: can only be used on time.Time fields, not string`,
+		},
+		"format-unknown": {
+			field: reflect.StructField{
+				Name: "CreatedAt",
+				Type: reflect.TypeOf(time.Time{}),
+				Tag:  `tfsdk:"created_at,format=unix"`,
+			},
+			expected: `invalid option "format" in struct tag for CreatedAt: unknown format "unix", must be one of "rfc3339" or "rfc3339nano"`,
+		},
+		"lazy-not-lazy-value": {
+			field: reflect.StructField{
+				Name: "Name",
+				Type: reflect.TypeOf(""),
+				Tag:  `tfsdk:"name,lazy"`,
+			},
+			expected: `invalid option "lazy" in struct tag for Name: can only be used on fields implementing LazyValue, like types.Lazy, not string`,
+		},
+		"omitempty-attr-value": {
+			field: reflect.StructField{
+				Name: "Name",
+				Type: reflect.TypeOf(attrmock.Value{}),
+				Tag:  `tfsdk:"name,omitempty"`,
+			},
+			expected: `invalid option "omitempty" in struct tag for Name: can't be used on attr.Value fields like attrmock.Value, which can be null themselves`,
+		},
+		"excluded": {
+			field: reflect.StructField{
+				Name: "Name",
+				Type: reflect.TypeOf(""),
+				Tag:  `tfsdk:"-,omitempty"`,
+			},
+			expected: `can't set options on Name, it is excluded with a "-" tag`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := reflect.StructOf([]reflect.StructField{test.field})
+			_, err := StructFields(context.Background(), reflect.New(typ).Elem(), tftypes.NewAttributePath())
+			if err == nil {
+				t.Fatalf("Expected error %q, got nil", test.expected)
+			}
+			if err.Error() != test.expected {
+				t.Errorf("Expected error to be %q, got %q", test.expected, err.Error())
+			}
+		})
+	}
+}
+
 func TestGetStructTags_notAStruct(t *testing.T) {
 	t.Parallel()
 	var testStruct string
 
-	_, err := getStructTags(context.Background(), reflect.ValueOf(testStruct), tftypes.NewAttributePath())
+	_, err := StructTags(context.Background(), reflect.ValueOf(testStruct), tftypes.NewAttributePath())
 	if err == nil {
 		t.Errorf("Expected error, got nil")
 	}
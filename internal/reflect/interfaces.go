@@ -9,6 +9,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+// LazyValue is an interface for types that hold a tftypes.Value and the
+// attr.Type it belongs to without converting it, used for struct fields with
+// the "lazy" tag option.
+type LazyValue interface {
+	SetTerraformValue(context.Context, attr.Type, tftypes.Value) error
+}
+
+// NewLazyValue creates a zero value of `target` and calls its
+// SetTerraformValue method with `typ` and `val`.
+//
+// It is meant to be called through Into, not directly.
+func NewLazyValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, path *tftypes.AttributePath) (reflect.Value, error) {
+	receiver := reflect.New(target.Type())
+	lazy, ok := receiver.Interface().(LazyValue)
+	if !ok {
+		return target, conversionErrorf(path, target.Type(), typ, "can't store value lazily in %s, it must implement LazyValue", target.Type())
+	}
+	if err := lazy.SetTerraformValue(ctx, typ, val); err != nil {
+		return target, conversionError(path, target.Type(), typ, err)
+	}
+	return receiver.Elem(), nil
+}
+
 // Unknownable is an interface for types that can be explicitly set to known or
 // unknown.
 type Unknownable interface {
@@ -26,7 +49,7 @@ func NewUnknownable(ctx context.Context, typ attr.Type, val tftypes.Value, targe
 	receiver := pointerSafeZeroValue(ctx, target)
 	method := receiver.MethodByName("SetUnknown")
 	if !method.IsValid() {
-		return target, path.NewErrorf("unexpectedly couldn't find SetUnknown method on type %s", receiver.Type().String())
+		return target, conversionErrorf(path, target.Type(), typ, "unexpectedly couldn't find SetUnknown method on type %s", receiver.Type().String())
 	}
 	results := method.Call([]reflect.Value{
 		reflect.ValueOf(ctx),
@@ -34,7 +57,7 @@ func NewUnknownable(ctx context.Context, typ attr.Type, val tftypes.Value, targe
 	})
 	err := results[0].Interface()
 	if err != nil {
-		return target, path.NewError(err.(error))
+		return target, conversionError(path, target.Type(), typ, err.(error))
 	}
 	return receiver, nil
 }
@@ -46,17 +69,17 @@ func FromUnknownable(ctx context.Context, typ attr.Type, val Unknownable, path *
 	if val.GetUnknown(ctx) {
 		res, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), tftypes.UnknownValue))
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 		}
 		return res, nil
 	}
 	err := tftypes.ValidateValue(typ.TerraformType(ctx), val.GetValue(ctx))
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	res, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), val.GetValue(ctx)))
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	return res, nil
 }
@@ -77,7 +100,7 @@ func NewNullable(ctx context.Context, typ attr.Type, val tftypes.Value, target r
 	receiver := pointerSafeZeroValue(ctx, target)
 	method := receiver.MethodByName("SetNull")
 	if !method.IsValid() {
-		return target, path.NewErrorf("unexpectedly couldn't find SetUnknown method on type %s", receiver.Type().String())
+		return target, conversionErrorf(path, target.Type(), typ, "unexpectedly couldn't find SetUnknown method on type %s", receiver.Type().String())
 	}
 	results := method.Call([]reflect.Value{
 		reflect.ValueOf(ctx),
@@ -85,7 +108,7 @@ func NewNullable(ctx context.Context, typ attr.Type, val tftypes.Value, target r
 	})
 	err := results[0].Interface()
 	if err != nil {
-		return target, path.NewError(err.(error))
+		return target, conversionError(path, target.Type(), typ, err.(error))
 	}
 	return receiver, nil
 }
@@ -97,17 +120,17 @@ func FromNullable(ctx context.Context, typ attr.Type, val Nullable, path *tftype
 	if val.GetNull(ctx) {
 		res, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 		}
 		return res, nil
 	}
 	err := tftypes.ValidateValue(typ.TerraformType(ctx), val.GetValue(ctx))
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	res, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), val.GetValue(ctx)))
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	return res, nil
 }
@@ -121,12 +144,12 @@ func NewValueConverter(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 	receiver := pointerSafeZeroValue(ctx, target)
 	method := receiver.MethodByName("FromTerraform5Value")
 	if !method.IsValid() {
-		return target, path.NewErrorf("unexpectedly couldn't find FromTerraform5Type method on type %s", receiver.Type().String())
+		return target, conversionErrorf(path, target.Type(), typ, "unexpectedly couldn't find FromTerraform5Type method on type %s", receiver.Type().String())
 	}
 	results := method.Call([]reflect.Value{reflect.ValueOf(val)})
 	err := results[0].Interface()
 	if err != nil {
-		return target, path.NewError(err.(error))
+		return target, conversionError(path, target.Type(), typ, err.(error))
 	}
 	return receiver, nil
 }
@@ -139,32 +162,49 @@ func NewValueConverter(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 func FromValueCreator(ctx context.Context, typ attr.Type, val tftypes.ValueCreator, path *tftypes.AttributePath) (attr.Value, error) {
 	raw, err := val.ToTerraform5Value()
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	err = tftypes.ValidateValue(typ.TerraformType(ctx), raw)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfVal := tftypes.NewValue(typ.TerraformType(ctx), raw)
 	res, err := typ.ValueFromTerraform(ctx, tfVal)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	return res, nil
 }
 
 // NewAttributeValue creates a new reflect.Value by calling the
-// ValueFromTerraform method on `typ`. It will return an error if the returned
-// `attr.Value` is not the same type as `target`.
+// ValueFromTerraform method on `typ`. If `target` is an attr.ValueWithType,
+// the ValueFromTerraform method of the attr.Type it reports is used instead,
+// as long as that attr.Type has the same Terraform type as `typ`. It will
+// return an error if the returned `attr.Value` is not the same type as
+// `target`.
 //
 // It is meant to be called through Into, not directly.
 func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	if target.Type().Implements(reflect.TypeOf((*attr.ValueWithType)(nil)).Elem()) {
+		valueWithType, ok := pointerSafeZeroValue(ctx, target).Interface().(attr.ValueWithType)
+		if !ok {
+			return target, conversionErrorf(path, target.Type(), typ, "unexpectedly couldn't use %s as an attr.ValueWithType", target.Type())
+		}
+		targetType := valueWithType.Type(ctx)
+		if targetType == nil {
+			return target, conversionErrorf(path, target.Type(), typ, "%s returned a nil attr.Type", target.Type())
+		}
+		if !targetType.TerraformType(ctx).Is(typ.TerraformType(ctx)) {
+			return target, conversionErrorf(path, target.Type(), typ, "can't use attr.Value %s, its type %T uses Terraform type %s, but %T uses Terraform type %s", target.Type(), targetType, targetType.TerraformType(ctx), typ, typ.TerraformType(ctx))
+		}
+		typ = targetType
+	}
 	res, err := typ.ValueFromTerraform(ctx, val)
 	if err != nil {
-		return target, err
+		return target, conversionError(path, target.Type(), typ, err)
 	}
 	if reflect.TypeOf(res) != target.Type() {
-		return target, path.NewErrorf("can't use attr.Value %s, only %s is supported because %T is the type in the schema", target.Type(), reflect.TypeOf(res), typ)
+		return target, conversionErrorf(path, target.Type(), typ, "can't use attr.Value %s, only %s is supported because %T is the type in the schema", target.Type(), reflect.TypeOf(res), typ)
 	}
 	return reflect.ValueOf(res), nil
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -445,6 +446,78 @@ func TestNewAttributeValue_value(t *testing.T) {
 	}
 }
 
+type customStringType struct{}
+
+func (c customStringType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+func (c customStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := types.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return customString{String: val.(types.String)}, nil
+}
+
+func (c customStringType) Equal(o attr.Type) bool {
+	_, ok := o.(customStringType)
+	return ok
+}
+
+func (c customStringType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to customStringType", step)
+}
+
+type customString struct {
+	types.String
+}
+
+func (c customString) Type(_ context.Context) attr.Type {
+	return customStringType{}
+}
+
+func (c customString) Equal(o attr.Value) bool {
+	other, ok := o.(customString)
+	if !ok {
+		return false
+	}
+	return c.String.Equal(other.String)
+}
+
+var _ attr.ValueWithType = customString{}
+
+func TestNewAttributeValue_valueWithType(t *testing.T) {
+	t.Parallel()
+
+	var av customString
+	res, err := refl.NewAttributeValue(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(av), refl.Options{}, tftypes.NewAttributePath())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err.Error())
+		return
+	}
+	got := res.Interface().(customString)
+	expected := customString{String: types.String{Value: "hello"}}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestNewAttributeValue_valueWithTypeMismatch(t *testing.T) {
+	t.Parallel()
+
+	var av customString
+	_, err := refl.NewAttributeValue(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 123), reflect.ValueOf(av), refl.Options{}, tftypes.NewAttributePath())
+	expected := "can't use attr.Value reflect_test.customString, its type reflect_test.customStringType uses Terraform type tftypes.String, but types.primitive uses Terraform type tftypes.Number"
+	if err == nil {
+		t.Errorf("Expected error %q, got nil", expected)
+		return
+	}
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
+
 func TestNewValueConverter_unknown(t *testing.T) {
 	t.Parallel()
 
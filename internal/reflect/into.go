@@ -3,7 +3,6 @@ package reflect
 import (
 	"context"
 	"errors"
-	"fmt"
 	"math/big"
 	"reflect"
 
@@ -24,7 +23,7 @@ import (
 func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interface{}, opts Options) error {
 	v := reflect.ValueOf(target)
 	if v.Kind() != reflect.Ptr {
-		return fmt.Errorf("target must be a pointer, got %T, which is a %s", target, v.Kind())
+		return conversionErrorf(tftypes.NewAttributePath(), reflect.TypeOf(target), typ, "target must be a pointer, got %T, which is a %s", target, v.Kind())
 	}
 	result, err := BuildValue(ctx, typ, val, v.Elem(), opts, tftypes.NewAttributePath())
 	if err != nil {
@@ -43,7 +42,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 	// if this isn't a valid reflect.Value, bail before we accidentally
 	// panic
 	if !target.IsValid() {
-		return target, path.NewErrorf("invalid target")
+		return target, conversionErrorf(path, nil, typ, "invalid target")
 	}
 	// if this is an attr.Value, build the type from that
 	if target.Type().Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
@@ -91,7 +90,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		// all that's left to us now is to set it as an empty value or
 		// throw an error, depending on what's in opts
 		if !opts.UnhandledUnknownAsEmpty {
-			return target, path.NewError(errors.New("unhandled unknown value"))
+			return target, conversionError(path, target.Type(), typ, errors.New("unhandled unknown value"))
 		}
 		// we want to set unhandled unknowns to the empty value
 		return reflect.Zero(target.Type()), nil
@@ -107,7 +106,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		if canBeNil(target) || opts.UnhandledNullAsEmpty {
 			return reflect.Zero(target.Type()), nil
 		}
-		return target, path.NewError(errors.New("unhandled null value"))
+		return target, conversionError(path, target.Type(), typ, errors.New("unhandled null value"))
 	}
 	// *big.Float and *big.Int are technically pointers, but we want them
 	// handled as numbers
@@ -131,11 +130,13 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		return Number(ctx, typ, val, target, opts, path)
 	case reflect.Slice:
 		return reflectSlice(ctx, typ, val, target, opts, path)
+	case reflect.Array:
+		return reflectArray(ctx, typ, val, target, opts, path)
 	case reflect.Map:
 		return Map(ctx, typ, val, target, opts, path)
 	case reflect.Ptr:
 		return Pointer(ctx, typ, val, target, opts, path)
 	default:
-		return target, path.NewErrorf("don't know how to reflect %s into %s", val.Type(), target.Type())
+		return target, conversionErrorf(path, target.Type(), typ, "don't know how to reflect %s into %s", val.Type(), target.Type())
 	}
 }
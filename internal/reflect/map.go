@@ -2,28 +2,30 @@ package reflect
 
 import (
 	"context"
+	"encoding"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Map creates a map value that matches the type of `target`, and populates it
-// with the contents of `val`.
+// with the contents of `val`. It is an error for two keys of `val` to parse to
+// the same key of `target`.
 func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
 	underlyingValue := trueReflectValue(target)
 
 	// this only works with maps, so check that out first
 	if underlyingValue.Kind() != reflect.Map {
-		return target, path.NewErrorf("expected a map type, got %s", target.Type())
+		return target, conversionErrorf(path, target.Type(), typ, "expected a map type, got %s", target.Type())
 	}
 	if !val.Type().Is(tftypes.Map{}) {
-		return target, path.NewErrorf("can't reflect %s into a map, must be a map", val.Type().String())
+		return target, conversionErrorf(path, target.Type(), typ, "can't reflect %s into a map, must be a map", val.Type().String())
 	}
 	elemTyper, ok := typ.(attr.TypeWithElementType)
 	if !ok {
-		return target, path.NewErrorf("can't reflect map using type information provided by %T, %T must be an attr.TypeWithElementType", typ, typ)
+		return target, conversionErrorf(path, target.Type(), typ, "can't reflect map using type information provided by %T, %T must be an attr.TypeWithElementType", typ, typ)
 	}
 
 	// we need our value to become a map of values so we can iterate over
@@ -31,10 +33,12 @@ func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.V
 	values := map[string]tftypes.Value{}
 	err := val.As(&values)
 	if err != nil {
-		return target, path.NewError(err)
+		return target, conversionError(path, target.Type(), typ, err)
 	}
 
-	// we need to know the type the slice is wrapping
+	// we need to know the type the map is wrapping, and the type of
+	// its keys
+	keyType := underlyingValue.Type().Key()
 	elemType := underlyingValue.Type().Elem()
 	elemAttrType := elemTyper.ElementType()
 
@@ -43,26 +47,71 @@ func Map(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.V
 
 	// go over each of the values passed in, create a Go value of the right
 	// type for them, and add it to our new map
-	for key, value := range values {
+	for _, key := range order.Keys(values) {
+		value := values[key]
+		// create a Go value of the key type to index the map with
+		keyValue, err := mapKeyFromString(keyType, key, path)
+		if err != nil {
+			return target, err
+		}
+		if m.MapIndex(keyValue).IsValid() {
+			return target, conversionErrorf(path.WithElementKeyString(key), keyType, nil, "map key %q parses to the same %s as another key", key, keyType)
+		}
+
 		// create a new Go value of the type that can go in the map
 		targetValue := reflect.Zero(elemType)
 
-		// update our path so we can have nice errors
-		path := path.WithElementKeyString(key)
-
 		// reflect the value into our new target
-		result, err := BuildValue(ctx, elemAttrType, value, targetValue, opts, path)
+		result, err := BuildValue(ctx, elemAttrType, value, targetValue, opts, path.WithElementKeyString(key))
 		if err != nil {
 			return target, err
 		}
-		m.SetMapIndex(reflect.ValueOf(key), result)
+		m.SetMapIndex(keyValue, result)
 	}
 	return m, nil
 }
 
+// mapKeyFromString creates a value of `keyType` from the map key `key`. If a
+// pointer to `keyType` implements encoding.TextUnmarshaler, its UnmarshalText
+// method is used to parse `key`. Otherwise, `keyType` must be a string type.
+// `path` is the path to the map; errors are reported at the key's element.
+func mapKeyFromString(keyType reflect.Type, key string, path *tftypes.AttributePath) (reflect.Value, error) {
+	path = path.WithElementKeyString(key)
+	if reflect.PtrTo(keyType).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()) {
+		keyValue := reflect.New(keyType)
+		err := keyValue.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(key))
+		if err != nil {
+			return reflect.Value{}, conversionErrorf(path, keyType, nil, "can't parse map key %q as %s: %w", key, keyType, err)
+		}
+		return keyValue.Elem(), nil
+	}
+	if keyType.Kind() != reflect.String {
+		return reflect.Value{}, conversionErrorf(path, keyType, nil, "map keys must be strings or implement encoding.TextUnmarshaler, got %s", keyType)
+	}
+	return reflect.ValueOf(key).Convert(keyType), nil
+}
+
+// mapKeyToString returns the string form of the map key `key`. If `key`
+// implements encoding.TextMarshaler, its MarshalText method is used to format
+// it. Otherwise, `key` must be a string type.
+func mapKeyToString(key reflect.Value, path *tftypes.AttributePath) (string, error) {
+	if key.Type().Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()) {
+		text, err := key.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", conversionErrorf(path, key.Type(), nil, "can't format map key %v as a string: %w", key.Interface(), err)
+		}
+		return string(text), nil
+	}
+	if key.Kind() != reflect.String {
+		return "", conversionErrorf(path, key.Type(), nil, "map keys must be strings or implement encoding.TextMarshaler, got %s", key.Type())
+	}
+	return key.String(), nil
+}
+
 // FromMap returns an attr.Value representing the data contained in `val`.
-// `val` must be a map type with keys that are a string type. The attr.Value
-// will be of the type produced by `typ`.
+// `val` must be a map type with keys that are a string type or implement
+// encoding.TextMarshaler, and no two keys may format as the same string. The
+// attr.Value will be of the type produced by `typ`.
 //
 // It is meant to be called through OutOf, not directly.
 func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Value, path *tftypes.AttributePath) (attr.Value, error) {
@@ -71,27 +120,37 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 	}
 	elemType := typ.ElementType()
 	tfElems := map[string]tftypes.Value{}
+
+	// convert the keys first, so the elements are converted, and errors
+	// returned, in the order of their keys
+	keys := make(map[string]reflect.Value, val.Len())
 	for _, key := range val.MapKeys() {
-		if key.Kind() != reflect.String {
-			return nil, path.NewErrorf("map keys must be strings, got %s", key.Type())
+		keyString, err := mapKeyToString(key, path)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := keys[keyString]; ok {
+			return nil, conversionErrorf(path.WithElementKeyString(keyString), key.Type(), typ, "more than one map key formats as %q", keyString)
 		}
-		val, err := FromValue(ctx, elemType, val.MapIndex(key).Interface(), path.WithElementKeyString(key.String()))
+		keys[keyString] = key
+	}
+	for _, keyString := range order.Keys(keys) {
+		elem, err := FromValue(ctx, elemType, val.MapIndex(keys[keyString]).Interface(), path.WithElementKeyString(keyString))
 		if err != nil {
 			return nil, err
 		}
-		tfVal, err := val.ToTerraformValue(ctx)
+		tfVal, err := elem.ToTerraformValue(ctx)
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, val.Type(), typ, err)
 		}
-		err = tftypes.ValidateValue(elemType.TerraformType(ctx), tfVal)
+		tfElems[keyString], err = NewTerraformValue(elemType.TerraformType(ctx), tfVal)
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, val.Type(), typ, err)
 		}
-		tfElems[key.String()] = tftypes.NewValue(elemType.TerraformType(ctx), tfVal)
 	}
 	err := tftypes.ValidateValue(typ.TerraformType(ctx), tfElems)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, val.Type(), typ, err)
 	}
 	return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), tfElems))
 }
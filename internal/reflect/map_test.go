@@ -2,9 +2,12 @@ package reflect_test
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -42,3 +45,144 @@ func TestReflectMap_string(t *testing.T) {
 		}
 	}
 }
+
+type mapKeyID struct {
+	Kind string
+	Name string
+}
+
+func (m mapKeyID) MarshalText() ([]byte, error) {
+	return []byte(m.Kind + "/" + m.Name), nil
+}
+
+func (m *mapKeyID) UnmarshalText(text []byte) error {
+	parts := strings.SplitN(string(text), "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected kind/name, got %q", string(text))
+	}
+	m.Kind = parts[0]
+	m.Name = parts[1]
+	return nil
+}
+
+func TestReflectMap_textUnmarshalerKey(t *testing.T) {
+	t.Parallel()
+
+	var m map[mapKeyID]string
+
+	expected := map[mapKeyID]string{
+		{Kind: "color", Name: "a"}: "red",
+		{Kind: "color", Name: "b"}: "blue",
+	}
+
+	result, err := refl.Map(context.Background(), types.MapType{
+		ElemType: types.StringType,
+	}, tftypes.NewValue(tftypes.Map{
+		AttributeType: tftypes.String,
+	}, map[string]tftypes.Value{
+		"color/a": tftypes.NewValue(tftypes.String, "red"),
+		"color/b": tftypes.NewValue(tftypes.String, "blue"),
+	}), reflect.ValueOf(m), refl.Options{}, tftypes.NewAttributePath())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	reflect.ValueOf(&m).Elem().Set(result)
+	if !reflect.DeepEqual(expected, m) {
+		t.Errorf("Expected %v, got %v", expected, m)
+	}
+}
+
+func TestReflectMap_textUnmarshalerKeyError(t *testing.T) {
+	t.Parallel()
+
+	var m map[mapKeyID]string
+
+	_, err := refl.Map(context.Background(), types.MapType{
+		ElemType: types.StringType,
+	}, tftypes.NewValue(tftypes.Map{
+		AttributeType: tftypes.String,
+	}, map[string]tftypes.Value{
+		"invalid": tftypes.NewValue(tftypes.String, "red"),
+	}), reflect.ValueOf(m), refl.Options{}, tftypes.NewAttributePath())
+	expected := `ElementKeyString("invalid"): can't parse map key "invalid" as reflect_test.mapKeyID: expected kind/name, got "invalid"`
+	if err == nil {
+		t.Fatalf("Expected error %q, got nil", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestFromMap_textMarshalerKey(t *testing.T) {
+	t.Parallel()
+
+	expected := types.Map{
+		ElemType: types.StringType,
+		Elems: map[string]attr.Value{
+			"color/a": types.String{Value: "red"},
+		},
+	}
+
+	got, err := refl.FromMap(context.Background(), types.MapType{
+		ElemType: types.StringType,
+	}, reflect.ValueOf(map[mapKeyID]string{
+		{Kind: "color", Name: "a"}: "red",
+	}), tftypes.NewAttributePath())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !expected.Equal(got) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+type caseInsensitiveKey string
+
+func (k caseInsensitiveKey) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(string(k))), nil
+}
+
+func (k *caseInsensitiveKey) UnmarshalText(text []byte) error {
+	*k = caseInsensitiveKey(strings.ToLower(string(text)))
+	return nil
+}
+
+func TestReflectMap_duplicateKey(t *testing.T) {
+	t.Parallel()
+
+	var m map[caseInsensitiveKey]string
+
+	_, err := refl.Map(context.Background(), types.MapType{
+		ElemType: types.StringType,
+	}, tftypes.NewValue(tftypes.Map{
+		AttributeType: tftypes.String,
+	}, map[string]tftypes.Value{
+		"A": tftypes.NewValue(tftypes.String, "red"),
+		"a": tftypes.NewValue(tftypes.String, "blue"),
+	}), reflect.ValueOf(m), refl.Options{}, tftypes.NewAttributePath())
+	expected := `ElementKeyString("a"): map key "a" parses to the same reflect_test.caseInsensitiveKey as another key`
+	if err == nil {
+		t.Fatalf("Expected error %q, got nil", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestFromMap_duplicateKey(t *testing.T) {
+	t.Parallel()
+
+	_, err := refl.FromMap(context.Background(), types.MapType{
+		ElemType: types.StringType,
+	}, reflect.ValueOf(map[caseInsensitiveKey]string{
+		"A": "red",
+		"a": "blue",
+	}), tftypes.NewAttributePath())
+	expected := `ElementKeyString("a"): more than one map key formats as "a"`
+	if err == nil {
+		t.Fatalf("Expected error %q, got nil", expected)
+	}
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
@@ -24,12 +24,15 @@ import (
 //
 // It is meant to be called through Into, not directly.
 func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
-	result := big.NewFloat(0)
+	// use a zero-precision big.Float, so it takes the precision of the
+	// value instead of rounding it to a float64's, which would corrupt
+	// int64s larger than 2^53
+	result := new(big.Float)
 	err := val.As(&result)
 	if err != nil {
-		return target, path.NewError(err)
+		return target, conversionError(path, target.Type(), typ, err)
 	}
-	roundingError := path.NewErrorf("can't store %s in %s", result.String(), target.Type())
+	roundingError := conversionErrorf(path, target.Type(), typ, "can't store %s in %s", result.String(), target.Type())
 	switch target.Type() {
 	case reflect.TypeOf(big.NewFloat(0)):
 		return reflect.ValueOf(result), nil
@@ -158,7 +161,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 		} else if acc == big.Below {
 			floatResult = math.SmallestNonzeroFloat32
 		} else if acc != big.Exact {
-			return target, path.NewErrorf("unsure how to round %s and %f", acc, floatResult)
+			return target, conversionErrorf(path, target.Type(), typ, "unsure how to round %s and %f", acc, floatResult)
 		}
 		return reflect.ValueOf(floatResult), nil
 	case reflect.Float64:
@@ -172,7 +175,7 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			} else if floatResult == 0.0 || floatResult == math.SmallestNonzeroFloat64 {
 				floatResult = -math.SmallestNonzeroFloat64
 			} else {
-				return target, path.NewErrorf("not sure how to round %s and %f", acc, floatResult)
+				return target, conversionErrorf(path, target.Type(), typ, "not sure how to round %s and %f", acc, floatResult)
 			}
 		} else if acc == big.Below {
 			if floatResult == math.Inf(-1) || floatResult == -math.MaxFloat64 {
@@ -180,14 +183,14 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 			} else if floatResult == -0.0 || floatResult == -math.SmallestNonzeroFloat64 { //nolint:staticcheck
 				floatResult = math.SmallestNonzeroFloat64
 			} else {
-				return target, path.NewErrorf("not sure how to round %s and %f", acc, floatResult)
+				return target, conversionErrorf(path, target.Type(), typ, "not sure how to round %s and %f", acc, floatResult)
 			}
 		} else if acc != big.Exact {
-			return target, path.NewErrorf("not sure how to round %s and %f", acc, floatResult)
+			return target, conversionErrorf(path, target.Type(), typ, "not sure how to round %s and %f", acc, floatResult)
 		}
 		return reflect.ValueOf(floatResult), nil
 	}
-	return target, path.NewErrorf("can't convert number to %s", target.Type())
+	return target, conversionErrorf(path, target.Type(), typ, "can't convert number to %s", target.Type())
 }
 
 // FromInt creates an attr.Value using `typ` from an int64.
@@ -196,13 +199,13 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 func FromInt(ctx context.Context, typ attr.Type, val int64, path *tftypes.AttributePath) (attr.Value, error) {
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
 	if err != nil {
-		return nil, err
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 
 	return num, nil
@@ -214,13 +217,13 @@ func FromInt(ctx context.Context, typ attr.Type, val int64, path *tftypes.Attrib
 func FromUint(ctx context.Context, typ attr.Type, val uint64, path *tftypes.AttributePath) (attr.Value, error) {
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
 	if err != nil {
-		return nil, err
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 
 	return num, nil
@@ -232,13 +235,13 @@ func FromUint(ctx context.Context, typ attr.Type, val uint64, path *tftypes.Attr
 func FromFloat(ctx context.Context, typ attr.Type, val float64, path *tftypes.AttributePath) (attr.Value, error) {
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
 	if err != nil {
-		return nil, err
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 
 	return num, nil
@@ -250,13 +253,13 @@ func FromFloat(ctx context.Context, typ attr.Type, val float64, path *tftypes.At
 func FromBigFloat(ctx context.Context, typ attr.Type, val *big.Float, path *tftypes.AttributePath) (attr.Value, error) {
 	err := tftypes.ValidateValue(tftypes.Number, val)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
 	if err != nil {
-		return nil, err
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 
 	return num, nil
@@ -266,16 +269,18 @@ func FromBigFloat(ctx context.Context, typ attr.Type, val *big.Float, path *tfty
 //
 // It is meant to be called through OutOf, not directly.
 func FromBigInt(ctx context.Context, typ attr.Type, val *big.Int, path *tftypes.AttributePath) (attr.Value, error) {
-	fl := big.NewFloat(0).SetInt(val)
+	// use a zero-precision big.Float, so SetInt gives it enough
+	// precision to hold `val` exactly
+	fl := new(big.Float).SetInt(val)
 	err := tftypes.ValidateValue(tftypes.Number, fl)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, fl)
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
 	if err != nil {
-		return nil, err
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 
 	return num, nil
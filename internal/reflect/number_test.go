@@ -1264,6 +1264,13 @@ func TestFromBigInt(t *testing.T) {
 				Value: big.NewFloat(1),
 			},
 		},
+		"larger-than-uint64": {
+			val: new(big.Int).Add(new(big.Int).SetUint64(math.MaxUint64), big.NewInt(2)),
+			typ: types.NumberType,
+			expected: types.Number{
+				Value: new(big.Float).SetPrec(128).Add(new(big.Float).SetUint64(math.MaxUint64), big.NewFloat(2)),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -46,7 +46,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path *tftype
 	case reflect.Struct:
 		t, ok := typ.(attr.TypeWithAttributeTypes)
 		if !ok {
-			return nil, path.NewErrorf("can't use type %T as schema type %T; %T must be an attr.TypeWithAttributeTypes to hold %T", val, typ, typ, val)
+			return nil, conversionErrorf(path, reflect.TypeOf(val), typ, "can't use type %T as schema type %T; %T must be an attr.TypeWithAttributeTypes to hold %T", val, typ, typ, val)
 		}
 		return FromStruct(ctx, t, value, path)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
@@ -61,17 +61,17 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path *tftype
 		return FromBool(ctx, typ, value.Bool(), path)
 	case reflect.String:
 		return FromString(ctx, typ, value.String(), path)
-	case reflect.Slice:
+	case reflect.Slice, reflect.Array:
 		return FromSlice(ctx, typ, value, path)
 	case reflect.Map:
 		t, ok := typ.(attr.TypeWithElementType)
 		if !ok {
-			return nil, path.NewErrorf("can't use type %T as schema type %T; %T must be an attr.TypeWithElementType to hold %T", val, typ, typ, val)
+			return nil, conversionErrorf(path, reflect.TypeOf(val), typ, "can't use type %T as schema type %T; %T must be an attr.TypeWithElementType to hold %T", val, typ, typ, val)
 		}
 		return FromMap(ctx, t, value, path)
 	case reflect.Ptr:
 		return FromPointer(ctx, typ, value, path)
 	default:
-		return nil, path.NewErrorf("don't know how to construct attr.Type from %T (%s)", val, kind)
+		return nil, conversionErrorf(path, reflect.TypeOf(val), typ, "don't know how to construct attr.Type from %T (%s)", val, kind)
 	}
 }
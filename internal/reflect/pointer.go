@@ -15,7 +15,7 @@ import (
 // It is meant to be called through Into, not directly.
 func Pointer(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
 	if target.Kind() != reflect.Ptr {
-		return target, path.NewErrorf("can't dereference pointer, not a pointer, is a %s (%s)", target.Type(), target.Kind())
+		return target, conversionErrorf(path, target.Type(), typ, "can't dereference pointer, not a pointer, is a %s (%s)", target.Type(), target.Kind())
 	}
 	// we may have gotten a nil pointer, so we need to create our own that
 	// we can set
@@ -65,7 +65,7 @@ func pointerSafeZeroValue(ctx context.Context, target reflect.Value) reflect.Val
 // It is meant to be called through OutOf, not directly.
 func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path *tftypes.AttributePath) (attr.Value, error) {
 	if value.Kind() != reflect.Ptr {
-		return nil, path.NewErrorf("can't use type %s as a pointer", value.Type())
+		return nil, conversionErrorf(path, value.Type(), typ, "can't use type %s as a pointer", value.Type())
 	}
 	if value.IsNil() {
 		return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
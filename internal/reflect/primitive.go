@@ -18,18 +18,18 @@ func Primitive(ctx context.Context, typ attr.Type, val tftypes.Value, target ref
 		var b bool
 		err := val.As(&b)
 		if err != nil {
-			return target, path.NewError(err)
+			return target, conversionError(path, target.Type(), typ, err)
 		}
 		return reflect.ValueOf(b).Convert(target.Type()), nil
 	case reflect.String:
 		var s string
 		err := val.As(&s)
 		if err != nil {
-			return target, path.NewError(err)
+			return target, conversionError(path, target.Type(), typ, err)
 		}
 		return reflect.ValueOf(s).Convert(target.Type()), nil
 	default:
-		return target, path.NewErrorf("unrecognized type %s (this should never happen)", target.Kind())
+		return target, conversionErrorf(path, target.Type(), typ, "unrecognized type %s (this should never happen)", target.Kind())
 	}
 }
 
@@ -39,13 +39,13 @@ func Primitive(ctx context.Context, typ attr.Type, val tftypes.Value, target ref
 func FromString(ctx context.Context, typ attr.Type, val string, path *tftypes.AttributePath) (attr.Value, error) {
 	err := tftypes.ValidateValue(tftypes.String, val)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfStr := tftypes.NewValue(tftypes.String, val)
 
 	str, err := typ.ValueFromTerraform(ctx, tfStr)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 
 	return str, nil
@@ -57,13 +57,13 @@ func FromString(ctx context.Context, typ attr.Type, val string, path *tftypes.At
 func FromBool(ctx context.Context, typ attr.Type, val bool, path *tftypes.AttributePath) (attr.Value, error) {
 	err := tftypes.ValidateValue(tftypes.Bool, val)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 	tfBool := tftypes.NewValue(tftypes.Bool, val)
 
 	b, err := typ.ValueFromTerraform(ctx, tfBool)
 	if err != nil {
-		return nil, err
+		return nil, conversionError(path, reflect.TypeOf(val), typ, err)
 	}
 
 	return b, nil
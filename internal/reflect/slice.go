@@ -14,12 +14,12 @@ import (
 func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
 	// this only works with slices, so check that out first
 	if target.Kind() != reflect.Slice {
-		return target, path.NewErrorf("expected a slice type, got %s", target.Type())
+		return target, conversionErrorf(path, target.Type(), typ, "expected a slice type, got %s", target.Type())
 	}
 	// TODO: check that the val is a list or set or tuple
 	elemTyper, ok := typ.(attr.TypeWithElementType)
 	if !ok {
-		return target, path.NewErrorf("can't reflect %s using type information provided by %T, %T must be an attr.TypeWithElementType", val.Type(), typ, typ)
+		return target, conversionErrorf(path, target.Type(), typ, "can't reflect %s using type information provided by %T, %T must be an attr.TypeWithElementType", val.Type(), typ, typ)
 	}
 
 	// we need our value to become a list of values so we can iterate over
@@ -27,7 +27,7 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 	var values []tftypes.Value
 	err := val.As(&values)
 	if err != nil {
-		return target, path.NewError(err)
+		return target, conversionError(path, target.Type(), typ, err)
 	}
 
 	// we need to know the type the slice is wrapping
@@ -59,10 +59,42 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 	return slice, nil
 }
 
+// build an array matching the type of `target`, which must have exactly as
+// many elements as `val`, and fill it with the data in `val`.
+func reflectArray(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	if target.Kind() != reflect.Array {
+		return target, conversionErrorf(path, target.Type(), typ, "expected an array type, got %s", target.Type())
+	}
+	elemTyper, ok := typ.(attr.TypeWithElementType)
+	if !ok {
+		return target, conversionErrorf(path, target.Type(), typ, "can't reflect %s using type information provided by %T, %T must be an attr.TypeWithElementType", val.Type(), typ, typ)
+	}
+
+	var values []tftypes.Value
+	err := val.As(&values)
+	if err != nil {
+		return target, conversionError(path, target.Type(), typ, err)
+	}
+	if len(values) != target.Len() {
+		return target, conversionErrorf(path, target.Type(), typ, "expected exactly %d elements to fill %s, got %d", target.Len(), target.Type(), len(values))
+	}
+
+	array := reflect.New(target.Type()).Elem()
+	for pos, value := range values {
+		path := path.WithElementKeyInt(int64(pos))
+		val, err := BuildValue(ctx, elemTyper.ElementType(), value, array.Index(pos), opts, path)
+		if err != nil {
+			return target, err
+		}
+		array.Index(pos).Set(val)
+	}
+	return array, nil
+}
+
 // FromSlice returns an attr.Value as produced by `typ` using the data in
-// `val`. `val` must be a slice. `typ` must be an attr.TypeWithElementType or
-// attr.TypeWithElementTypes. If the slice is nil, the representation of null
-// for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
+// `val`. `val` must be a slice or an array. `typ` must be an
+// attr.TypeWithElementType or attr.TypeWithElementTypes. If the slice is nil,
+// the representation of null for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
 // for each element in the slice, using the element type or types defined on
 // `typ` to construct values for them.
 //
@@ -70,35 +102,35 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path *tftypes.AttributePath) (attr.Value, error) {
 	// TODO: support tuples, which are attr.TypeWithElementTypes
 
-	if val.IsNil() {
+	if val.Kind() == reflect.Slice && val.IsNil() {
 		return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
 	}
 
 	t, ok := typ.(attr.TypeWithElementType)
 	if !ok {
-		return nil, path.NewErrorf("can't use type %T as schema type %T; %T must be an attr.TypeWithElementType to hold %T", val, typ, typ, val)
+		return nil, conversionErrorf(path, val.Type(), typ, "can't use type %T as schema type %T; %T must be an attr.TypeWithElementType to hold %T", val, typ, typ, val)
 	}
 
 	elemType := t.ElementType()
 	tfElems := make([]tftypes.Value, 0, val.Len())
 	for i := 0; i < val.Len(); i++ {
-		val, err := FromValue(ctx, elemType, val.Index(i).Interface(), path.WithElementKeyInt(int64(i)))
+		elem, err := FromValue(ctx, elemType, val.Index(i).Interface(), path.WithElementKeyInt(int64(i)))
 		if err != nil {
 			return nil, err
 		}
-		tfVal, err := val.ToTerraformValue(ctx)
+		tfVal, err := elem.ToTerraformValue(ctx)
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, val.Type(), typ, err)
 		}
-		err = tftypes.ValidateValue(elemType.TerraformType(ctx), tfVal)
+		tfElem, err := NewTerraformValue(elemType.TerraformType(ctx), tfVal)
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, val.Type(), typ, err)
 		}
-		tfElems = append(tfElems, tftypes.NewValue(elemType.TerraformType(ctx), tfVal))
+		tfElems = append(tfElems, tfElem)
 	}
 	err := tftypes.ValidateValue(typ.TerraformType(ctx), tfElems)
 	if err != nil {
-		return nil, path.NewError(err)
+		return nil, conversionError(path, val.Type(), typ, err)
 	}
 	return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), tfElems))
 }
@@ -0,0 +1,98 @@
+package reflect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testZones(zones ...interface{}) tftypes.Value {
+	vals := make([]tftypes.Value, 0, len(zones))
+	for _, zone := range zones {
+		vals = append(vals, tftypes.NewValue(tftypes.String, zone))
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"zones": tftypes.List{ElementType: tftypes.String},
+	}}, map[string]tftypes.Value{
+		"zones": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, vals),
+	})
+}
+
+type testZonesModel struct {
+	Zones [3]string `tfsdk:"zones"`
+}
+
+var testZonesType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"zones": types.ListType{ElemType: types.StringType},
+}}
+
+func TestInto_array(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         tftypes.Value
+		expected    testZonesModel
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"exact": {
+			val:      testZones("a", "b", "c"),
+			expected: testZonesModel{Zones: [3]string{"a", "b", "c"}},
+		},
+		"too-few": {
+			val:         testZones("a", "b"),
+			expectedErr: `AttributeName("zones"): expected exactly 3 elements to fill [3]string, got 2`,
+		},
+		"too-many": {
+			val:         testZones("a", "b", "c", "d"),
+			expectedErr: `AttributeName("zones"): expected exactly 3 elements to fill [3]string, got 4`,
+		},
+		"null-element": {
+			val:         testZones("a", nil, "c"),
+			expectedErr: `AttributeName("zones").ElementKeyInt(1): unhandled null value`,
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testZonesModel
+			err := refl.Into(context.Background(), testZonesType, tc.val, &got, refl.Options{})
+			if err != nil {
+				if err.Error() != tc.expectedErr {
+					t.Errorf("Expected error %q, got %q", tc.expectedErr, err)
+				}
+				return
+			}
+			if tc.expectedErr != "" {
+				t.Fatalf("Expected error %q, got none", tc.expectedErr)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutOf_array(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got, err := refl.OutOf(ctx, testZonesType, testZonesModel{Zones: [3]string{"a", "b", "c"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected, err := testZonesType.ValueFromTerraform(ctx, testZones("a", "b", "c"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
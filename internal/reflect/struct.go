@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -22,31 +23,35 @@ import (
 // explicitly defining them as not part of the object. This is to catch typos
 // and other mistakes early.
 //
+// The field name may be followed by comma-separated options, like
+// `tfsdk:"created_at,omitempty,format=rfc3339"`. See tagOptions for the
+// options that can be set.
+//
 // Struct is meant to be called from Into, not directly.
 func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
 	// this only works with object values, so make sure that constraint is
 	// met
 	if target.Kind() != reflect.Struct {
-		return target, path.NewErrorf("expected a struct type, got %s", target.Type())
+		return target, conversionErrorf(path, target.Type(), typ, "expected a struct type, got %s", target.Type())
 	}
 	if !object.Type().Is(tftypes.Object{}) {
-		return target, path.NewErrorf("can't reflect %s into a struct, must be an object", object.Type().String())
+		return target, conversionErrorf(path, target.Type(), typ, "can't reflect %s into a struct, must be an object", object.Type().String())
 	}
 	attrsType, ok := typ.(attr.TypeWithAttributeTypes)
 	if !ok {
-		return target, path.NewErrorf("can't reflect object using type information provided by %T, %T must be an attr.TypeWithAttributeTypes", typ, typ)
+		return target, conversionErrorf(path, target.Type(), typ, "can't reflect object using type information provided by %T, %T must be an attr.TypeWithAttributeTypes", typ, typ)
 	}
 
 	// collect a map of fields that are in the object passed in
 	var objectFields map[string]tftypes.Value
 	err := object.As(&objectFields)
 	if err != nil {
-		return target, path.NewErrorf("unexpected error converting object: %w", err)
+		return target, conversionErrorf(path, target.Type(), typ, "unexpected error converting object: %w", err)
 	}
 
 	// collect a map of fields that are defined in the tags of the struct
 	// passed in
-	targetFields, err := getStructTags(ctx, target, path)
+	targetFields, err := StructFields(ctx, target, path)
 	if err != nil {
 		return target, fmt.Errorf("error retrieving field names from struct tags: %w", err)
 	}
@@ -55,12 +60,12 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	// leading to surprises, so let's ensure they have the exact same
 	// fields defined
 	var objectMissing, targetMissing []string
-	for field := range targetFields {
+	for _, field := range order.Keys(targetFields) {
 		if _, ok := objectFields[field]; !ok {
 			objectMissing = append(objectMissing, field)
 		}
 	}
-	for field := range objectFields {
+	for _, field := range order.Keys(objectFields) {
 		if _, ok := targetFields[field]; !ok {
 			targetMissing = append(targetMissing, field)
 		}
@@ -73,7 +78,7 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 		if len(targetMissing) > 0 {
 			missing = append(missing, fmt.Sprintf("Object defines fields not found in struct: %s.", commaSeparatedString(targetMissing)))
 		}
-		return target, path.NewErrorf("mismatch between struct and object: %s", strings.Join(missing, " "))
+		return target, conversionErrorf(path, target.Type(), typ, "mismatch between struct and object: %s", strings.Join(missing, " "))
 	}
 
 	attrTypes := attrsType.AttributeTypes()
@@ -81,13 +86,14 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	// now that we know they match perfectly, fill the struct with the
 	// values in the object
 	result := reflect.New(target.Type()).Elem()
-	for field, structFieldPos := range targetFields {
+	for _, field := range order.Keys(targetFields) {
+		targetField := targetFields[field]
 		attrType, ok := attrTypes[field]
 		if !ok {
-			return target, path.WithAttributeName(field).NewErrorf("couldn't find type information for attribute in supplied attr.Type %T", typ)
+			return target, conversionErrorf(path.WithAttributeName(field), target.Type(), typ, "couldn't find type information for attribute in supplied attr.Type %T", typ)
 		}
-		structField := result.Field(structFieldPos)
-		fieldVal, err := BuildValue(ctx, attrType, objectFields[field], structField, opts, path.WithAttributeName(field))
+		structField := result.Field(targetField.Index)
+		fieldVal, err := buildFieldValue(ctx, attrType, objectFields[field], structField, targetField.Options, opts, path.WithAttributeName(field))
 		if err != nil {
 			return target, err
 		}
@@ -96,6 +102,22 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	return result, nil
 }
 
+// buildFieldValue builds the value of a struct field from `val`, applying
+// the options set on the field's struct tag before falling back to
+// BuildValue.
+func buildFieldValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, tagOpts TagOptions, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	if tagOpts.Has("lazy") {
+		return NewLazyValue(ctx, typ, val, target, path)
+	}
+	if tagOpts.Has("omitempty") && val.IsNull() {
+		return reflect.Zero(target.Type()), nil
+	}
+	if tagOpts.Has("format") {
+		return buildTimeValue(val, target, tagOpts.Get("format"), opts, path)
+	}
+	return BuildValue(ctx, typ, val, target, opts, path)
+}
+
 // FromStruct builds an attr.Value as produced by `typ` from the data in `val`.
 // `val` must be a struct type, and must have all its properties tagged and be
 // a 1:1 match with the attributes reported by `typ`. FromStruct will recurse
@@ -109,37 +131,37 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 
 	// collect a map of fields that are defined in the tags of the struct
 	// passed in
-	targetFields, err := getStructTags(ctx, val, path)
+	targetFields, err := StructFields(ctx, val, path)
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving field names from struct tags: %w", err)
 	}
 
 	attrTypes := typ.AttributeTypes()
-	for name, fieldNo := range targetFields {
+	for _, name := range order.Keys(targetFields) {
+		targetField := targetFields[name]
 		path := path.WithAttributeName(name)
-		fieldValue := val.Field(fieldNo)
-
-		attrVal, err := FromValue(ctx, attrTypes[name], fieldValue.Interface(), path)
-		if err != nil {
-			return nil, err
-		}
+		fieldValue := val.Field(targetField.Index)
 
 		attrType, ok := attrTypes[name]
 		if !ok || attrType == nil {
-			return nil, path.NewErrorf("couldn't find type information for attribute in supplied attr.Type %T", typ)
+			return nil, conversionErrorf(path, val.Type(), typ, "couldn't find type information for attribute in supplied attr.Type %T", typ)
+		}
+
+		attrVal, err := fromFieldValue(ctx, attrType, fieldValue, targetField.Options, path)
+		if err != nil {
+			return nil, err
 		}
 
 		objTypes[name] = attrType.TerraformType(ctx)
 
 		tfVal, err := attrVal.ToTerraformValue(ctx)
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, val.Type(), typ, err)
 		}
-		err = tftypes.ValidateValue(objTypes[name], tfVal)
+		objValues[name], err = NewTerraformValue(objTypes[name], tfVal)
 		if err != nil {
-			return nil, path.NewError(err)
+			return nil, conversionError(path, val.Type(), typ, err)
 		}
-		objValues[name] = tftypes.NewValue(objTypes[name], tfVal)
 	}
 
 	tfVal := tftypes.NewValue(tftypes.Object{
@@ -149,8 +171,36 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 	retType := typ.WithAttributeTypes(attrTypes)
 	ret, err := retType.ValueFromTerraform(ctx, tfVal)
 	if err != nil {
-		return nil, err
+		return nil, conversionError(path, val.Type(), typ, err)
 	}
 
 	return ret, nil
 }
+
+// fromFieldValue builds an attr.Value as produced by `typ` from the struct
+// field `val`, applying the options set on the field's struct tag before
+// falling back to FromValue.
+func fromFieldValue(ctx context.Context, typ attr.Type, val reflect.Value, tagOpts TagOptions, path *tftypes.AttributePath) (attr.Value, error) {
+	if tagOpts.Has("omitempty") && val.IsZero() {
+		res, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+		if err != nil {
+			return nil, conversionError(path, val.Type(), typ, err)
+		}
+		return res, nil
+	}
+	if tagOpts.Has("format") {
+		if !typ.TerraformType(ctx).Is(tftypes.String) {
+			return nil, conversionErrorf(path, val.Type(), typ, "can't use format %q with %s, must be a string", tagOpts.Get("format"), typ.TerraformType(ctx))
+		}
+		tfVal, err := fromTimeValue(val, tagOpts.Get("format"), path)
+		if err != nil {
+			return nil, err
+		}
+		res, err := typ.ValueFromTerraform(ctx, tfVal)
+		if err != nil {
+			return nil, conversionError(path, val.Type(), typ, err)
+		}
+		return res, nil
+	}
+	return FromValue(ctx, typ, val.Interface(), path)
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -656,3 +657,166 @@ func TestFromStruct_complex(t *testing.T) {
 		t.Errorf("Didn't get expected value. Diff (+ is expected, - is result): %s", diff)
 	}
 }
+
+func TestNewStruct_tagOptions(t *testing.T) {
+	t.Parallel()
+
+	type event struct {
+		Name      string    `tfsdk:"name,omitempty"`
+		CreatedAt time.Time `tfsdk:"created_at,format=rfc3339"`
+	}
+
+	var e event
+	result, err := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":       types.StringType,
+			"created_at": types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":       tftypes.String,
+			"created_at": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"name":       tftypes.NewValue(tftypes.String, nil),
+		"created_at": tftypes.NewValue(tftypes.String, "2021-06-01T12:30:00Z"),
+	}), reflect.ValueOf(e), refl.Options{}, tftypes.NewAttributePath())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	reflect.ValueOf(&e).Elem().Set(result)
+
+	expected := event{
+		CreatedAt: time.Date(2021, time.June, 1, 12, 30, 0, 0, time.UTC),
+	}
+	if e.Name != expected.Name || !e.CreatedAt.Equal(expected.CreatedAt) {
+		t.Errorf("Expected %+v, got %+v", expected, e)
+	}
+}
+
+func TestNewStruct_lazy(t *testing.T) {
+	t.Parallel()
+
+	type resource struct {
+		Name  string     `tfsdk:"name"`
+		Rules types.Lazy `tfsdk:"rules,lazy"`
+	}
+
+	rulesType := types.ListType{ElemType: types.StringType}
+	objType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  types.StringType,
+			"rules": rulesType,
+		},
+	}
+	rules := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "allow"),
+		tftypes.NewValue(tftypes.String, "deny"),
+	})
+	obj := tftypes.NewValue(objType.TerraformType(context.Background()), map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, "example"),
+		"rules": rules,
+	})
+
+	var r resource
+	err := refl.Into(context.Background(), objType, obj, &r, refl.Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if r.Name != "example" {
+		t.Errorf("Expected name to be %q, got %q", "example", r.Name)
+	}
+	if !r.Rules.Raw().Equal(rules) {
+		t.Errorf("Expected lazy value to hold %s, got %s", rules, r.Rules.Raw())
+	}
+	got, err := r.Rules.Value(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := types.List{
+		ElemType: types.StringType,
+		Elems: []attr.Value{
+			types.String{Value: "allow"},
+			types.String{Value: "deny"},
+		},
+	}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// the lazy value must round trip back into a value
+	roundTrip, err := refl.OutOf(context.Background(), objType, r)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	raw, err := roundTrip.ToTerraformValue(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if roundTripVal := tftypes.NewValue(objType.TerraformType(context.Background()), raw); !roundTripVal.Equal(obj) {
+		t.Errorf("Expected round trip to produce %s, got %s", obj, roundTripVal)
+	}
+}
+
+func TestNewStruct_tagOptionsInvalidTime(t *testing.T) {
+	t.Parallel()
+
+	type event struct {
+		CreatedAt time.Time `tfsdk:"created_at,format=rfc3339"`
+	}
+
+	var e event
+	_, err := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"created_at": types.StringType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"created_at": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"created_at": tftypes.NewValue(tftypes.String, "yesterday"),
+	}), reflect.ValueOf(e), refl.Options{}, tftypes.NewAttributePath())
+	if err == nil {
+		t.Fatal("Expected error, didn't get one")
+	}
+	if expected := `AttributeName("created_at"): can't parse "yesterday" using format "rfc3339": parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`; expected != err.Error() {
+		t.Errorf("Expected error to be %q, got %q", expected, err.Error())
+	}
+}
+
+func TestFromStruct_tagOptions(t *testing.T) {
+	t.Parallel()
+
+	type event struct {
+		Name      string    `tfsdk:"name,omitempty"`
+		CreatedAt time.Time `tfsdk:"created_at,format=rfc3339"`
+	}
+
+	actualVal, err := refl.FromStruct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":       types.StringType,
+			"created_at": types.StringType,
+		},
+	}, reflect.ValueOf(event{
+		CreatedAt: time.Date(2021, time.June, 1, 12, 30, 0, 0, time.UTC),
+	}), tftypes.NewAttributePath())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedVal := types.Object{
+		Attrs: map[string]attr.Value{
+			"name":       types.String{Null: true},
+			"created_at": types.String{Value: "2021-06-01T12:30:00Z"},
+		},
+		AttrTypes: map[string]attr.Type{
+			"name":       types.StringType,
+			"created_at": types.StringType,
+		},
+	}
+
+	if diff := cmp.Diff(expectedVal, actualVal); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,218 @@
+package reflect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TagOptions holds the options set on a struct field's "tfsdk" tag after the
+// field name, like `tfsdk:"name,omitempty,format=rfc3339"`. Options that
+// don't take a value are mapped to an empty string.
+type TagOptions map[string]string
+
+// Has returns true if the option `name` is set.
+func (t TagOptions) Has(name string) bool {
+	_, ok := t[name]
+	return ok
+}
+
+// Get returns the value of the option `name`, or an empty string if it isn't
+// set.
+func (t TagOptions) Get(name string) string {
+	return t[name]
+}
+
+// StructField describes a struct field that maps to an attribute.
+type StructField struct {
+	// Index is the position of the field in the struct.
+	Index int
+
+	// Options are the options set on the field's "tfsdk" tag.
+	Options TagOptions
+}
+
+// tagOption describes an option that can be set on a "tfsdk" struct tag.
+type tagOption struct {
+	// takesValue indicates that the option must be set using
+	// `option=value` syntax. Options that don't take a value must be set
+	// using just their name.
+	takesValue bool
+
+	// validate, if set, checks that the option can be used on `field`
+	// with the value `value`.
+	validate func(field reflect.StructField, value string) error
+}
+
+// tagOptions is the registry of options that can be set on "tfsdk" struct
+// tags. Any option not in this registry will produce an error.
+//
+// omitempty treats null values as the zero value of the field when reading
+// into the struct, and the zero value of the field as null when building a
+// value from the struct. It only applies to plain Go types; it can't be used
+// on attr.Value fields, which represent null themselves.
+//
+// format controls how the field is represented as a string. It can only be
+// used on time.Time fields; see timeFormats for the accepted values.
+//
+// lazy stores the field's tftypes.Value without converting it, so expensive
+// conversions can be put off until the value is needed. It can only be used
+// on fields whose pointers implement LazyValue, like types.Lazy.
+var tagOptions = map[string]tagOption{
+	"omitempty": {
+		validate: validateOmitEmptyTagOption,
+	},
+	"format": {
+		takesValue: true,
+		validate:   validateFormatTagOption,
+	},
+	"lazy": {
+		validate: validateLazyTagOption,
+	},
+}
+
+// timeFormats are the values accepted by the format tag option, and the time
+// layouts they correspond to.
+var timeFormats = map[string]string{
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+}
+
+// parseStructTag splits the "tfsdk" tag of `field` into its name and its
+// options, validating the options against the tagOptions registry.
+func parseStructTag(field reflect.StructField, tag string, path *tftypes.AttributePath) (string, TagOptions, error) {
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if len(parts) == 1 {
+		return name, nil, nil
+	}
+	if name == "-" {
+		return "", nil, conversionErrorf(path, field.Type, nil, `can't set options on %s, it is excluded with a "-" tag`, field.Name)
+	}
+	opts := TagOptions{}
+	for _, part := range parts[1:] {
+		optName, value := part, ""
+		hasValue := false
+		if i := strings.Index(part, "="); i >= 0 {
+			optName, value = part[:i], part[i+1:]
+			hasValue = true
+		}
+		opt, ok := tagOptions[optName]
+		if !ok {
+			return "", nil, conversionErrorf(path, field.Type, nil, "unknown option %q in struct tag for %s, must be one of %s", optName, field.Name, knownTagOptions())
+		}
+		if opts.Has(optName) {
+			return "", nil, conversionErrorf(path, field.Type, nil, "option %q set more than once in struct tag for %s", optName, field.Name)
+		}
+		if opt.takesValue && !hasValue {
+			return "", nil, conversionErrorf(path, field.Type, nil, "option %q in struct tag for %s needs a value, use %s=<value>", optName, field.Name, optName)
+		}
+		if !opt.takesValue && hasValue {
+			return "", nil, conversionErrorf(path, field.Type, nil, "option %q in struct tag for %s doesn't take a value", optName, field.Name)
+		}
+		if opt.validate != nil {
+			if err := opt.validate(field, value); err != nil {
+				return "", nil, conversionErrorf(path, field.Type, nil, "invalid option %q in struct tag for %s: %w", optName, field.Name, err)
+			}
+		}
+		opts[optName] = value
+	}
+	return name, opts, nil
+}
+
+// knownTagOptions returns an English list of the options in the tagOptions
+// registry.
+func knownTagOptions() string {
+	names := make([]string, 0, len(tagOptions))
+	for name := range tagOptions {
+		names = append(names, name)
+	}
+	return quotedAlternatives(names)
+}
+
+// quotedAlternatives returns an English list of the strings in `in`, quoted,
+// sorted, and joined using "or" and commas as appropriate.
+func quotedAlternatives(in []string) string {
+	quoted := make([]string, 0, len(in))
+	for _, s := range in {
+		quoted = append(quoted, fmt.Sprintf("%q", s))
+	}
+	sort.Strings(quoted)
+	return strings.Replace(commaSeparatedString(quoted), " and ", " or ", 1)
+}
+
+// validateFormatTagOption checks that the format option is only used on
+// time.Time fields, with a known format.
+func validateFormatTagOption(field reflect.StructField, value string) error {
+	if field.Type != reflect.TypeOf(time.Time{}) {
+		return fmt.Errorf("can only be used on time.Time fields, not %s", field.Type)
+	}
+	if _, ok := timeFormats[value]; !ok {
+		formats := make([]string, 0, len(timeFormats))
+		for format := range timeFormats {
+			formats = append(formats, format)
+		}
+		return fmt.Errorf("unknown format %q, must be one of %s", value, quotedAlternatives(formats))
+	}
+	return nil
+}
+
+// validateOmitEmptyTagOption checks that the omitempty option isn't used on
+// attr.Value fields, whose zero value isn't necessarily null.
+func validateOmitEmptyTagOption(field reflect.StructField, _ string) error {
+	if field.Type.Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
+		return fmt.Errorf("can't be used on attr.Value fields like %s, which can be null themselves", field.Type)
+	}
+	return nil
+}
+
+// validateLazyTagOption checks that the lazy option is only used on fields
+// whose pointers implement LazyValue.
+func validateLazyTagOption(field reflect.StructField, _ string) error {
+	if !reflect.PtrTo(field.Type).Implements(reflect.TypeOf((*LazyValue)(nil)).Elem()) {
+		return fmt.Errorf("can only be used on fields implementing LazyValue, like types.Lazy, not %s", field.Type)
+	}
+	return nil
+}
+
+// buildTimeValue parses the string in `val` as a time.Time, using the layout
+// named by `format`.
+func buildTimeValue(val tftypes.Value, target reflect.Value, format string, opts Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	if !val.IsKnown() {
+		if !opts.UnhandledUnknownAsEmpty {
+			return target, conversionError(path, target.Type(), nil, errors.New("unhandled unknown value"))
+		}
+		return reflect.Zero(target.Type()), nil
+	}
+	if val.IsNull() {
+		if !opts.UnhandledNullAsEmpty {
+			return target, conversionError(path, target.Type(), nil, errors.New("unhandled null value"))
+		}
+		return reflect.Zero(target.Type()), nil
+	}
+	var s string
+	if err := val.As(&s); err != nil {
+		return target, conversionErrorf(path, target.Type(), nil, "can't use format %q with %s, must be a string", format, val.Type())
+	}
+	t, err := time.Parse(timeFormats[format], s)
+	if err != nil {
+		return target, conversionErrorf(path, target.Type(), nil, "can't parse %q using format %q: %w", s, format, err)
+	}
+	return reflect.ValueOf(t), nil
+}
+
+// fromTimeValue formats the time.Time in `val` as a string, using the layout
+// named by `format`.
+func fromTimeValue(val reflect.Value, format string, path *tftypes.AttributePath) (tftypes.Value, error) {
+	t, ok := val.Interface().(time.Time)
+	if !ok {
+		return tftypes.Value{}, conversionErrorf(path, val.Type(), nil, "can't use format %q with %s, must be a time.Time", format, val.Type())
+	}
+	return tftypes.NewValue(tftypes.String, t.Format(timeFormats[format])), nil
+}
@@ -0,0 +1,54 @@
+// Package providerdata gets the concrete type of the provider passed to
+// NewResource and NewDataSource, replacing the type assertion at the top of
+// each of those methods, with diagnostics practitioners can report.
+package providerdata
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Get stores `p` in `target`, which must be a pointer to the type
+// implementing tfsdk.Provider, or to an interface it implements, like:
+//
+//	func (t serverType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, []*tfprotov6.Diagnostic) {
+//		var prov *provider
+//		if diags := providerdata.Get(p, &prov); len(diags) > 0 {
+//			return nil, diags
+//		}
+//		return server{client: prov.client}, nil
+//	}
+//
+// It returns an error diagnostic, and leaves `target` unchanged, if `p` is
+// nil or a nil pointer, or can't be stored in `target`.
+func Get(p tfsdk.Provider, target interface{}) []*tfprotov6.Diagnostic {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errorDiags("Invalid provider data target", fmt.Sprintf("The provider can't be stored in %T, it must be a non-nil pointer.", target))
+	}
+	if p == nil {
+		return errorDiags("Unconfigured provider", "The provider wasn't set when it was needed.")
+	}
+	pv := reflect.ValueOf(p)
+	if pv.Kind() == reflect.Ptr && pv.IsNil() {
+		return errorDiags("Unconfigured provider", fmt.Sprintf("The provider was a nil %T when it was needed.", p))
+	}
+	if !pv.Type().AssignableTo(v.Elem().Type()) {
+		return errorDiags("Unexpected provider type", fmt.Sprintf("Expected the provider to be a %s, got %T.", v.Elem().Type(), p))
+	}
+	v.Elem().Set(pv)
+	return nil
+}
+
+func errorDiags(summary, detail string) []*tfprotov6.Diagnostic {
+	return []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  summary,
+			Detail:   detail + " This is always a problem with the provider. Please report this to the provider developer.",
+		},
+	}
+}
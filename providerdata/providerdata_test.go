@@ -0,0 +1,92 @@
+package providerdata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+type testProvider struct {
+	tfsdk.Manifest
+
+	client string
+}
+
+func (p *testProvider) GetSchema(_ context.Context) (schema.Schema, []*tfprotov6.Diagnostic) {
+	return schema.Schema{}, nil
+}
+
+func (p *testProvider) Configure(_ context.Context, _ tfsdk.ConfigureProviderRequest, _ *tfsdk.ConfigureProviderResponse) {
+}
+
+type otherProvider struct {
+	testProvider
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	errorDiag := func(summary, detail string) []*tfprotov6.Diagnostic {
+		return []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  summary,
+				Detail:   detail + " This is always a problem with the provider. Please report this to the provider developer.",
+			},
+		}
+	}
+
+	type testCase struct {
+		provider      tfsdk.Provider
+		expected      *testProvider
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+	tests := map[string]testCase{
+		"provider": {
+			provider: &testProvider{client: "api"},
+			expected: &testProvider{client: "api"},
+		},
+		"nil": {
+			expectedDiags: errorDiag("Unconfigured provider", "The provider wasn't set when it was needed."),
+		},
+		"nil-pointer": {
+			provider:      (*testProvider)(nil),
+			expectedDiags: errorDiag("Unconfigured provider", "The provider was a nil *providerdata.testProvider when it was needed."),
+		},
+		"wrong-type": {
+			provider:      &otherProvider{},
+			expectedDiags: errorDiag("Unexpected provider type", "Expected the provider to be a *providerdata.testProvider, got *providerdata.otherProvider."),
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *testProvider
+			diags := Get(tc.provider, &got)
+			if diff := cmp.Diff(tc.expectedDiags, diags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.expected, got, cmp.AllowUnexported(testProvider{})); diff != "" {
+				t.Errorf("Unexpected diff in provider (+wanted, -got): %s", diff)
+			}
+		})
+	}
+
+	// interfaces the provider implements can be used too
+	var prov tfsdk.Provider
+	if diags := Get(&testProvider{}, &prov); len(diags) > 0 {
+		t.Errorf("Unexpected diagnostics: %+v", diags)
+	}
+
+	diags := Get(&testProvider{}, testProvider{})
+	expectedDiags := errorDiag("Invalid provider data target", "The provider can't be stored in providerdata.testProvider, it must be a non-nil pointer.")
+	if diff := cmp.Diff(expectedDiags, diags); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+}
@@ -36,6 +36,12 @@ type Attribute struct {
 	// used. It should be formatted using Markdown.
 	MarkdownDescription string
 
+	// DescriptionTemplates indicates whether Description and
+	// MarkdownDescription are text/template templates, rendered with
+	// DescriptionData when the schema is returned to Terraform. If false,
+	// they're used exactly as written.
+	DescriptionTemplates bool
+
 	// Required indicates whether the practitioner must enter a value for
 	// this attribute or not. Required and Optional cannot both be true,
 	// and Required and Computed cannot both be true.
@@ -64,6 +70,66 @@ type Attribute struct {
 	// using this attribute, warning them that it is deprecated and
 	// instructing them on what upgrade steps to take.
 	DeprecationMessage string
+
+	// IgnoreListOrder indicates that the order of the elements of this
+	// attribute doesn't matter, for lists whose order is chosen by the
+	// API rather than the practitioner. When the list the provider returns
+	// from Read contains the same elements as the prior state in a
+	// different order, or the list it returns from Create or Update
+	// contains the same elements as the plan in a different order, the
+	// framework keeps the prior order, so the reordering doesn't show up
+	// as a difference. It only has an effect on attributes whose Type is a
+	// list type or that use ListNestedAttributes.
+	IgnoreListOrder bool
+
+	// EmptyObject controls how an attribute using SingleNestedAttributes
+	// is represented when it has no values, so the same empty object
+	// isn't alternately stored as null and as an object whose attributes
+	// are all null, showing up as a difference on every plan. It has no
+	// effect on other attributes.
+	EmptyObject EmptyObjectMode
+
+	// EnvVars and Default fill in a value for a top-level attribute of a
+	// provider's schema when it's null in the provider configuration,
+	// before the provider's Configure method is called. The value of the
+	// first environment variable in EnvVars that is set to a non-empty
+	// string is used, converted to the attribute's type, which must be a
+	// string, number, or bool. If none of them are set, Default is used,
+	// if it isn't nil. Attributes using them should be Optional. They are
+	// ignored in resource and data source schemas, where plan modifiers
+	// can set defaults instead.
+	EnvVars []string
+	Default attr.Value
+
+	// Supersedes is the name of a top-level attribute this top-level
+	// attribute was renamed from, for resources. The old attribute should
+	// stay in the schema, Optional and with a DeprecationMessage, so
+	// configurations using it keep working, and this attribute must be
+	// Optional and Computed, with the same type as the old attribute.
+	// The framework then warns practitioners who configure the old
+	// attribute, rejects configurations setting both, copies the old
+	// attribute's configured value to this attribute in the config and
+	// plan the resource gets, and copies it into this attribute when
+	// upgrading prior state that only has the old one. The old attribute
+	// keeps its value in the plan and state, as Terraform requires the
+	// planned value of a configured attribute that isn't Computed to
+	// match the configuration.
+	Supersedes string
+
+	// PlanModifiers defines a sequence of modifiers for this attribute at
+	// plan time. They are only run for resources, and are run in order,
+	// each receiving the planned value produced by the ones before it.
+	PlanModifiers []AttributePlanModifier
+
+	// ElementPlanModifiers defines a sequence of modifiers run once for
+	// each element of this attribute, for attributes whose Type is a list
+	// or map type or that use ListNestedAttributes, SetNestedAttributes,
+	// or MapNestedAttributes. Each run gets the path of the element, like
+	// AttributeName("rules").ElementKeyInt(0), and the element's values,
+	// so modifiers can set defaults or require replacement for single
+	// elements. They run before PlanModifiers, which get the collection
+	// with the modified elements. They have no effect on other attributes.
+	ElementPlanModifiers []AttributePlanModifier
 }
 
 // ApplyTerraform5AttributePathStep transparently calls
@@ -102,6 +168,9 @@ func (a Attribute) Equal(o Attribute) bool {
 	if a.MarkdownDescription != o.MarkdownDescription {
 		return false
 	}
+	if a.DescriptionTemplates != o.DescriptionTemplates {
+		return false
+	}
 	if a.Required != o.Required {
 		return false
 	}
@@ -117,5 +186,49 @@ func (a Attribute) Equal(o Attribute) bool {
 	if a.DeprecationMessage != o.DeprecationMessage {
 		return false
 	}
+	if a.IgnoreListOrder != o.IgnoreListOrder {
+		return false
+	}
+	if a.EmptyObject != o.EmptyObject {
+		return false
+	}
+	if len(a.EnvVars) != len(o.EnvVars) {
+		return false
+	}
+	for i := range a.EnvVars {
+		if a.EnvVars[i] != o.EnvVars[i] {
+			return false
+		}
+	}
+	if (a.Default == nil) != (o.Default == nil) {
+		return false
+	}
+	if a.Default != nil && !a.Default.Equal(o.Default) {
+		return false
+	}
+	if a.Supersedes != o.Supersedes {
+		return false
+	}
 	return true
 }
+
+// EmptyObjectMode is how an attribute using SingleNestedAttributes is
+// represented when it has no values.
+type EmptyObjectMode uint8
+
+const (
+	// EmptyObjectUnchanged stores the attribute exactly as the provider
+	// sets it. It is the default.
+	EmptyObjectUnchanged EmptyObjectMode = iota
+
+	// EmptyObjectNull stores an object whose attributes are all null as
+	// null, whenever the provider sets it in state.
+	EmptyObjectNull
+
+	// EmptyObjectOfNulls stores a null object as an object whose
+	// attributes are all null, whenever the provider sets it in state, and
+	// plans an object of nulls when the attribute isn't configured. As
+	// Terraform requires attributes that aren't computed to match their
+	// configuration, it only has an effect on computed attributes.
+	EmptyObjectOfNulls
+)
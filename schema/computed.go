@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// ComputedOnly returns a copy of the schema with every attribute, including
+// nested attributes, set to be computed only. Plan modifiers and the limits
+// on the number of nested attribute elements are removed, as they only apply
+// to configured values. It is meant for data sources that return the same
+// data as a resource, so their schema can be derived from the resource's
+// schema instead of duplicated:
+//
+//	resourceSchema.ComputedOnly()
+//
+// Attributes the data source needs as arguments, like an ID to look up, can
+// be set on the returned schema afterwards. The version of the returned
+// schema is always 0, as data sources don't have state to upgrade.
+func (s Schema) ComputedOnly() Schema {
+	return Schema{
+		Attributes:          computedOnlyAttributes(s.Attributes),
+		DeprecationMessage:  s.DeprecationMessage,
+		Description:         s.Description,
+		MarkdownDescription: s.MarkdownDescription,
+	}
+}
+
+// ComputedSchemaFromType returns a schema with a computed-only attribute for
+// each attribute of `typ`, using the attribute's type. It is meant for data
+// sources whose data is described by an existing object type.
+func ComputedSchemaFromType(typ attr.TypeWithAttributeTypes) Schema {
+	attrs := make(map[string]Attribute, len(typ.AttributeTypes()))
+	for name, attrType := range typ.AttributeTypes() {
+		attrs[name] = Attribute{
+			Type:     attrType,
+			Computed: true,
+		}
+	}
+	return Schema{
+		Attributes: attrs,
+	}
+}
+
+func computedOnlyAttributes(in map[string]Attribute) map[string]Attribute {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]Attribute, len(in))
+	for name, a := range in {
+		out[name] = computedOnlyAttribute(a)
+	}
+	return out
+}
+
+func computedOnlyAttribute(a Attribute) Attribute {
+	a.Required = false
+	a.Optional = false
+	a.Computed = true
+	a.PlanModifiers = nil
+	a.ElementPlanModifiers = nil
+	if a.Attributes == nil {
+		return a
+	}
+	attrs := computedOnlyAttributes(a.Attributes.GetAttributes())
+	switch a.Attributes.GetNestingMode() {
+	case NestingModeSingle:
+		a.Attributes = SingleNestedAttributes(attrs)
+	case NestingModeList:
+		a.Attributes = ListNestedAttributes(attrs, ListNestedAttributesOptions{})
+	case NestingModeSet:
+		a.Attributes = SetNestedAttributes(attrs, SetNestedAttributesOptions{})
+	case NestingModeMap:
+		a.Attributes = MapNestedAttributes(attrs, MapNestedAttributesOptions{})
+	}
+	return a
+}
@@ -0,0 +1,128 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaComputedOnly(t *testing.T) {
+	t.Parallel()
+
+	original := Schema{
+		Version:     2,
+		Description: "A resource.",
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The name.",
+			},
+			"password": {
+				Type:      types.StringType,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"size": {
+						Type:     types.NumberType,
+						Optional: true,
+						Computed: true,
+					},
+				}, ListNestedAttributesOptions{
+					MinItems: 1,
+					MaxItems: 3,
+				}),
+				Required: true,
+			},
+		},
+	}.WithIDAttribute()
+
+	expected := Schema{
+		Description: "A resource.",
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The unique identifier of the resource.",
+			},
+			"name": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The name.",
+			},
+			"password": {
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"size": {
+						Type:     types.NumberType,
+						Computed: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Computed: true,
+			},
+		},
+	}
+
+	got := original.ComputedOnly()
+	if got.Version != expected.Version || got.Description != expected.Description {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+	if len(got.Attributes) != len(expected.Attributes) {
+		t.Fatalf("Expected attributes %+v, got %+v", expected.Attributes, got.Attributes)
+	}
+	for name, attribute := range expected.Attributes {
+		if !attribute.Equal(got.Attributes[name]) {
+			t.Errorf("Expected attribute %q to be %+v, got %+v", name, attribute, got.Attributes[name])
+		}
+		if len(got.Attributes[name].PlanModifiers) != 0 {
+			t.Errorf("Expected attribute %q to have no plan modifiers, got %+v", name, got.Attributes[name].PlanModifiers)
+		}
+	}
+	if !original.Attributes["name"].Required {
+		t.Errorf("Expected original schema to be unmodified")
+	}
+	if diff := cmp.Diff(original.AttributeType(), got.AttributeType()); diff != "" {
+		t.Errorf("Expected the same type as the original schema (+got, -wanted): %s", diff)
+	}
+}
+
+func TestComputedSchemaFromType(t *testing.T) {
+	t.Parallel()
+
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+			"tags": types.MapType{ElemType: types.StringType},
+		},
+	}
+	got := ComputedSchemaFromType(typ)
+	expected := map[string]Attribute{
+		"name": {
+			Type:     types.StringType,
+			Computed: true,
+		},
+		"tags": {
+			Type:     types.MapType{ElemType: types.StringType},
+			Computed: true,
+		},
+	}
+	if len(got.Attributes) != len(expected) {
+		t.Fatalf("Expected attributes %+v, got %+v", expected, got.Attributes)
+	}
+	for name, attribute := range expected {
+		if !attribute.Equal(got.Attributes[name]) {
+			t.Errorf("Expected attribute %q to be %+v, got %+v", name, attribute, got.Attributes[name])
+		}
+	}
+	if !typ.Equal(got.AttributeType()) {
+		t.Errorf("Expected schema type %+v, got %+v", typ, got.AttributeType())
+	}
+}
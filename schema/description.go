@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DescriptionData is the data available to the text/template templates in
+// an Attribute's Description and MarkdownDescription when its
+// DescriptionTemplates is true, so descriptions can include information
+// derived from the attribute's behavior instead of repeating it by hand. For
+// example:
+//
+//	Description:          "The size of the disk. {{ .TypeDescription }}",
+//	DescriptionTemplates: true,
+type DescriptionData struct {
+	// Name is the name of the attribute.
+	Name string
+
+	// Required, Optional, Computed, and Sensitive are copied from the
+	// attribute.
+	Required  bool
+	Optional  bool
+	Computed  bool
+	Sensitive bool
+
+	// TypeDescription is the description returned by the attribute's
+	// Type, if it implements attr.TypeWithPlaintextDescription.
+	TypeDescription string
+
+	// PlanModifiers are the descriptions of the attribute's plan
+	// modifiers, in order. They're the plain text descriptions when
+	// rendering Description, and the Markdown descriptions when rendering
+	// MarkdownDescription.
+	PlanModifiers []string
+
+	// ElementPlanModifiers are the descriptions of the attribute's
+	// element plan modifiers, in order, rendered like PlanModifiers.
+	ElementPlanModifiers []string
+}
+
+// RenderDescription returns the attribute's Description, rendered as a
+// text/template template with DescriptionData for the attribute named `name`
+// if the attribute's DescriptionTemplates is true.
+func (a Attribute) RenderDescription(ctx context.Context, name string) (string, error) {
+	if !a.DescriptionTemplates {
+		return a.Description, nil
+	}
+	data := a.descriptionData(ctx, name)
+	for _, modifier := range a.PlanModifiers {
+		data.PlanModifiers = append(data.PlanModifiers, modifier.Description(ctx))
+	}
+	for _, modifier := range a.ElementPlanModifiers {
+		data.ElementPlanModifiers = append(data.ElementPlanModifiers, modifier.Description(ctx))
+	}
+	return renderDescription(a.Description, data)
+}
+
+// RenderMarkdownDescription returns the attribute's MarkdownDescription,
+// rendered as a text/template template with DescriptionData for the attribute
+// named `name` if the attribute's DescriptionTemplates is true.
+func (a Attribute) RenderMarkdownDescription(ctx context.Context, name string) (string, error) {
+	if !a.DescriptionTemplates {
+		return a.MarkdownDescription, nil
+	}
+	data := a.descriptionData(ctx, name)
+	for _, modifier := range a.PlanModifiers {
+		data.PlanModifiers = append(data.PlanModifiers, modifier.MarkdownDescription(ctx))
+	}
+	for _, modifier := range a.ElementPlanModifiers {
+		data.ElementPlanModifiers = append(data.ElementPlanModifiers, modifier.MarkdownDescription(ctx))
+	}
+	return renderDescription(a.MarkdownDescription, data)
+}
+
+func (a Attribute) descriptionData(ctx context.Context, name string) DescriptionData {
+	data := DescriptionData{
+		Name:      name,
+		Required:  a.Required,
+		Optional:  a.Optional,
+		Computed:  a.Computed,
+		Sensitive: a.Sensitive,
+	}
+	if typ, ok := a.Type.(attr.TypeWithPlaintextDescription); ok {
+		data.TypeDescription = typ.Description(ctx)
+	}
+	return data
+}
+
+func renderDescription(description string, data DescriptionData) (string, error) {
+	tmpl, err := template.New(data.Name).Option("missingkey=error").Parse(description)
+	if err != nil {
+		return "", fmt.Errorf("error parsing description template: %w", err)
+	}
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+	if err != nil {
+		return "", fmt.Errorf("error rendering description template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// ValidateDescriptions checks the descriptions of every attribute in the
+// schema, including nested attributes. It returns a warning for each
+// attribute with neither a Description nor a MarkdownDescription, and an
+// error for each description template that can't be rendered. It is meant to
+// be called from provider unit tests, to keep documentation complete.
+func (s Schema) ValidateDescriptions(ctx context.Context) []*tfprotov6.Diagnostic {
+	diags := validateDescriptions(ctx, s.Attributes, tftypes.NewAttributePath())
+	sort.SliceStable(diags, func(i, j int) bool {
+		return order.PathLess(diags[i].Attribute, diags[j].Attribute)
+	})
+	return diags
+}
+
+func validateDescriptions(ctx context.Context, attrs map[string]Attribute, path *tftypes.AttributePath) []*tfprotov6.Diagnostic {
+	var diags []*tfprotov6.Diagnostic
+	for name, a := range attrs {
+		attrPath := path.WithAttributeName(name)
+		if a.Description == "" && a.MarkdownDescription == "" {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity:  tfprotov6.DiagnosticSeverityWarning,
+				Summary:   "Missing attribute description",
+				Detail:    fmt.Sprintf("The %q attribute has no Description or MarkdownDescription. Practitioners rely on descriptions to know what attributes do.", name),
+				Attribute: attrPath,
+			})
+		}
+		if _, err := a.RenderDescription(ctx, name); err != nil {
+			diags = append(diags, invalidDescriptionDiagnostic(attrPath, "Description", err))
+		}
+		if _, err := a.RenderMarkdownDescription(ctx, name); err != nil {
+			diags = append(diags, invalidDescriptionDiagnostic(attrPath, "MarkdownDescription", err))
+		}
+		if a.Attributes != nil {
+			diags = append(diags, validateDescriptions(ctx, a.Attributes.GetAttributes(), attrPath)...)
+		}
+	}
+	return diags
+}
+
+func invalidDescriptionDiagnostic(path *tftypes.AttributePath, field string, err error) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity:  tfprotov6.DiagnosticSeverityError,
+		Summary:   "Invalid attribute description",
+		Detail:    fmt.Sprintf("The attribute's %s can't be rendered: %s", field, err),
+		Attribute: path,
+	}
+}
@@ -0,0 +1,159 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testDescribedStringType struct {
+	attr.Type
+}
+
+func (t testDescribedStringType) Description(_ context.Context) string {
+	return "Must be a valid hostname."
+}
+
+func TestAttributeRenderDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		attr             Attribute
+		expected         string
+		expectedMarkdown string
+		expectedError    string
+	}
+
+	tests := map[string]testCase{
+		"plain": {
+			attr: Attribute{
+				Type:                types.StringType,
+				Description:         "Just text, with {braces}.",
+				MarkdownDescription: "Just `text`.",
+			},
+			expected:         "Just text, with {braces}.",
+			expectedMarkdown: "Just `text`.",
+		},
+		"not-a-template": {
+			attr: Attribute{
+				Type:                types.StringType,
+				Description:         "Matches {{ .Name }} literally.",
+				MarkdownDescription: "Use `{{ .Name }}` in the template.",
+			},
+			expected:         "Matches {{ .Name }} literally.",
+			expectedMarkdown: "Use `{{ .Name }}` in the template.",
+		},
+		"type-description": {
+			attr: Attribute{
+				Type:                 testDescribedStringType{types.StringType},
+				Required:             true,
+				Description:          "The {{ .Name }} to connect to.{{ if .Required }} Required.{{ end }} {{ .TypeDescription }}",
+				DescriptionTemplates: true,
+			},
+			expected: "The host to connect to. Required. Must be a valid hostname.",
+		},
+		"plan-modifiers": {
+			attr: Attribute{
+				Type:                 types.StringType,
+				Computed:             true,
+				MarkdownDescription:  "The ID.{{ range .PlanModifiers }} {{ . }}{{ end }}",
+				PlanModifiers:        []AttributePlanModifier{UseStateForUnknown()},
+				DescriptionTemplates: true,
+			},
+			expectedMarkdown: "The ID. Once set, the value of this attribute in state will not change.",
+		},
+		"invalid": {
+			attr: Attribute{
+				Type:                 types.StringType,
+				Description:          "The {{ .Name ",
+				DescriptionTemplates: true,
+			},
+			expectedError: "error parsing description template: template: host:1: unclosed action",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.attr.RenderDescription(context.Background(), "host")
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %q", tc.expectedError, err.Error())
+				}
+				return
+			}
+			if tc.expectedError != "" {
+				t.Fatalf("Expected error %q, got none", tc.expectedError)
+			}
+			if got != tc.expected {
+				t.Errorf("Expected description %q, got %q", tc.expected, got)
+			}
+			gotMarkdown, err := tc.attr.RenderMarkdownDescription(context.Background(), "host")
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if gotMarkdown != tc.expectedMarkdown {
+				t.Errorf("Expected markdown description %q, got %q", tc.expectedMarkdown, gotMarkdown)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateDescriptions(t *testing.T) {
+	t.Parallel()
+
+	s := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The name.",
+			},
+			"size": {
+				Type:     types.NumberType,
+				Optional: true,
+			},
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:                 types.StringType,
+						Required:             true,
+						MarkdownDescription:  "The {{ .Nope }}.",
+						DescriptionTemplates: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional:    true,
+				Description: "The disks.",
+			},
+		},
+	}
+
+	expected := []*tfprotov6.Diagnostic{
+		{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Invalid attribute description",
+			Detail:    `The attribute's MarkdownDescription can't be rendered: error rendering description template: template: id:1:7: executing "id" at <.Nope>: can't evaluate field Nope in type schema.DescriptionData`,
+			Attribute: tftypes.NewAttributePath().WithAttributeName("disks").WithAttributeName("id"),
+		},
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Missing attribute description",
+			Detail:    `The "size" attribute has no Description or MarkdownDescription. Practitioners rely on descriptions to know what attributes do.`,
+			Attribute: tftypes.NewAttributePath().WithAttributeName("size"),
+		},
+	}
+	got := s.ValidateDescriptions(context.Background())
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,270 @@
+// Package modelgen generates Go model structs for schemas, with a field,
+// tagged for tfsdk, for each attribute, and null-safe accessors, so large
+// providers can keep their models in sync with their schemas.
+//
+// It is meant to be called from a small program run by go generate, like:
+//
+//	//go:generate go run ./internal/genmodels
+//
+// where internal/genmodels calls WriteFile with each schema.
+package modelgen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const typesPackage = "github.com/hashicorp/terraform-plugin-framework/types"
+
+// initialisms are the words in attribute names that are written in capitals
+// in field names, like "ID" in "InstanceID".
+var initialisms = map[string]bool{
+	"api": true, "arn": true, "cidr": true, "cpu": true, "dns": true, "http": true,
+	"https": true, "id": true, "ip": true, "json": true, "ssh": true,
+	"tls": true, "ttl": true, "uri": true, "url": true, "uuid": true,
+}
+
+// Options configure the generated code.
+type Options struct {
+	// Package is the name of the package the code is generated for. It
+	// must be set.
+	Package string
+
+	// TypeName is the name of the model struct. Nested attributes get
+	// structs of their own, named TypeName followed by the field name,
+	// like ExampleModelRules. It must be set.
+	TypeName string
+}
+
+// Generate returns the source of a Go file declaring a model struct for `s`.
+//
+// Each attribute becomes a field of the value type of its attr.Type, like
+// types.String for types.StringType. Single nested attributes become pointers
+// to structs, list and set nested attributes slices of structs, and map
+// nested attributes maps of structs, so null values become nil. Nested
+// attributes that can be unknown, like computed ones in a plan, can't be read
+// into these fields.
+//
+// Each field also gets a Get method that is safe to call on a nil model and
+// returns a plain Go value: the zero value for null or unknown strings,
+// bools, and numbers, and nil for null nested attributes. Chained calls, like
+// m.GetNetwork().GetSubnetID(), never panic.
+func Generate(ctx context.Context, s schema.Schema, opts Options) ([]byte, error) {
+	if opts.Package == "" || opts.TypeName == "" {
+		return nil, fmt.Errorf("a Package and TypeName must be set to generate a model")
+	}
+	g := &generator{
+		imports: map[string]bool{},
+		names:   map[string]bool{},
+	}
+	if err := g.model(ctx, opts.TypeName, s.Attributes, tftypes.NewAttributePath()); err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by modelgen. DO NOT EDIT.\n\npackage %s\n\n", opts.Package)
+	if len(g.imports) > 0 {
+		// the standard library's packages go first, in a group of
+		// their own, like goimports does
+		var std, other []string
+		for imp := range g.imports {
+			if strings.Contains(strings.SplitN(imp, "/", 2)[0], ".") {
+				other = append(other, imp)
+			} else {
+				std = append(std, imp)
+			}
+		}
+		sort.Strings(std)
+		sort.Strings(other)
+		b.WriteString("import (\n")
+		for _, imp := range std {
+			fmt.Fprintf(&b, "%q\n", imp)
+		}
+		if len(std) > 0 && len(other) > 0 {
+			b.WriteString("\n")
+		}
+		for _, imp := range other {
+			fmt.Fprintf(&b, "%q\n", imp)
+		}
+		b.WriteString(")\n")
+	}
+	b.Write(g.body.Bytes())
+	return format.Source(b.Bytes())
+}
+
+// WriteFile writes the model struct for `s`, as returned by Generate, to the
+// file `filename`.
+func WriteFile(ctx context.Context, filename string, s schema.Schema, opts Options) error {
+	src, err := Generate(ctx, s, opts)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, src, 0644)
+}
+
+type generator struct {
+	imports map[string]bool
+	names   map[string]bool
+	body    bytes.Buffer
+}
+
+// field is a field of a model struct.
+type field struct {
+	name   string
+	tag    string
+	goType string
+
+	// getType and getValue are the return type of the field's Get method
+	// and the expression it returns when the field is set. getValue is
+	// empty when the field itself is returned.
+	getType  string
+	getValue string
+}
+
+// model declares the struct `name` with fields for `attributes`, at
+// `parentPath`, followed by the structs for its nested attributes.
+func (g *generator) model(ctx context.Context, name string, attributes map[string]schema.Attribute, parentPath *tftypes.AttributePath) error {
+	if g.names[name] {
+		return parentPath.NewErrorf("more than one struct would be named %s", name)
+	}
+	g.names[name] = true
+
+	attrNames := make([]string, 0, len(attributes))
+	for attrName := range attributes {
+		attrNames = append(attrNames, attrName)
+	}
+	sort.Strings(attrNames)
+
+	fields := make([]field, 0, len(attributes))
+	fieldNames := map[string]bool{}
+	type nestedModel struct {
+		name       string
+		attributes map[string]schema.Attribute
+		path       *tftypes.AttributePath
+	}
+	var nested []nestedModel
+	for _, attrName := range attrNames {
+		attribute := attributes[attrName]
+		attrPath := parentPath.WithAttributeName(attrName)
+		f := field{
+			name: fieldName(attrName),
+			tag:  attrName,
+		}
+		if fieldNames[f.name] {
+			return attrPath.NewErrorf("more than one field would be named %s", f.name)
+		}
+		fieldNames[f.name] = true
+
+		if attribute.Attributes != nil {
+			nestedName := name + f.name
+			switch attribute.Attributes.GetNestingMode() {
+			case schema.NestingModeSingle:
+				f.goType = "*" + nestedName
+			case schema.NestingModeList, schema.NestingModeSet:
+				f.goType = "[]" + nestedName
+			case schema.NestingModeMap:
+				f.goType = "map[string]" + nestedName
+			default:
+				return attrPath.NewErrorf("unsupported nesting mode %d", attribute.Attributes.GetNestingMode())
+			}
+			f.getType = f.goType
+			fields = append(fields, f)
+			nested = append(nested, nestedModel{
+				name:       nestedName,
+				attributes: attribute.Attributes.GetAttributes(),
+				path:       attrPath,
+			})
+			continue
+		}
+
+		if attribute.Type == nil {
+			return attrPath.NewErrorf("attributes must have a Type or nested Attributes")
+		}
+		null, err := attribute.Type.ValueFromTerraform(ctx, tftypes.NewValue(attribute.Type.TerraformType(ctx), nil))
+		if err != nil {
+			return attrPath.NewErrorf("can't create a value of %s: %s", attribute.Type, err)
+		}
+		valueType := reflect.TypeOf(null)
+		if valueType.PkgPath() == "" || valueType.Name() == "" {
+			return attrPath.NewErrorf("values of %s are a %s, which isn't a named type", attribute.Type, valueType)
+		}
+		g.imports[valueType.PkgPath()] = true
+		f.goType = path.Base(valueType.PkgPath()) + "." + valueType.Name()
+		f.getType = f.goType
+		if valueType.PkgPath() == typesPackage {
+			switch valueType.Name() {
+			case "String":
+				f.getType, f.getValue = "string", "m."+f.name+".Value"
+			case "Bool":
+				f.getType, f.getValue = "bool", "m."+f.name+".Value"
+			case "Int64":
+				f.getType, f.getValue = "int64", "m."+f.name+".Value"
+			case "Float64":
+				f.getType, f.getValue = "float64", "m."+f.name+".Value"
+			case "Number":
+				g.imports["math/big"] = true
+				f.getType, f.getValue = "*big.Float", "m."+f.name+".Value"
+			}
+		}
+		fields = append(fields, f)
+	}
+
+	g.writeModel(name, fields)
+	for _, n := range nested {
+		if err := g.model(ctx, n.name, n.attributes, n.path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeModel writes the struct `name` with `fields`, and its Get methods.
+func (g *generator) writeModel(name string, fields []field) {
+	fmt.Fprintf(&g.body, "\ntype %s struct {\n", name)
+	for _, f := range fields {
+		fmt.Fprintf(&g.body, "%s %s `tfsdk:%q`\n", f.name, f.goType, f.tag)
+	}
+	g.body.WriteString("}\n")
+
+	for _, f := range fields {
+		fmt.Fprintf(&g.body, "\n// Get%s returns the value of %s.", f.name, f.name)
+		if f.getValue != "" {
+			fmt.Fprintf(&g.body, " It returns the zero value if\n// %s is null or unknown, or `m` is nil.\n", f.name)
+		} else {
+			fmt.Fprintf(&g.body, " It returns the zero value if\n// `m` is nil.\n")
+		}
+		fmt.Fprintf(&g.body, "func (m *%s) Get%s() %s {\n", name, f.name, f.getType)
+		if f.getValue != "" {
+			fmt.Fprintf(&g.body, "if m == nil || m.%s.Null || m.%s.Unknown {\nvar zero %s\nreturn zero\n}\nreturn %s\n}\n", f.name, f.name, f.getType, f.getValue)
+			continue
+		}
+		fmt.Fprintf(&g.body, "if m == nil {\nvar zero %s\nreturn zero\n}\nreturn m.%s\n}\n", f.getType, f.name)
+	}
+}
+
+// fieldName returns the name of the field for the attribute `attrName`, like
+// "SubnetID" for "subnet_id".
+func fieldName(attrName string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(attrName, "_") {
+		if word == "" {
+			continue
+		}
+		if initialisms[word] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
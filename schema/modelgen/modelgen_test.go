@@ -0,0 +1,139 @@
+package modelgen
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/decimal"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subnet_id": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"enabled": {
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"price": {
+				Type:     decimal.Type{Scale: 2},
+				Optional: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"network": {
+				Attributes: schema.SingleNestedAttributes(map[string]schema.Attribute{
+					"cidr": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				}),
+				Optional: true,
+			},
+			"rules": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"port": {
+						Type:     types.Int64Type,
+						Required: true,
+					},
+					"weight": {
+						Type:     types.NumberType,
+						Optional: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+
+	got, err := Generate(context.Background(), s, Options{
+		Package:  "example",
+		TypeName: "ExampleModel",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected, err := ioutil.ReadFile("testdata/example.golden")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(string(got), string(expected)); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		schema      schema.Schema
+		opts        Options
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"no-type-name": {
+			opts:        Options{Package: "example"},
+			expectedErr: "a Package and TypeName must be set to generate a model",
+		},
+		"field-names": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"subnet_id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+					"subnet__id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			opts:        Options{Package: "example", TypeName: "ExampleModel"},
+			expectedErr: `AttributeName("subnet_id"): more than one field would be named SubnetID`,
+		},
+		"struct-names": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"a": {
+						Attributes: schema.SingleNestedAttributes(map[string]schema.Attribute{
+							"b": {
+								Attributes: schema.SingleNestedAttributes(map[string]schema.Attribute{}),
+								Optional:   true,
+							},
+						}),
+						Optional: true,
+					},
+					"a_b": {
+						Attributes: schema.SingleNestedAttributes(map[string]schema.Attribute{}),
+						Optional:   true,
+					},
+				},
+			},
+			opts:        Options{Package: "example", TypeName: "Example"},
+			expectedErr: `AttributeName("a_b"): more than one struct would be named ExampleAB`,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Generate(context.Background(), tc.schema, tc.opts)
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("Expected error %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
@@ -198,11 +198,27 @@ func (l listNestedAttributes) AttributeType() attr.Type {
 }
 
 func (l listNestedAttributes) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
-	_, ok := step.(tftypes.ElementKeyInt)
+	index, ok := step.(tftypes.ElementKeyInt)
 	if !ok {
 		return nil, fmt.Errorf("can't apply %T to ListNestedAttributes", step)
 	}
-	return l.nestedAttributes, nil
+	return nestedElement{
+		nestedAttributes: l.nestedAttributes,
+		index:            int64(index),
+		min:              l.GetMinItems(),
+		max:              l.GetMaxItems(),
+	}, nil
+}
+
+// nestedElement is the result of stepping into an element of
+// ListNestedAttributes. It behaves like the nested attributes, but keeps the
+// element's index and the list's bounds so they can be reported by
+// Schema.AttributeAtPathWithBounds.
+type nestedElement struct {
+	nestedAttributes
+
+	index    int64
+	min, max int64
 }
 
 func (l listNestedAttributes) Equal(o NestedAttributes) bool {
@@ -349,8 +365,9 @@ func (m mapNestedAttributes) GetMaxItems() int64 {
 
 // AttributeType returns an attr.Type corresponding to the nested attributes.
 func (m mapNestedAttributes) AttributeType() attr.Type {
-	// TODO fill in implementation when types.MapType is available
-	return nil
+	return types.MapType{
+		ElemType: m.nestedAttributes.AttributeType(),
+	}
 }
 
 func (m mapNestedAttributes) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
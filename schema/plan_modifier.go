@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributePlanModifier represents a modifier for an attribute at plan time.
+// An AttributePlanModifier can only modify the planned value for the
+// attribute on which it is defined. Plan modifiers are only run for
+// resources, and are run in the order they are defined on the attribute,
+// after the framework has marked computed attributes that are null in the
+// configuration as unknown.
+type AttributePlanModifier interface {
+	// Description is used in various tooling, like the language server,
+	// to give practitioners more information about what this modifier
+	// is, what it's for, and how it should be used. It should be written
+	// as plain text, with no special formatting.
+	Description(context.Context) string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this modifier is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription(context.Context) string
+
+	// Modify is called when the provider has an opportunity to modify
+	// the plan for the attribute. The planned value is pre-populated in
+	// the response from the request, and should be changed on the
+	// response as appropriate.
+	Modify(context.Context, ModifyAttributePlanRequest, *ModifyAttributePlanResponse)
+}
+
+// ModifyAttributePlanRequest represents a request to modify the planned value
+// of an attribute. An instance of this request struct is supplied as an
+// argument to an AttributePlanModifier's Modify method.
+type ModifyAttributePlanRequest struct {
+	// AttributePath is the path of the attribute whose plan is being
+	// modified.
+	AttributePath *tftypes.AttributePath
+
+	// AttributeConfig is the configuration the user supplied for the
+	// attribute.
+	AttributeConfig attr.Value
+
+	// AttributeState is the current state of the attribute. It is null
+	// when the resource is being created.
+	AttributeState attr.Value
+
+	// AttributePlan is the planned new state for the attribute, as
+	// modified by any plan modifiers that ran before this one.
+	AttributePlan attr.Value
+
+	// Schema is the schema of the resource the attribute belongs to.
+	Schema Schema
+
+	// Config, State, and Plan are the configuration, current state, and
+	// planned new state of the whole resource, for modifiers that depend
+	// on other attributes. Plan is the plan before any plan modifiers
+	// ran. State is null when the resource is being created.
+	Config tftypes.Value
+	State  tftypes.Value
+	Plan   tftypes.Value
+}
+
+// ModifyAttributePlanResponse represents a response to a
+// ModifyAttributePlanRequest. An instance of this response struct is supplied
+// as an argument to an AttributePlanModifier's Modify method.
+type ModifyAttributePlanResponse struct {
+	// AttributePlan is the planned new state for the attribute. It is
+	// pre-populated from ModifyAttributePlanRequest.AttributePlan, and
+	// must be a value of the attribute's type.
+	AttributePlan attr.Value
+
+	// RequiresReplace indicates that the change to the attribute can't
+	// be made in place, and the resource must be destroyed and created
+	// again. It is ignored when the resource is being created.
+	RequiresReplace bool
+
+	// Diagnostics report errors or warnings related to modifying the
+	// plan. Diagnostics without an Attribute set will be associated with
+	// the attribute being modified. An empty slice indicates success,
+	// with no warnings or errors generated.
+	Diagnostics []*tfprotov6.Diagnostic
+}
+
+// UseStateForUnknown returns an AttributePlanModifier that copies the prior
+// state value of a computed attribute into the plan, instead of letting it be
+// marked as unknown, whenever the resource already exists. It is meant for
+// attributes, like IDs, that are set when the resource is created and never
+// change afterwards, so practitioners don't see "(known after apply)" for
+// them on every update.
+func UseStateForUnknown() AttributePlanModifier {
+	return useStateForUnknownModifier{}
+}
+
+type useStateForUnknownModifier struct{}
+
+func (m useStateForUnknownModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+func (m useStateForUnknownModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useStateForUnknownModifier) Modify(ctx context.Context, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	if req.AttributeState == nil || req.AttributeState.IsNull() {
+		// the resource is being created, there's no state to use
+		return
+	}
+	if req.AttributePlan == nil || !req.AttributePlan.IsUnknown() {
+		// the plan already has a value, don't override it
+		return
+	}
+	if req.AttributeConfig != nil && req.AttributeConfig.IsUnknown() {
+		// the practitioner configured a value that isn't known yet,
+		// it must not be replaced with the state
+		return
+	}
+	resp.AttributePlan = req.AttributeState
+}
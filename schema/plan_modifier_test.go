@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUseStateForUnknownModify(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		config   attr.Value
+		state    attr.Value
+		plan     attr.Value
+		expected attr.Value
+	}
+	tests := map[string]testCase{
+		"create": {
+			config:   types.String{Null: true},
+			state:    types.String{Null: true},
+			plan:     types.String{Unknown: true},
+			expected: types.String{Unknown: true},
+		},
+		"update-unknown-plan": {
+			config:   types.String{Null: true},
+			state:    types.String{Value: "123"},
+			plan:     types.String{Unknown: true},
+			expected: types.String{Value: "123"},
+		},
+		"update-known-plan": {
+			config:   types.String{Value: "456"},
+			state:    types.String{Value: "123"},
+			plan:     types.String{Value: "456"},
+			expected: types.String{Value: "456"},
+		},
+		"update-unknown-config": {
+			config:   types.String{Unknown: true},
+			state:    types.String{Value: "123"},
+			plan:     types.String{Unknown: true},
+			expected: types.String{Unknown: true},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := ModifyAttributePlanRequest{
+				AttributePath:   tftypes.NewAttributePath().WithAttributeName("id"),
+				AttributeConfig: test.config,
+				AttributeState:  test.state,
+				AttributePlan:   test.plan,
+			}
+			resp := &ModifyAttributePlanResponse{
+				AttributePlan: test.plan,
+			}
+			UseStateForUnknown().Modify(context.Background(), req, resp)
+			if diff := cmp.Diff(test.expected, resp.AttributePlan); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+			if len(resp.Diagnostics) > 0 {
+				t.Errorf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -18,6 +18,10 @@ var (
 	ErrPathInsideAtomicAttribute = errors.New("path leads to element or attribute of a schema.Attribute that has no schema associated with it")
 )
 
+// IDAttributeName is the name of the attribute added to schemas by
+// WithIDAttribute.
+const IDAttributeName = "id"
+
 // Schema is used to define the shape of practitioner-provider information,
 // like resources, data sources, and providers. Think of it as a type
 // definition, but for Terraform.
@@ -82,6 +86,10 @@ func (s Schema) AttributeTypeAtPath(path *tftypes.AttributePath) (attr.Type, err
 		return n.AttributeType(), nil
 	}
 
+	if e, ok := rawType.(nestedElement); ok {
+		return e.AttributeType(), nil
+	}
+
 	a, ok := rawType.(Attribute)
 	if !ok {
 		return nil, fmt.Errorf("got unexpected type %T", rawType)
@@ -113,6 +121,11 @@ func (s Schema) TerraformType(ctx context.Context) tftypes.Type {
 func (s Schema) AttributeAtPath(path *tftypes.AttributePath) (Attribute, error) {
 	res, remaining, err := tftypes.WalkAttributePath(s, path)
 	if err != nil {
+		if isDynamic(res) {
+			// the shape of a dynamic value is only known at
+			// runtime, so anything inside it has no schema
+			return Attribute{}, ErrPathInsideAtomicAttribute
+		}
 		return Attribute{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
 
@@ -126,3 +139,141 @@ func (s Schema) AttributeAtPath(path *tftypes.AttributePath) (Attribute, error)
 	}
 	return a, nil
 }
+
+// isDynamic returns whether `res`, the result of walking a path through a
+// schema, is an Attribute or attr.Type that holds a dynamic value.
+func isDynamic(res interface{}) bool {
+	var typ attr.Type
+	switch v := res.(type) {
+	case Attribute:
+		typ = v.Type
+	case attr.Type:
+		typ = v
+	}
+	return typ != nil && typ.TerraformType(context.Background()).Is(tftypes.DynamicPseudoType)
+}
+
+// ElementBounds describes a step into an element of ListNestedAttributes,
+// along with the MinItems and MaxItems set for the list.
+type ElementBounds struct {
+	// Path is the path to the element.
+	Path *tftypes.AttributePath
+
+	// Index is the index of the element in the list.
+	Index int64
+
+	// MinItems and MaxItems are the bounds set for the list. Zero means
+	// no bound is set.
+	MinItems int64
+	MaxItems int64
+}
+
+// WithinMinItems returns true if the element is one of the first MinItems
+// elements, which the list must always have.
+func (b ElementBounds) WithinMinItems() bool {
+	return b.Index < b.MinItems
+}
+
+// WithinMaxItems returns true if the list is allowed to have the element
+// under MaxItems. It is always true if MaxItems isn't set.
+func (b ElementBounds) WithinMaxItems() bool {
+	return b.Index >= 0 && (b.MaxItems <= 0 || b.Index < b.MaxItems)
+}
+
+// AttributeAtPathWithBounds returns the Attribute at the passed path, like
+// AttributeAtPath, along with ElementBounds for every step in the path into
+// an element of ListNestedAttributes, in the order they appear. If the path
+// ends at an element of nested attributes, the Attribute holding the nested
+// attributes is returned.
+func (s Schema) AttributeAtPathWithBounds(path *tftypes.AttributePath) (Attribute, []ElementBounds, error) {
+	var bounds []ElementBounds
+	var current interface{} = s
+	var lastAttribute Attribute
+	steps := path.Steps()
+	for i, step := range steps {
+		stepper, ok := current.(tftypes.AttributePathStepper)
+		if !ok {
+			return Attribute{}, nil, fmt.Errorf("%v still remains in the path: %w", tftypes.NewAttributePathWithSteps(steps[i:]), tftypes.ErrInvalidStep)
+		}
+		next, err := stepper.ApplyTerraform5AttributePathStep(step)
+		if err != nil {
+			return Attribute{}, nil, fmt.Errorf("%v still remains in the path: %w", tftypes.NewAttributePathWithSteps(steps[i:]), err)
+		}
+		switch n := next.(type) {
+		case Attribute:
+			lastAttribute = n
+		case nestedElement:
+			bounds = append(bounds, ElementBounds{
+				Path:     tftypes.NewAttributePathWithSteps(steps[:i+1]),
+				Index:    n.index,
+				MinItems: n.min,
+				MaxItems: n.max,
+			})
+		}
+		current = next
+	}
+
+	switch c := current.(type) {
+	case Attribute:
+		return c, bounds, nil
+	case nestedAttributes, nestedElement:
+		return lastAttribute, bounds, nil
+	case attr.Type:
+		return Attribute{}, nil, ErrPathInsideAtomicAttribute
+	default:
+		return Attribute{}, nil, fmt.Errorf("got unexpected type %T", current)
+	}
+}
+
+// AttributeValueAtPath returns the value at `path` in `val` as an attr.Value
+// produced by the attr.Type of the attribute at `path`. `val` must be a value
+// of the entire object described by the schema, like the raw value of a
+// config, plan, or state. Consumers should assert the type of the returned
+// value with the desired attr.Type.
+func (s Schema) AttributeValueAtPath(ctx context.Context, val tftypes.Value, path *tftypes.AttributePath) (attr.Value, error) {
+	if !val.Type().Is(s.TerraformType(ctx)) {
+		return nil, fmt.Errorf("can't use value of type %s with schema of type %s", val.Type(), s.TerraformType(ctx))
+	}
+
+	attrType, err := s.AttributeTypeAtPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("error walking schema: %w", err)
+	}
+
+	rawValue, remaining, err := tftypes.WalkAttributePath(val, path)
+	if err != nil {
+		return nil, fmt.Errorf("error walking value: %v still remains in the path: %w", remaining, err)
+	}
+	attrValue, ok := rawValue.(tftypes.Value)
+	if !ok {
+		return nil, fmt.Errorf("got non-tftypes.Value result %v", rawValue)
+	}
+
+	return attrType.ValueFromTerraform(ctx, attrValue)
+}
+
+// IDAttribute returns the conventional "id" attribute for resources: a
+// computed string that is set when the resource is created and keeps its
+// value from state on every plan after that.
+func IDAttribute() Attribute {
+	return Attribute{
+		Type:        types.StringType,
+		Computed:    true,
+		Description: "The unique identifier of the resource.",
+		PlanModifiers: []AttributePlanModifier{
+			UseStateForUnknown(),
+		},
+	}
+}
+
+// WithIDAttribute returns a copy of the schema with IDAttribute set as the
+// IDAttributeName attribute, replacing any attribute already using that name.
+func (s Schema) WithIDAttribute() Schema {
+	attrs := make(map[string]Attribute, len(s.Attributes)+1)
+	for name, attr := range s.Attributes {
+		attrs[name] = attr
+	}
+	attrs[IDAttributeName] = IDAttribute()
+	s.Attributes = attrs
+	return s
+}
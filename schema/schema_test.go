@@ -1,11 +1,13 @@
 package schema
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestSchemaAttributeType(t *testing.T) {
@@ -78,3 +80,268 @@ func TestSchemaAttributeType(t *testing.T) {
 		t.Fatalf("types not equal (+wanted, -got): %s", cmp.Diff(expectedType, actualType))
 	}
 }
+
+func TestSchemaWithIDAttribute(t *testing.T) {
+	t.Parallel()
+
+	original := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+	got := original.WithIDAttribute()
+
+	if len(original.Attributes) != 1 {
+		t.Errorf("Expected original schema to be unmodified, got attributes %v", original.Attributes)
+	}
+	if len(got.Attributes) != 2 {
+		t.Fatalf("Expected 2 attributes, got %v", got.Attributes)
+	}
+	id, ok := got.Attributes[IDAttributeName]
+	if !ok {
+		t.Fatalf("Expected %q attribute to be set", IDAttributeName)
+	}
+	if !id.Equal(IDAttribute()) {
+		t.Errorf("Expected %q attribute to be %+v, got %+v", IDAttributeName, IDAttribute(), id)
+	}
+	if !id.Computed || id.Optional || id.Required {
+		t.Errorf("Expected %q attribute to be computed only, got %+v", IDAttributeName, id)
+	}
+	if len(id.PlanModifiers) != 1 {
+		t.Errorf("Expected %q attribute to have one plan modifier, got %v", IDAttributeName, id.PlanModifiers)
+	}
+}
+
+func TestSchemaAttributeValueAtPath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"tags": {
+				Type: types.MapType{
+					ElemType: types.StringType,
+				},
+				Optional: true,
+			},
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+	diskType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	val := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"tags":  tftypes.Map{AttributeType: tftypes.String},
+			"disks": tftypes.List{ElementType: diskType},
+		},
+	}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "hello"),
+		"tags": tftypes.NewValue(tftypes.Map{AttributeType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		"disks": tftypes.NewValue(tftypes.List{ElementType: diskType}, []tftypes.Value{
+			tftypes.NewValue(diskType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "disk-1"),
+			}),
+		}),
+	})
+
+	type testCase struct {
+		val           tftypes.Value
+		path          *tftypes.AttributePath
+		expected      attr.Value
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"attribute": {
+			val:      val,
+			path:     tftypes.NewAttributePath().WithAttributeName("name"),
+			expected: types.String{Value: "hello"},
+		},
+		"element": {
+			val:      val,
+			path:     tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyString("env"),
+			expected: types.String{Unknown: true},
+		},
+		"nested-attribute": {
+			val:      val,
+			path:     tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("id"),
+			expected: types.String{Value: "disk-1"},
+		},
+		"nested-attributes-element": {
+			val:  val,
+			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			expected: types.Object{
+				AttrTypes: map[string]attr.Type{
+					"id": types.StringType,
+				},
+				Attrs: map[string]attr.Value{
+					"id": types.String{Value: "disk-1"},
+				},
+			},
+		},
+		"unknown-attribute": {
+			val:           val,
+			path:          tftypes.NewAttributePath().WithAttributeName("nope"),
+			expectedError: "error walking schema: AttributeName(\"nope\") still remains in the path: could not find attribute \"nope\" in schema",
+		},
+		"missing-element": {
+			val:           val,
+			path:          tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyString("missing"),
+			expectedError: "error walking value: ElementKeyString(\"missing\") still remains in the path: step cannot be applied to this value",
+		},
+		"wrong-value-type": {
+			val:           tftypes.NewValue(tftypes.String, "hello"),
+			path:          tftypes.NewAttributePath().WithAttributeName("name"),
+			expectedError: "can't use value of type tftypes.String with schema of type tftypes.Object[\"disks\":tftypes.List[tftypes.Object[\"id\":tftypes.String]], \"name\":tftypes.String, \"tags\":tftypes.Map[tftypes.String]]",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testSchema.AttributeValueAtPath(context.Background(), tc.val, tc.path)
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %q", tc.expectedError, err.Error())
+				}
+				return
+			}
+			if tc.expectedError != "" {
+				t.Fatalf("Expected error %q, got none", tc.expectedError)
+			}
+			if !tc.expected.Equal(got) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSchemaAttributeAtPathWithBounds(t *testing.T) {
+	t.Parallel()
+
+	idAttribute := Attribute{
+		Type:     types.StringType,
+		Required: true,
+	}
+	disksAttribute := Attribute{
+		Attributes: ListNestedAttributes(map[string]Attribute{
+			"id": idAttribute,
+		}, ListNestedAttributesOptions{MaxItems: 2}),
+		Optional: true,
+	}
+	boundsSchema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"servers": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"disks": disksAttribute,
+				}, ListNestedAttributesOptions{MinItems: 1, MaxItems: 3}),
+				Required: true,
+			},
+		},
+	}
+	serversPath := tftypes.NewAttributePath().WithAttributeName("servers")
+
+	type testCase struct {
+		path              *tftypes.AttributePath
+		expectedAttribute Attribute
+		expectedBounds    []ElementBounds
+		expectedWithin    [][2]bool
+		expectedError     string
+	}
+
+	tests := map[string]testCase{
+		"top-level": {
+			path:              tftypes.NewAttributePath().WithAttributeName("name"),
+			expectedAttribute: boundsSchema.Attributes["name"],
+		},
+		"nested": {
+			path:              serversPath.WithElementKeyInt(0).WithAttributeName("disks").WithElementKeyInt(2).WithAttributeName("id"),
+			expectedAttribute: idAttribute,
+			expectedBounds: []ElementBounds{
+				{Path: serversPath.WithElementKeyInt(0), Index: 0, MinItems: 1, MaxItems: 3},
+				{Path: serversPath.WithElementKeyInt(0).WithAttributeName("disks").WithElementKeyInt(2), Index: 2, MaxItems: 2},
+			},
+			expectedWithin: [][2]bool{{true, true}, {false, false}},
+		},
+		"element": {
+			path:              serversPath.WithElementKeyInt(1),
+			expectedAttribute: boundsSchema.Attributes["servers"],
+			expectedBounds: []ElementBounds{
+				{Path: serversPath.WithElementKeyInt(1), Index: 1, MinItems: 1, MaxItems: 3},
+			},
+			expectedWithin: [][2]bool{{false, true}},
+		},
+		"inside-atomic": {
+			path:          tftypes.NewAttributePath().WithAttributeName("name").WithElementKeyInt(0),
+			expectedError: `ElementKeyInt(0) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyInt to types.StringType`,
+		},
+		"unknown-attribute": {
+			path:          serversPath.WithElementKeyInt(0).WithAttributeName("nope"),
+			expectedError: `AttributeName("nope") still remains in the path: no attribute "nope" on Attributes`,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, bounds, err := boundsSchema.AttributeAtPathWithBounds(tc.path)
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %q", tc.expectedError, err.Error())
+				}
+				return
+			}
+			if tc.expectedError != "" {
+				t.Fatalf("Expected error %q, got none", tc.expectedError)
+			}
+			if !got.Equal(tc.expectedAttribute) {
+				t.Errorf("Expected attribute %+v, got %+v", tc.expectedAttribute, got)
+			}
+			if diff := cmp.Diff(bounds, tc.expectedBounds); diff != "" {
+				t.Errorf("Unexpected diff in bounds (+wanted, -got): %s", diff)
+			}
+			for i, within := range tc.expectedWithin {
+				if got := bounds[i].WithinMinItems(); got != within[0] {
+					t.Errorf("Expected WithinMinItems of bounds %d to be %v, got %v", i, within[0], got)
+				}
+				if got := bounds[i].WithinMaxItems(); got != within[1] {
+					t.Errorf("Expected WithinMaxItems of bounds %d to be %v, got %v", i, within[1], got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"fmt"
+)
+
+// SharedSchema is a single definition of the attributes of an object that is
+// managed by a resource and looked up by a data source, from which the
+// schemas of both are derived, so they can't drift apart.
+//
+// The names in ResourceOnly, DataSourceOnly, DataSourceRequired, and
+// DataSourceOptional are names of top-level attributes of Schema.
+type SharedSchema struct {
+	// Schema is the resource's view of the attributes, with the plan
+	// modifiers and Required, Optional, and Computed settings the
+	// resource uses.
+	Schema Schema
+
+	// ResourceOnly names the attributes only the resource has, like
+	// write-only secrets the API doesn't return.
+	ResourceOnly []string
+
+	// DataSourceOnly names the attributes only the data source has, like
+	// filters used to find the object.
+	DataSourceOnly []string
+
+	// DataSourceRequired and DataSourceOptional name the attributes
+	// practitioners set to look up the object with the data source. They
+	// keep the Required or Optional setting they're listed under, and
+	// optional ones are also computed, so the data source can return
+	// their values. Every other attribute of the data source is computed
+	// only.
+	DataSourceRequired []string
+	DataSourceOptional []string
+}
+
+// Resource returns the resource's schema: Schema without the DataSourceOnly
+// attributes.
+func (s SharedSchema) Resource() (Schema, error) {
+	if err := s.checkNames(); err != nil {
+		return Schema{}, err
+	}
+	return s.Schema.without(s.DataSourceOnly), nil
+}
+
+// DataSource returns the data source's schema: Schema without the
+// ResourceOnly attributes, with every attribute computed only, as described
+// by ComputedOnly, except the DataSourceRequired and DataSourceOptional
+// attributes.
+func (s SharedSchema) DataSource() (Schema, error) {
+	if err := s.checkNames(); err != nil {
+		return Schema{}, err
+	}
+	result := s.Schema.without(s.ResourceOnly).ComputedOnly()
+	for _, name := range s.DataSourceRequired {
+		a := result.Attributes[name]
+		a.Required = true
+		a.Computed = false
+		result.Attributes[name] = a
+	}
+	for _, name := range s.DataSourceOptional {
+		a := result.Attributes[name]
+		a.Optional = true
+		result.Attributes[name] = a
+	}
+	return result, nil
+}
+
+// checkNames returns an error if any of the names in `s` aren't attributes of
+// its schema, or are in lists that conflict with each other.
+func (s SharedSchema) checkNames() error {
+	lists := []struct {
+		name  string
+		names []string
+	}{
+		{"ResourceOnly", s.ResourceOnly},
+		{"DataSourceOnly", s.DataSourceOnly},
+		{"DataSourceRequired", s.DataSourceRequired},
+		{"DataSourceOptional", s.DataSourceOptional},
+	}
+	// an attribute can be in DataSourceOnly and one of DataSourceRequired
+	// or DataSourceOptional, but in no other two lists
+	compatible := map[[2]string]bool{
+		{"DataSourceOnly", "DataSourceRequired"}: true,
+		{"DataSourceOnly", "DataSourceOptional"}: true,
+	}
+	seen := map[string]string{}
+	for _, list := range lists {
+		for _, name := range list.names {
+			if _, ok := s.Schema.Attributes[name]; !ok {
+				return fmt.Errorf("%s names %q, which isn't an attribute of the schema", list.name, name)
+			}
+			if other, ok := seen[name]; ok && !compatible[[2]string{other, list.name}] {
+				return fmt.Errorf("%q can't be in both %s and %s", name, other, list.name)
+			}
+			seen[name] = list.name
+		}
+	}
+	return nil
+}
+
+// without returns a copy of the schema without the attributes named in
+// `names`.
+func (s Schema) without(names []string) Schema {
+	excluded := make(map[string]bool, len(names))
+	for _, name := range names {
+		excluded[name] = true
+	}
+	attrs := make(map[string]Attribute, len(s.Attributes))
+	for name, a := range s.Attributes {
+		if !excluded[name] {
+			attrs[name] = a
+		}
+	}
+	s.Attributes = attrs
+	return s
+}
@@ -0,0 +1,138 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSharedSchema(t *testing.T) {
+	t.Parallel()
+
+	shared := SharedSchema{
+		Schema: Schema{
+			Version: 1,
+			Attributes: map[string]Attribute{
+				"name": {
+					Type:     types.StringType,
+					Required: true,
+				},
+				"size": {
+					Type:     types.NumberType,
+					Optional: true,
+					Computed: true,
+				},
+				"password": {
+					Type:      types.StringType,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"most_recent": {
+					Type:     types.BoolType,
+					Optional: true,
+				},
+			},
+		}.WithIDAttribute(),
+		ResourceOnly:       []string{"password"},
+		DataSourceOnly:     []string{"most_recent"},
+		DataSourceRequired: []string{"name"},
+		DataSourceOptional: []string{"most_recent"},
+	}
+
+	gotResource, err := shared.Resource()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedResource := Schema{
+		Version: 1,
+		Attributes: map[string]Attribute{
+			"name":     shared.Schema.Attributes["name"],
+			"size":     shared.Schema.Attributes["size"],
+			"password": shared.Schema.Attributes["password"],
+			"id":       IDAttribute(),
+		},
+	}
+	if diff := cmp.Diff(expectedResource, gotResource, cmp.Comparer(func(a, b Attribute) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("Unexpected diff in resource schema (+wanted, -got): %s", diff)
+	}
+	if len(gotResource.Attributes["id"].PlanModifiers) != 1 {
+		t.Errorf("Expected the resource schema to keep plan modifiers, got %+v", gotResource.Attributes["id"])
+	}
+
+	gotDataSource, err := shared.DataSource()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedDataSource := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"size": {
+				Type:     types.NumberType,
+				Computed: true,
+			},
+			"most_recent": {
+				Type:     types.BoolType,
+				Optional: true,
+				Computed: true,
+			},
+			"id": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The unique identifier of the resource.",
+			},
+		},
+	}
+	if diff := cmp.Diff(expectedDataSource, gotDataSource, cmp.Comparer(func(a, b Attribute) bool { return a.Equal(b) })); diff != "" {
+		t.Errorf("Unexpected diff in data source schema (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSharedSchemaInvalidNames(t *testing.T) {
+	t.Parallel()
+
+	attrs := map[string]Attribute{
+		"name": {
+			Type:     types.StringType,
+			Required: true,
+		},
+	}
+
+	tests := map[string]struct {
+		shared        SharedSchema
+		expectedError string
+	}{
+		"missing": {
+			shared: SharedSchema{
+				Schema:             Schema{Attributes: attrs},
+				DataSourceRequired: []string{"nme"},
+			},
+			expectedError: `DataSourceRequired names "nme", which isn't an attribute of the schema`,
+		},
+		"conflict": {
+			shared: SharedSchema{
+				Schema:             Schema{Attributes: attrs},
+				ResourceOnly:       []string{"name"},
+				DataSourceRequired: []string{"name"},
+			},
+			expectedError: `"name" can't be in both ResourceOnly and DataSourceRequired`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := test.shared.Resource(); err == nil || err.Error() != test.expectedError {
+				t.Errorf("Expected Resource to return error %q, got %v", test.expectedError, err)
+			}
+			if _, err := test.shared.DataSource(); err == nil || err.Error() != test.expectedError {
+				t.Errorf("Expected DataSource to return error %q, got %v", test.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package attrcmp provides go-cmp options for comparing attr.Values and
+// attr.Types in tests, so diffs report values that differ according to their
+// Equal methods, rendered compactly, instead of every field of every nested
+// value.
+package attrcmp
+
+import (
+	"math/big"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Options returns cmp.Options that compare:
+//
+//   - attr.Values with their Equal methods, which compare element and
+//     attribute types with attr.Type.Equal rather than by pointer identity,
+//     and map elements regardless of their order. Values that differ are
+//     reported as a whole, using their String methods where available.
+//
+//   - attr.Types with their Equal methods.
+//
+//   - *big.Floats by the number they hold, regardless of precision, mode,
+//     or representation.
+//
+// Pass them to cmp.Diff or cmp.Equal:
+//
+//	if diff := cmp.Diff(expected, got, attrcmp.Options()); diff != "" {
+//		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+//	}
+func Options() cmp.Options {
+	return cmp.Options{
+		cmp.Comparer(valuesEqual),
+		cmp.Comparer(typesEqual),
+		cmp.Comparer(bigFloatsEqual),
+	}
+}
+
+func valuesEqual(a, b attr.Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(b)
+}
+
+func typesEqual(a, b attr.Type) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(b)
+}
+
+func bigFloatsEqual(a, b *big.Float) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Cmp(b) == 0
+}
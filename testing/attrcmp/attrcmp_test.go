@@ -0,0 +1,108 @@
+package attrcmp
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testStruct struct {
+	Value attr.Value
+	Type  attr.Type
+	Float *big.Float
+}
+
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	objType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"port": types.NumberType,
+	}}
+	obj := func(port *big.Float) attr.Value {
+		return types.Object{
+			AttrTypes: map[string]attr.Type{"port": types.NumberType},
+			Attrs: map[string]attr.Value{
+				"port": types.Number{Value: port},
+			},
+		}
+	}
+	list := func(elems ...attr.Value) attr.Value {
+		return types.List{ElemType: objType, Elems: elems}
+	}
+
+	type testCase struct {
+		a            testStruct
+		b            testStruct
+		expectedDiff []string
+	}
+
+	tests := map[string]testCase{
+		"equal": {
+			a: testStruct{
+				Value: list(obj(big.NewFloat(80))),
+				Type:  types.ListType{ElemType: objType},
+				Float: big.NewFloat(1.5),
+			},
+			b: testStruct{
+				Value: list(obj(new(big.Float).SetPrec(512).SetInt64(80))),
+				Type: types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"port": types.NumberType,
+				}}},
+				Float: new(big.Float).SetPrec(1024).SetFloat64(1.5),
+			},
+		},
+		"nil": {},
+		"different-values": {
+			a: testStruct{
+				Value: list(obj(big.NewFloat(80))),
+			},
+			b: testStruct{
+				Value: list(obj(big.NewFloat(443))),
+			},
+			expectedDiff: []string{"[{port = 80}]", "[{port = 443}]"},
+		},
+		"different-types": {
+			a: testStruct{
+				Type: types.ListType{ElemType: types.StringType},
+			},
+			b: testStruct{
+				Type: types.ListType{ElemType: types.NumberType},
+			},
+			expectedDiff: []string{"Type"},
+		},
+		"different-floats": {
+			a: testStruct{
+				Float: big.NewFloat(1.5),
+			},
+			b: testStruct{
+				Float: big.NewFloat(2),
+			},
+			expectedDiff: []string{"Float"},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff := cmp.Diff(tc.a, tc.b, Options())
+			if len(tc.expectedDiff) == 0 {
+				if diff != "" {
+					t.Errorf("Expected no diff, got: %s", diff)
+				}
+				return
+			}
+			for _, expected := range tc.expectedDiff {
+				if !strings.Contains(diff, expected) {
+					t.Errorf("Expected diff to contain %q, got: %s", expected, diff)
+				}
+			}
+		})
+	}
+}
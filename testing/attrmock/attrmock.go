@@ -0,0 +1,206 @@
+// Package attrmock provides configurable attr.Type and attr.Value test
+// doubles, for unit testing code that works with arbitrary types, like
+// framework extensions and custom types, against edge cases such as
+// conversion failures that the types package never produces.
+package attrmock
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.TypeWithValidate = Type{}
+	_ attr.ValueWithType    = Value{}
+)
+
+// Type is an attr.Type whose behavior is controlled by its fields. The zero
+// value is a usable Type representing a string. Any function left nil falls
+// back to a default behavior, documented on the field.
+type Type struct {
+	// TfType is the tftypes.Type returned by TerraformType. It defaults to
+	// tftypes.String.
+	TfType tftypes.Type
+
+	// ValueFromTerraformFunc, if set, is called by ValueFromTerraform. By
+	// default, ValueFromTerraform returns an error if the tftypes.Value
+	// isn't of TfType, and a Value holding its data otherwise.
+	ValueFromTerraformFunc func(context.Context, tftypes.Value) (attr.Value, error)
+
+	// EqualFunc, if set, is called by Equal. By default, Equal returns
+	// true if the other Type is also a Type with the same TfType.
+	EqualFunc func(attr.Type) bool
+
+	// ValidateFunc, if set, is called by Validate. By default, Validate
+	// returns no diagnostics.
+	ValidateFunc func(context.Context, tftypes.Value) []*tfprotov6.Diagnostic
+
+	// ApplyTerraform5AttributePathStepFunc, if set, is called by
+	// ApplyTerraform5AttributePathStep. By default, no steps can be
+	// applied to the Type.
+	ApplyTerraform5AttributePathStepFunc func(tftypes.AttributePathStep) (interface{}, error)
+}
+
+// TerraformType returns TfType, or tftypes.String if it isn't set.
+func (t Type) TerraformType(_ context.Context) tftypes.Type {
+	if t.TfType == nil {
+		return tftypes.String
+	}
+	return t.TfType
+}
+
+// ValueFromTerraform returns the result of ValueFromTerraformFunc, or a Value
+// holding the data in `in` if it isn't set.
+func (t Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if t.ValueFromTerraformFunc != nil {
+		return t.ValueFromTerraformFunc(ctx, in)
+	}
+	if !in.Type().Is(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("can't use %s as value of attrmock.Type, can only use %s values", in.Type(), t.TerraformType(ctx))
+	}
+	raw, err := rawValue(in)
+	if err != nil {
+		return nil, err
+	}
+	return Value{
+		MockType: t,
+		Raw:      raw,
+	}, nil
+}
+
+// Equal returns the result of EqualFunc, or whether `o` is a Type with the
+// same Terraform type if it isn't set.
+func (t Type) Equal(o attr.Type) bool {
+	if t.EqualFunc != nil {
+		return t.EqualFunc(o)
+	}
+	other, ok := o.(Type)
+	if !ok {
+		return false
+	}
+	ctx := context.Background()
+	return t.TerraformType(ctx).Is(other.TerraformType(ctx)) && other.TerraformType(ctx).Is(t.TerraformType(ctx))
+}
+
+// Validate returns the result of ValidateFunc, or no diagnostics if it isn't
+// set.
+func (t Type) Validate(ctx context.Context, in tftypes.Value) []*tfprotov6.Diagnostic {
+	if t.ValidateFunc != nil {
+		return t.ValidateFunc(ctx, in)
+	}
+	return nil
+}
+
+// ApplyTerraform5AttributePathStep returns the result of
+// ApplyTerraform5AttributePathStepFunc, or an error if it isn't set.
+func (t Type) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	if t.ApplyTerraform5AttributePathStepFunc != nil {
+		return t.ApplyTerraform5AttributePathStepFunc(step)
+	}
+	return nil, fmt.Errorf("cannot apply step %T to attrmock.Type", step)
+}
+
+// Value is an attr.Value whose behavior is controlled by its fields. Values
+// returned by Type.ValueFromTerraform hold the data they were created from.
+type Value struct {
+	// MockType is the Type returned by the Type method, and the type Raw
+	// is interpreted as.
+	MockType Type
+
+	// Raw is returned by ToTerraformValue. It must be something
+	// tftypes.NewValue accepts for MockType's Terraform type.
+	Raw interface{}
+
+	// ToTerraformValueError, if set, is returned by ToTerraformValue
+	// instead of Raw.
+	ToTerraformValueError error
+
+	// EqualFunc, if set, is called by Equal. By default, Equal returns
+	// true if the other Value is also a Value with an equal MockType and
+	// an equal Raw.
+	EqualFunc func(attr.Value) bool
+}
+
+// ToTerraformValue returns ToTerraformValueError if it is set, or Raw
+// otherwise.
+func (v Value) ToTerraformValue(_ context.Context) (interface{}, error) {
+	if v.ToTerraformValueError != nil {
+		return nil, v.ToTerraformValueError
+	}
+	return v.Raw, nil
+}
+
+// Equal returns the result of EqualFunc, or whether `o` is a Value with an
+// equal MockType and Raw if it isn't set.
+func (v Value) Equal(o attr.Value) bool {
+	if v.EqualFunc != nil {
+		return v.EqualFunc(o)
+	}
+	other, ok := o.(Value)
+	if !ok {
+		return false
+	}
+	if !v.MockType.Equal(other.MockType) {
+		return false
+	}
+	typ := v.MockType.TerraformType(context.Background())
+	if tftypes.ValidateValue(typ, v.Raw) != nil || tftypes.ValidateValue(typ, other.Raw) != nil {
+		return false
+	}
+	return tftypes.NewValue(typ, v.Raw).Equal(tftypes.NewValue(typ, other.Raw))
+}
+
+// IsNull returns true if Raw is nil.
+func (v Value) IsNull() bool {
+	return v.Raw == nil
+}
+
+// IsUnknown returns true if Raw is tftypes.UnknownValue.
+func (v Value) IsUnknown() bool {
+	return v.Raw == tftypes.UnknownValue
+}
+
+// Type returns MockType.
+func (v Value) Type(_ context.Context) attr.Type {
+	return v.MockType
+}
+
+// rawValue returns the data in `in` as a Go value tftypes.NewValue accepts
+// for the type of `in`.
+func rawValue(in tftypes.Value) (interface{}, error) {
+	if !in.IsKnown() {
+		return tftypes.UnknownValue, nil
+	}
+	if in.IsNull() {
+		return nil, nil
+	}
+	typ := in.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := in.As(&s)
+		return s, err
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		err := in.As(&n)
+		return &n, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := in.As(&b)
+		return b, err
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		err := in.As(&elems)
+		return elems, err
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		err := in.As(&elems)
+		return elems, err
+	}
+	return nil, fmt.Errorf("can't get the data of a %s value", typ)
+}
@@ -0,0 +1,187 @@
+package attrmock
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		typ           Type
+		input         tftypes.Value
+		expected      attr.Value
+		expectedError string
+	}
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.Bool}}
+	tests := map[string]testCase{
+		"string": {
+			input:    tftypes.NewValue(tftypes.String, "hello"),
+			expected: Value{Raw: "hello"},
+		},
+		"number": {
+			typ:      Type{TfType: tftypes.Number},
+			input:    tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			expected: Value{MockType: Type{TfType: tftypes.Number}, Raw: big.NewFloat(1.5)},
+		},
+		"object": {
+			typ: Type{TfType: objType},
+			input: tftypes.NewValue(objType, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.Bool, true),
+			}),
+			expected: Value{MockType: Type{TfType: objType}, Raw: map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.Bool, true),
+			}},
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: Value{Raw: tftypes.UnknownValue},
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: Value{},
+		},
+		"wrong-type": {
+			input:         tftypes.NewValue(tftypes.Bool, true),
+			expectedError: "can't use tftypes.Bool as value of attrmock.Type, can only use tftypes.String values",
+		},
+		"func": {
+			typ: Type{
+				ValueFromTerraformFunc: func(context.Context, tftypes.Value) (attr.Value, error) {
+					return nil, errors.New("conversion failed")
+				},
+			},
+			input:         tftypes.NewValue(tftypes.String, "hello"),
+			expectedError: "conversion failed",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.typ.ValueFromTerraform(context.Background(), test.input)
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if err.Error() != test.expectedError {
+					t.Fatalf("Expected error %q, got %q", test.expectedError, err.Error())
+				}
+				return
+			}
+			if test.expectedError != "" {
+				t.Fatalf("Expected error %q, got none", test.expectedError)
+			}
+			if !test.expected.Equal(got) {
+				t.Errorf("Expected %+v, got %+v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	if !(Type{}).Equal(Type{TfType: tftypes.String}) {
+		t.Error("Expected default Type to equal a string Type")
+	}
+	if (Type{}).Equal(Type{TfType: tftypes.Number}) {
+		t.Error("Expected string Type not to equal a number Type")
+	}
+	if (Type{}).Equal(types.StringType) {
+		t.Error("Expected Type not to equal types.StringType")
+	}
+	typ := Type{EqualFunc: func(attr.Type) bool { return true }}
+	if !typ.Equal(types.BoolType) {
+		t.Error("Expected EqualFunc to be used")
+	}
+}
+
+func TestTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	if diags := (Type{}).Validate(context.Background(), tftypes.NewValue(tftypes.String, "hello")); len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %+v", diags)
+	}
+	typ := Type{
+		ValidateFunc: func(context.Context, tftypes.Value) []*tfprotov6.Diagnostic {
+			return []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "invalid",
+				},
+			}
+		},
+	}
+	diags := typ.Validate(context.Background(), tftypes.NewValue(tftypes.String, "hello"))
+	if len(diags) != 1 || diags[0].Summary != "invalid" {
+		t.Errorf("Expected ValidateFunc diagnostics, got %+v", diags)
+	}
+}
+
+func TestValueToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	got, err := Value{Raw: "hello"}.ToTerraformValue(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if got != "hello" {
+		t.Errorf("Expected %q, got %v", "hello", got)
+	}
+
+	_, err = Value{Raw: "hello", ToTerraformValueError: errors.New("boom")}.ToTerraformValue(context.Background())
+	if err == nil || err.Error() != "boom" {
+		t.Errorf("Expected error %q, got %v", "boom", err)
+	}
+}
+
+func TestValueEqual(t *testing.T) {
+	t.Parallel()
+
+	if !(Value{Raw: "hello"}).Equal(Value{Raw: "hello"}) {
+		t.Error("Expected equal values to be equal")
+	}
+	if (Value{Raw: "hello"}).Equal(Value{Raw: "world"}) {
+		t.Error("Expected different values not to be equal")
+	}
+	if (Value{Raw: "hello"}).Equal(types.String{Value: "hello"}) {
+		t.Error("Expected Value not to equal types.String")
+	}
+	if (Value{Raw: true}).Equal(Value{Raw: true}) {
+		t.Error("Expected values with invalid data not to be equal")
+	}
+	v := Value{EqualFunc: func(attr.Value) bool { return true }}
+	if !v.Equal(types.Bool{Value: true}) {
+		t.Error("Expected EqualFunc to be used")
+	}
+}
+
+// TestTypeConversionFailure shows Type being used to test how code handles
+// element types that fail to convert values.
+func TestTypeConversionFailure(t *testing.T) {
+	t.Parallel()
+
+	listType := types.ListType{
+		ElemType: Type{
+			ValueFromTerraformFunc: func(context.Context, tftypes.Value) (attr.Value, error) {
+				return nil, errors.New("conversion failed")
+			},
+		},
+	}
+	_, err := listType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+	}))
+	if err == nil || err.Error() != "conversion failed" {
+		t.Errorf("Expected error %q, got %v", "conversion failed", err)
+	}
+}
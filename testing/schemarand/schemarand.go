@@ -0,0 +1,267 @@
+// Package schemarand generates random configuration values that conform to a
+// schema.Schema, for property-based testing of resources and data sources.
+package schemarand
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	defaultMaxElements = 3
+	defaultMaxAttempts = 10
+	stringCharacters   = "abcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+// Generator generates random configuration values for schemas. Required
+// attributes are always set, optional attributes are sometimes left null, and
+// attributes that are only computed are always null, as practitioners can't
+// configure them.
+//
+// Values of types implementing attr.TypeWithValidate are regenerated until
+// they pass validation, up to MaxAttempts times. Other constraints, like
+// plan modifiers or checks made by the resource itself, aren't known to the
+// Generator and aren't respected.
+type Generator struct {
+	// Rand is the source of randomness. Using a Rand with a fixed seed
+	// makes the generated values reproducible. It must be set.
+	Rand *rand.Rand
+
+	// NullChance is the probability, between 0 and 1, that an optional
+	// attribute is left null.
+	NullChance float64
+
+	// MaxElements is the largest number of elements generated for lists,
+	// sets, and maps, unless nested attributes require more. It defaults
+	// to 3.
+	MaxElements int
+
+	// MaxAttempts is the number of times a value is generated for a type
+	// implementing attr.TypeWithValidate before giving up. It defaults to
+	// 10.
+	MaxAttempts int
+}
+
+// Config returns a random configuration value for `s`.
+func (g Generator) Config(ctx context.Context, s schema.Schema) (tftypes.Value, error) {
+	if g.Rand == nil {
+		return tftypes.Value{}, fmt.Errorf("a Rand must be set to generate values")
+	}
+	return g.attributes(ctx, s.Attributes, tftypes.NewAttributePath())
+}
+
+func (g Generator) maxElements() int {
+	if g.MaxElements > 0 {
+		return g.MaxElements
+	}
+	return defaultMaxElements
+}
+
+func (g Generator) maxAttempts() int {
+	if g.MaxAttempts > 0 {
+		return g.MaxAttempts
+	}
+	return defaultMaxAttempts
+}
+
+// attributes returns an object value for `attrs`, the attributes of a schema
+// or of nested attributes, located at `path`.
+func (g Generator) attributes(ctx context.Context, attrs map[string]schema.Attribute, path *tftypes.AttributePath) (tftypes.Value, error) {
+	// generate attributes in order, so a seeded Rand always produces the
+	// same value
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	types := make(map[string]tftypes.Type, len(attrs))
+	vals := make(map[string]tftypes.Value, len(attrs))
+	for _, name := range names {
+		val, err := g.attribute(ctx, attrs[name], path.WithAttributeName(name))
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		types[name] = val.Type()
+		vals[name] = val
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: types}, vals), nil
+}
+
+// attribute returns a value for `attribute`, located at `path`.
+func (g Generator) attribute(ctx context.Context, attribute schema.Attribute, path *tftypes.AttributePath) (tftypes.Value, error) {
+	var typ tftypes.Type
+	switch {
+	case attribute.Type != nil:
+		typ = attribute.Type.TerraformType(ctx)
+	case attribute.Attributes != nil:
+		typ = attribute.Attributes.AttributeType().TerraformType(ctx)
+	default:
+		return tftypes.Value{}, path.NewErrorf("attribute has neither a Type nor Attributes")
+	}
+	if !attribute.Required && (!attribute.Optional || g.Rand.Float64() < g.NullChance) {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if attribute.Attributes != nil {
+		return g.nestedAttributes(ctx, attribute.Attributes, typ, path)
+	}
+	return g.validValue(ctx, attribute.Type, path)
+}
+
+// nestedAttributes returns a value of type `typ` for `nested`, located at
+// `path`, respecting its minimum and maximum number of elements.
+func (g Generator) nestedAttributes(ctx context.Context, nested schema.NestedAttributes, typ tftypes.Type, path *tftypes.AttributePath) (tftypes.Value, error) {
+	if nested.GetNestingMode() == schema.NestingModeSingle {
+		return g.attributes(ctx, nested.GetAttributes(), path)
+	}
+
+	min, max := int(nested.GetMinItems()), int(nested.GetMaxItems())
+	if max <= 0 {
+		max = g.maxElements()
+		if max < min {
+			max = min
+		}
+	}
+	n := min
+	if max > min {
+		n += g.Rand.Intn(max - min + 1)
+	}
+
+	switch nested.GetNestingMode() {
+	case schema.NestingModeList, schema.NestingModeSet:
+		elems := make([]tftypes.Value, 0, n)
+		for i := 0; i < n; i++ {
+			elem, err := g.attributes(ctx, nested.GetAttributes(), path.WithElementKeyInt(int64(i)))
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			// objects in sets must be unique
+			if nested.GetNestingMode() == schema.NestingModeSet && containsValue(elems, elem) {
+				continue
+			}
+			elems = append(elems, elem)
+		}
+		return tftypes.NewValue(typ, elems), nil
+	case schema.NestingModeMap:
+		elems := make(map[string]tftypes.Value, n)
+		for len(elems) < n {
+			key := g.randomString()
+			elem, err := g.attributes(ctx, nested.GetAttributes(), path.WithElementKeyString(key))
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			elems[key] = elem
+		}
+		return tftypes.NewValue(typ, elems), nil
+	}
+	return tftypes.Value{}, path.NewErrorf("unsupported nesting mode %d", nested.GetNestingMode())
+}
+
+// validValue returns a value of `attrType`, located at `path`, that passes
+// the type's validation, if it has any.
+func (g Generator) validValue(ctx context.Context, attrType attr.Type, path *tftypes.AttributePath) (tftypes.Value, error) {
+	validator, ok := attrType.(attr.TypeWithValidate)
+	for attempt := 0; attempt < g.maxAttempts(); attempt++ {
+		val := g.value(attrType.TerraformType(ctx))
+		if !ok {
+			return val, nil
+		}
+		var invalid bool
+		for _, diag := range validator.Validate(ctx, val) {
+			if diag.Severity == tfprotov6.DiagnosticSeverityError {
+				invalid = true
+				break
+			}
+		}
+		if !invalid {
+			return val, nil
+		}
+	}
+	return tftypes.Value{}, path.NewErrorf("couldn't generate a valid value in %d attempts", g.maxAttempts())
+}
+
+// value returns a random known value of `typ`.
+func (g Generator) value(typ tftypes.Type) tftypes.Value {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		n := g.Rand.Intn(g.maxElements() + 1)
+		elems := make([]tftypes.Value, 0, n)
+		for i := 0; i < n; i++ {
+			elems = append(elems, g.value(typ.ElementType))
+		}
+		return tftypes.NewValue(typ, elems)
+	case tftypes.Set:
+		n := g.Rand.Intn(g.maxElements() + 1)
+		elems := make([]tftypes.Value, 0, n)
+		for i := 0; i < n; i++ {
+			elem := g.value(typ.ElementType)
+			if containsValue(elems, elem) {
+				continue
+			}
+			elems = append(elems, elem)
+		}
+		return tftypes.NewValue(typ, elems)
+	case tftypes.Map:
+		n := g.Rand.Intn(g.maxElements() + 1)
+		elems := make(map[string]tftypes.Value, n)
+		for i := 0; i < n; i++ {
+			elems[g.randomString()] = g.value(typ.AttributeType)
+		}
+		return tftypes.NewValue(typ, elems)
+	case tftypes.Object:
+		names := make([]string, 0, len(typ.AttributeTypes))
+		for name := range typ.AttributeTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		vals := make(map[string]tftypes.Value, len(names))
+		for _, name := range names {
+			vals[name] = g.value(typ.AttributeTypes[name])
+		}
+		return tftypes.NewValue(typ, vals)
+	case tftypes.Tuple:
+		elems := make([]tftypes.Value, 0, len(typ.ElementTypes))
+		for _, elemType := range typ.ElementTypes {
+			elems = append(elems, g.value(elemType))
+		}
+		return tftypes.NewValue(typ, elems)
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, g.randomString())
+	case typ.Is(tftypes.Number):
+		return tftypes.NewValue(typ, new(big.Float).SetInt64(g.Rand.Int63n(2001)-1000))
+	case typ.Is(tftypes.Bool):
+		return tftypes.NewValue(typ, g.Rand.Intn(2) == 1)
+	}
+	// dynamic values can hold anything, use a string
+	return tftypes.NewValue(tftypes.String, g.randomString())
+}
+
+// randomString returns a short random string of lowercase letters and
+// numbers.
+func (g Generator) randomString() string {
+	b := make([]byte, 1+g.Rand.Intn(10))
+	for i := range b {
+		b[i] = stringCharacters[g.Rand.Intn(len(stringCharacters))]
+	}
+	return string(b)
+}
+
+// containsValue returns true if `vals` contains a value equal to `val`.
+func containsValue(vals []tftypes.Value, val tftypes.Value) bool {
+	for _, v := range vals {
+		if v.Equal(val) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package schemarand
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/testing/attrmock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": {
+			Type:     types.StringType,
+			Required: true,
+		},
+		"id": {
+			Type:     types.StringType,
+			Computed: true,
+		},
+		"tags": {
+			Type:     types.MapType{ElemType: types.StringType},
+			Optional: true,
+		},
+		"disks": {
+			Optional: true,
+			Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+				"size_gb": {
+					Type:     types.NumberType,
+					Required: true,
+				},
+				"boot": {
+					Type:     types.BoolType,
+					Optional: true,
+				},
+			}, schema.ListNestedAttributesOptions{MinItems: 2, MaxItems: 4}),
+		},
+	},
+}
+
+func TestGeneratorConfig(t *testing.T) {
+	t.Parallel()
+
+	typ := testSchema.TerraformType(context.Background())
+	for seed := int64(0); seed < 50; seed++ {
+		g := Generator{Rand: rand.New(rand.NewSource(seed)), NullChance: 0.5}
+		got, err := g.Config(context.Background(), testSchema)
+		if err != nil {
+			t.Fatalf("seed %d: unexpected error: %s", seed, err)
+		}
+		if !got.Type().Is(typ) {
+			t.Fatalf("seed %d: expected value of type %s, got %s", seed, typ, got.Type())
+		}
+		var attrs map[string]tftypes.Value
+		if err := got.As(&attrs); err != nil {
+			t.Fatalf("seed %d: unexpected error: %s", seed, err)
+		}
+		if attrs["name"].IsNull() {
+			t.Errorf("seed %d: expected required attribute to be set", seed)
+		}
+		if !attrs["id"].IsNull() {
+			t.Errorf("seed %d: expected computed attribute to be null, got %s", seed, attrs["id"])
+		}
+		if attrs["disks"].IsNull() {
+			continue
+		}
+		var disks []tftypes.Value
+		if err := attrs["disks"].As(&disks); err != nil {
+			t.Fatalf("seed %d: unexpected error: %s", seed, err)
+		}
+		if len(disks) < 2 || len(disks) > 4 {
+			t.Errorf("seed %d: expected 2 to 4 disks, got %d", seed, len(disks))
+		}
+	}
+}
+
+func TestGeneratorConfig_reproducible(t *testing.T) {
+	t.Parallel()
+
+	first, err := Generator{Rand: rand.New(rand.NewSource(42)), NullChance: 0.5}.Config(context.Background(), testSchema)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	second, err := Generator{Rand: rand.New(rand.NewSource(42)), NullChance: 0.5}.Config(context.Background(), testSchema)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !first.Equal(second) {
+		t.Errorf("Expected the same seed to generate the same value, got %s and %s", first, second)
+	}
+}
+
+func TestGeneratorConfig_validate(t *testing.T) {
+	t.Parallel()
+
+	prefixed := func(prefix string) attrmock.Type {
+		return attrmock.Type{
+			ValidateFunc: func(_ context.Context, val tftypes.Value) []*tfprotov6.Diagnostic {
+				var s string
+				if err := val.As(&s); err != nil || !strings.HasPrefix(s, prefix) {
+					return []*tfprotov6.Diagnostic{{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Invalid value",
+					}}
+				}
+				return nil
+			},
+		}
+	}
+
+	g := Generator{Rand: rand.New(rand.NewSource(1)), MaxAttempts: 1000}
+	got, err := g.Config(context.Background(), schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"code": {
+				Type:     prefixed("a"),
+				Required: true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := got.As(&attrs); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	var code string
+	if err := attrs["code"].As(&code); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !strings.HasPrefix(code, "a") {
+		t.Errorf("Expected a value passing validation, got %q", code)
+	}
+
+	g = Generator{Rand: rand.New(rand.NewSource(1)), MaxAttempts: 3}
+	_, err = g.Config(context.Background(), schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"code": {
+				Type:     prefixed("impossible"),
+				Required: true,
+			},
+		},
+	})
+	expected := `AttributeName("code"): couldn't generate a valid value in 3 attempts`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
@@ -0,0 +1,128 @@
+package tfsdk
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// checkApplyConsistency returns an error diagnostic for each value in
+// `applied`, the new state returned by a resource's Create or Update method,
+// that Terraform will reject as inconsistent with `planned`: values that were
+// known in the plan must not change, and values that were unknown must
+// become known.
+func checkApplyConsistency(typeName string, planned, applied tftypes.Value) []*tfprotov6.Diagnostic {
+	return checkAppliedValue(typeName, tftypes.NewAttributePath(), planned, applied)
+}
+
+func checkAppliedValue(typeName string, path *tftypes.AttributePath, planned, applied tftypes.Value) []*tfprotov6.Diagnostic {
+	if !planned.IsKnown() {
+		if !applied.IsFullyKnown() {
+			return []*tfprotov6.Diagnostic{unknownAfterApplyDiagnostic(typeName, path)}
+		}
+		return nil
+	}
+	if planned.IsNull() || applied.IsNull() || !applied.IsKnown() {
+		if planned.IsNull() && applied.IsNull() {
+			return nil
+		}
+		if !applied.IsKnown() {
+			return []*tfprotov6.Diagnostic{unknownAfterApplyDiagnostic(typeName, path)}
+		}
+		return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, "")}
+	}
+
+	var diags []*tfprotov6.Diagnostic
+	switch planned.Type().(type) {
+	case tftypes.Object, tftypes.Map:
+		var plannedVals, appliedVals map[string]tftypes.Value
+		if err := planned.As(&plannedVals); err != nil {
+			return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, err.Error())}
+		}
+		if err := applied.As(&appliedVals); err != nil {
+			return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, err.Error())}
+		}
+		keys := make([]string, 0, len(plannedVals))
+		for key := range plannedVals {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		_, isObject := planned.Type().(tftypes.Object)
+		if !isObject {
+			if len(plannedVals) != len(appliedVals) {
+				return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, fmt.Sprintf("The plan had %d elements, but the new state has %d.", len(plannedVals), len(appliedVals)))}
+			}
+			for _, key := range keys {
+				if _, ok := appliedVals[key]; !ok {
+					return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, fmt.Sprintf("The plan had an element with the key %q, but the new state doesn't.", key))}
+				}
+			}
+		}
+		for _, key := range keys {
+			elemPath := path.WithElementKeyString(key)
+			if isObject {
+				elemPath = path.WithAttributeName(key)
+			}
+			diags = append(diags, checkAppliedValue(typeName, elemPath, plannedVals[key], appliedVals[key])...)
+		}
+	case tftypes.List, tftypes.Tuple:
+		var plannedVals, appliedVals []tftypes.Value
+		if err := planned.As(&plannedVals); err != nil {
+			return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, err.Error())}
+		}
+		if err := applied.As(&appliedVals); err != nil {
+			return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, err.Error())}
+		}
+		if len(plannedVals) != len(appliedVals) {
+			return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, fmt.Sprintf("The plan had %d elements, but the new state has %d.", len(plannedVals), len(appliedVals)))}
+		}
+		for pos := range plannedVals {
+			diags = append(diags, checkAppliedValue(typeName, path.WithElementKeyInt(int64(pos)), plannedVals[pos], appliedVals[pos])...)
+		}
+	case tftypes.Set:
+		// set elements are identified by their values, so elements
+		// that were unknown in the plan can't be matched up with
+		// elements of the new state; only sets that were fully known
+		// can be compared
+		if !applied.IsFullyKnown() {
+			return []*tfprotov6.Diagnostic{unknownAfterApplyDiagnostic(typeName, path)}
+		}
+		if planned.IsFullyKnown() && !planned.Equal(applied) {
+			return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, "")}
+		}
+	default:
+		if !planned.Equal(applied) {
+			return []*tfprotov6.Diagnostic{inconsistentAfterApplyDiagnostic(typeName, path, "")}
+		}
+	}
+	return diags
+}
+
+// inconsistentAfterApplyDiagnostic returns an error that the value at `path`
+// in the new state doesn't match the value in the plan. The values aren't
+// included, as they may be sensitive.
+func inconsistentAfterApplyDiagnostic(typeName string, path *tftypes.AttributePath, reason string) *tfprotov6.Diagnostic {
+	detail := fmt.Sprintf("When applying changes to %s, the provider produced a value for this attribute that doesn't match the planned value.", typeName)
+	if reason != "" {
+		detail += " " + reason
+	}
+	return &tfprotov6.Diagnostic{
+		Severity:  tfprotov6.DiagnosticSeverityError,
+		Summary:   "Provider produced inconsistent result after apply",
+		Detail:    detail + " Values that were known in the plan must not change during apply. This is always a problem with the provider. Please report this to the provider developer.",
+		Attribute: path,
+	}
+}
+
+// unknownAfterApplyDiagnostic returns an error that the value at `path` in
+// the new state is unknown.
+func unknownAfterApplyDiagnostic(typeName string, path *tftypes.AttributePath) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity:  tfprotov6.DiagnosticSeverityError,
+		Summary:   "Provider produced inconsistent result after apply",
+		Detail:    fmt.Sprintf("When applying changes to %s, the provider left this attribute unknown. Every value must be known after apply. This is always a problem with the provider. Please report this to the provider developer.", typeName),
+		Attribute: path,
+	}
+}
@@ -0,0 +1,108 @@
+package tfsdk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCheckApplyConsistency(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"id":    tftypes.String,
+			"tags":  tftypes.List{ElementType: tftypes.String},
+			"zones": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+	value := func(name, id interface{}, tags, zones []interface{}) tftypes.Value {
+		var tagVals, zoneVals interface{}
+		if tags != nil {
+			vals := []tftypes.Value{}
+			for _, tag := range tags {
+				vals = append(vals, tftypes.NewValue(tftypes.String, tag))
+			}
+			tagVals = vals
+		}
+		if zones != nil {
+			vals := []tftypes.Value{}
+			for _, zone := range zones {
+				vals = append(vals, tftypes.NewValue(tftypes.String, zone))
+			}
+			zoneVals = vals
+		}
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":  tftypes.NewValue(tftypes.String, name),
+			"id":    tftypes.NewValue(tftypes.String, id),
+			"tags":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tagVals),
+			"zones": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, zoneVals),
+		})
+	}
+	unknown := tftypes.UnknownValue
+
+	type testCase struct {
+		planned       tftypes.Value
+		applied       tftypes.Value
+		expectedPaths []*tftypes.AttributePath
+	}
+
+	tests := map[string]testCase{
+		"consistent": {
+			planned: value("example", unknown, []interface{}{"a", unknown}, []interface{}{unknown}),
+			applied: value("example", "abc123", []interface{}{"a", "b"}, []interface{}{"us-east-1a", "us-east-1b"}),
+		},
+		"changed": {
+			planned: value("example", unknown, []interface{}{"a", "b"}, []interface{}{"us-east-1a"}),
+			applied: value("EXAMPLE", "abc123", []interface{}{"a", "c"}, []interface{}{"us-east-1b"}),
+			expectedPaths: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("name"),
+				tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(1),
+				tftypes.NewAttributePath().WithAttributeName("zones"),
+			},
+		},
+		"still-unknown": {
+			planned: value("example", unknown, nil, nil),
+			applied: value("example", unknown, nil, nil),
+			expectedPaths: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("id"),
+			},
+		},
+		"list-length": {
+			planned: value("example", "abc123", []interface{}{"a"}, nil),
+			applied: value("example", "abc123", []interface{}{"a", "b"}, nil),
+			expectedPaths: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("tags"),
+			},
+		},
+		"null-set": {
+			planned: value("example", "abc123", nil, nil),
+			applied: value("example", "abc123", nil, []interface{}{"us-east-1a"}),
+			expectedPaths: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("zones"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := checkApplyConsistency("test_resource", tc.planned, tc.applied)
+			var paths []*tftypes.AttributePath
+			for _, diag := range diags {
+				if diag.Severity != tfprotov6.DiagnosticSeverityError {
+					t.Errorf("Expected an error diagnostic, got %+v", diag)
+				}
+				paths = append(paths, diag.Attribute)
+			}
+			if diff := cmp.Diff(paths, tc.expectedPaths); diff != "" {
+				t.Errorf("Unexpected diff in diagnostic paths (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// attributeAtValuePath returns the attribute of `s` whose value is at `path`
+// in a value of `s`. It returns false if the value at `path` isn't the value
+// of an attribute: if `path` is the root of the value, an element of nested
+// attributes, which holds attributes but isn't one, or is inside the value of
+// an attribute.
+func attributeAtValuePath(s schema.Schema, path *tftypes.AttributePath) (schema.Attribute, bool) {
+	rawAttribute, _, err := tftypes.WalkAttributePath(s, path)
+	if err != nil {
+		// the path is inside an attribute, not an attribute
+		return schema.Attribute{}, false
+	}
+	attribute, ok := rawAttribute.(schema.Attribute)
+	return attribute, ok
+}
+
+// walkAttributeValues calls `fn` with the path, attribute, and value of every
+// attribute of `s` in `val`, in the order of tftypes.Walk. Nested attributes
+// are only visited if `fn` returns true for the attribute holding them.
+func walkAttributeValues(s schema.Schema, val tftypes.Value, fn func(*tftypes.AttributePath, schema.Attribute, tftypes.Value) (bool, error)) error {
+	return tftypes.Walk(val, func(path *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		attribute, ok := attributeAtValuePath(s, path)
+		if !ok {
+			// the root and the elements of nested attributes
+			// hold attributes
+			return true, nil
+		}
+		return fn(path, attribute, v)
+	})
+}
+
+// transformAttributeValues returns `val` with the value of every attribute of
+// `s`, including nested attributes, replaced by the result of calling `fn`
+// with its path, attribute, and value. Like tftypes.Transform, nested
+// attributes are transformed before the attributes holding them.
+func transformAttributeValues(s schema.Schema, val tftypes.Value, fn func(*tftypes.AttributePath, schema.Attribute, tftypes.Value) (tftypes.Value, error)) (tftypes.Value, error) {
+	return tftypes.Transform(val, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		attribute, ok := attributeAtValuePath(s, path)
+		if !ok {
+			return v, nil
+		}
+		return fn(path, attribute, v)
+	})
+}
@@ -0,0 +1,88 @@
+package tfsdk
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWalkAttributeValues(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"rules": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"port": {
+						Type:     types.NumberType,
+						Required: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+	typ := s.TerraformType(context.Background())
+	rulesType := typ.(tftypes.Object).AttributeTypes["rules"]
+	ruleType := rulesType.(tftypes.List).ElementType
+	val := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+		}),
+		"rules": tftypes.NewValue(rulesType, []tftypes.Value{
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				"port": tftypes.NewValue(tftypes.Number, 80),
+			}),
+		}),
+	})
+
+	type testCase struct {
+		descend  bool
+		expected []string
+	}
+	tests := map[string]testCase{
+		"descend": {
+			descend: true,
+			expected: []string{
+				`AttributeName("rules")`,
+				`AttributeName("rules").ElementKeyInt(0).AttributeName("port")`,
+				`AttributeName("tags")`,
+			},
+		},
+		"no-descend": {
+			expected: []string{
+				`AttributeName("rules")`,
+				`AttributeName("tags")`,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			err := walkAttributeValues(s, val, func(path *tftypes.AttributePath, attribute schema.Attribute, v tftypes.Value) (bool, error) {
+				got = append(got, path.String())
+				return tc.descend && attribute.Attributes != nil, nil
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
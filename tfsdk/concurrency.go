@@ -0,0 +1,123 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// operationLimiter bounds how many resource and data source operations can
+// run at once, both across the provider and for each type name.
+type operationLimiter struct {
+	global chan struct{}
+
+	perTypeLimits map[string]int
+	perType       map[limitedType]chan struct{}
+	perTypeMu     sync.Mutex
+}
+
+// limitedType identifies the resource or data source type an operation is
+// on. A resource and a data source can share a type name, so they're kept
+// apart when applying per-type limits.
+type limitedType struct {
+	dataSource bool
+	name       string
+}
+
+// newOperationLimiter returns an operationLimiter allowing at most `global`
+// concurrent operations, and at most perType[name] concurrent operations on
+// the resource type named `name`, and as many again on the data source type
+// named `name`. Limits less than 1 mean no limit. If no limits are set, nil
+// is returned.
+func newOperationLimiter(global int, perType map[string]int) *operationLimiter {
+	l := &operationLimiter{
+		perTypeLimits: map[string]int{},
+		perType:       map[limitedType]chan struct{}{},
+	}
+	if global > 0 {
+		l.global = make(chan struct{}, global)
+	}
+	for name, limit := range perType {
+		if limit > 0 {
+			l.perTypeLimits[name] = limit
+		}
+	}
+	if l.global == nil && len(l.perTypeLimits) < 1 {
+		return nil
+	}
+	return l
+}
+
+// typeSemaphore returns the semaphore for `typ`, or nil if the type has no
+// limit.
+func (l *operationLimiter) typeSemaphore(typ limitedType) chan struct{} {
+	limit, ok := l.perTypeLimits[typ.name]
+	if !ok {
+		return nil
+	}
+	l.perTypeMu.Lock()
+	defer l.perTypeMu.Unlock()
+	sem, ok := l.perType[typ]
+	if !ok {
+		sem = make(chan struct{}, limit)
+		l.perType[typ] = sem
+	}
+	return sem
+}
+
+// acquire blocks until an operation on `typ` is allowed to run, or until
+// `ctx` is canceled. The returned function must be called when the operation
+// is complete. It is safe to call acquire on a nil operationLimiter, which
+// never blocks.
+func (l *operationLimiter) acquire(ctx context.Context, typ limitedType) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	// always acquire the per-type semaphore first, so operations waiting
+	// on a busy type don't hold a global slot other types could use
+	typeSem := l.typeSemaphore(typ)
+	if typeSem != nil {
+		select {
+		case typeSem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if l.global != nil {
+		select {
+		case l.global <- struct{}{}:
+		case <-ctx.Done():
+			if typeSem != nil {
+				<-typeSem
+			}
+			return nil, ctx.Err()
+		}
+	}
+	return func() {
+		if l.global != nil {
+			<-l.global
+		}
+		if typeSem != nil {
+			<-typeSem
+		}
+	}, nil
+}
+
+// acquireOperation waits until an operation on the resource or data source
+// type `typ` can run, according to the concurrency limits set in ServeOpts.
+// The returned function must be called when the operation is complete.
+func (s *server) acquireOperation(ctx context.Context, typ limitedType) (func(), []*tfprotov6.Diagnostic) {
+	release, err := s.limiter.acquire(ctx, typ)
+	if err != nil {
+		return nil, []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Operation canceled",
+				Detail:   fmt.Sprintf("The operation on %q was canceled while waiting for other operations to finish: %s", typ.name, err),
+			},
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,165 @@
+package tfsdk
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewOperationLimiter_noLimits(t *testing.T) {
+	t.Parallel()
+
+	l := newOperationLimiter(0, map[string]int{"test_one": 0})
+	if l != nil {
+		t.Fatalf("expected nil limiter, got %+v", l)
+	}
+
+	// a nil limiter should never block
+	release, err := l.acquire(context.Background(), limitedType{name: "test_one"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	release()
+}
+
+func TestOperationLimiter(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		global   int
+		perType  map[string]int
+		typeName string
+		expected int32
+	}
+
+	tests := map[string]testCase{
+		"global": {
+			global:   2,
+			typeName: "test_one",
+			expected: 2,
+		},
+		"per-type": {
+			perType:  map[string]int{"test_one": 3},
+			typeName: "test_one",
+			expected: 3,
+		},
+		"per-type-lower-than-global": {
+			global:   4,
+			perType:  map[string]int{"test_one": 1},
+			typeName: "test_one",
+			expected: 1,
+		},
+		"global-lower-than-per-type": {
+			global:   2,
+			perType:  map[string]int{"test_one": 5},
+			typeName: "test_one",
+			expected: 2,
+		},
+		"other-type-uses-global": {
+			global:   3,
+			perType:  map[string]int{"test_one": 1},
+			typeName: "test_two",
+			expected: 3,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			l := newOperationLimiter(tc.global, tc.perType)
+			var running, max int32
+			wg := new(sync.WaitGroup)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					release, err := l.acquire(context.Background(), limitedType{name: tc.typeName})
+					if err != nil {
+						t.Errorf("unexpected error: %s", err)
+						return
+					}
+					defer release()
+					now := atomic.AddInt32(&running, 1)
+					for {
+						prev := atomic.LoadInt32(&max)
+						if now <= prev || atomic.CompareAndSwapInt32(&max, prev, now) {
+							break
+						}
+					}
+					time.Sleep(10 * time.Millisecond)
+					atomic.AddInt32(&running, -1)
+				}()
+			}
+			wg.Wait()
+			if max > tc.expected {
+				t.Errorf("expected at most %d concurrent operations, got %d", tc.expected, max)
+			}
+		})
+	}
+}
+
+func TestOperationLimiter_dataSourceSharesTypeName(t *testing.T) {
+	t.Parallel()
+
+	l := newOperationLimiter(0, map[string]int{"test_one": 1})
+	release, err := l.acquire(context.Background(), limitedType{name: "test_one"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer release()
+
+	// the resource holds its only slot, but the data source with the same
+	// type name has its own
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	releaseDataSource, err := l.acquire(ctx, limitedType{dataSource: true, name: "test_one"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	releaseDataSource()
+}
+
+func TestOperationLimiter_canceled(t *testing.T) {
+	t.Parallel()
+
+	l := newOperationLimiter(1, nil)
+	release, err := l.acquire(context.Background(), limitedType{name: "test_one"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = l.acquire(ctx, limitedType{name: "test_one"})
+	if err != context.Canceled {
+		t.Fatalf("expected %s, got %v", context.Canceled, err)
+	}
+}
+
+func TestServerAcquireOperation_canceled(t *testing.T) {
+	t.Parallel()
+
+	s := &server{
+		limiter: newOperationLimiter(0, map[string]int{"test_one": 1}),
+	}
+	release, diags := s.acquireOperation(context.Background(), limitedType{name: "test_one"})
+	if diagsHasErrors(diags) {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, diags = s.acquireOperation(ctx, limitedType{name: "test_one"})
+	if !diagsHasErrors(diags) {
+		t.Fatalf("expected an error diagnostic, got %+v", diags)
+	}
+	if diags[0].Summary != "Operation canceled" {
+		t.Errorf("unexpected summary %q", diags[0].Summary)
+	}
+}
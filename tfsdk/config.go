@@ -38,6 +38,17 @@ func (c Config) GetAttribute(ctx context.Context, path *tftypes.AttributePath) (
 	return attrType.ValueFromTerraform(ctx, attrValue)
 }
 
+// PathMatches returns every path in the config matching `expr`, along with the
+// value at that path. Consumers should assert the type of each value with the
+// desired attr.Type.
+func (c Config) PathMatches(ctx context.Context, expr PathExpression) ([]PathMatch, error) {
+	matches, err := pathMatches(ctx, c.Schema, c.Raw, expr)
+	if err != nil {
+		return nil, fmt.Errorf("error matching paths in config: %w", err)
+	}
+	return matches, nil
+}
+
 func (c Config) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
 	rawValue, remaining, err := tftypes.WalkAttributePath(c.Raw, path)
 	if err != nil {
@@ -2,6 +2,7 @@ package tfsdk
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -26,3 +27,17 @@ type DataSource interface {
 	// ReadDataSourceResponse.
 	Read(context.Context, ReadDataSourceRequest, *ReadDataSourceResponse)
 }
+
+// DataSourceWithReadCache is a data source whose results the framework
+// caches, for data sources read many times with the same configuration, like
+// lookups used across a large plan. While a result is cached, reading the
+// data source again with an equal configuration and provider_meta returns it
+// without calling Read. Only results without error diagnostics are cached,
+// and each provider process has its own cache.
+type DataSourceWithReadCache interface {
+	DataSource
+
+	// ReadCacheTTL returns how long results are cached for. Zero or less
+	// turns caching off.
+	ReadCacheTTL(context.Context) time.Duration
+}
@@ -0,0 +1,106 @@
+package tfsdk
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/valuehash"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// dataSourceReadCache holds the results of reading data sources that
+// implement DataSourceWithReadCache. Its zero value is an empty cache.
+type dataSourceReadCache struct {
+	mu      sync.Mutex
+	entries map[dataSourceReadCacheKey][]dataSourceReadCacheEntry
+
+	// now returns the current time. It defaults to time.Now.
+	now func() time.Time
+}
+
+// dataSourceReadCacheKey finds the results that may be for a read. Different
+// configurations can hash the same, so the entries must still be compared.
+type dataSourceReadCacheKey struct {
+	typeName   string
+	configHash uint64
+}
+
+type dataSourceReadCacheEntry struct {
+	config       tftypes.Value
+	providerMeta tftypes.Value
+	state        *tfprotov6.DynamicValue
+	diags        []*tfprotov6.Diagnostic
+	expires      time.Time
+}
+
+// readCacheKey returns the key for reading the data source `typeName` with
+// `config`.
+func readCacheKey(ctx context.Context, typeName string, dataSourceSchema schema.Schema, config tftypes.Value) (dataSourceReadCacheKey, error) {
+	val, err := dataSourceSchema.AttributeType().ValueFromTerraform(ctx, config)
+	if err != nil {
+		return dataSourceReadCacheKey{}, err
+	}
+	hash, err := valuehash.Hash(ctx, val)
+	if err != nil {
+		return dataSourceReadCacheKey{}, err
+	}
+	return dataSourceReadCacheKey{typeName: typeName, configHash: hash}, nil
+}
+
+// get returns the cached state and diagnostics for reading with `config`
+// and `providerMeta`, if they haven't expired.
+func (c *dataSourceReadCache) get(key dataSourceReadCacheKey, config, providerMeta tftypes.Value) (*tfprotov6.DynamicValue, []*tfprotov6.Diagnostic, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.currentTime()
+	for _, entry := range c.entries[key] {
+		if now.Before(entry.expires) && entry.config.Equal(config) && entry.providerMeta.Equal(providerMeta) {
+			return entry.state, entry.diags, true
+		}
+	}
+	return nil, nil, false
+}
+
+// put caches `state` and `diags` as the result of reading with `config` and
+// `providerMeta` for `ttl`, replacing any result for the same read. Expired
+// results for every read are dropped, so the cache doesn't grow with reads
+// that are never repeated.
+func (c *dataSourceReadCache) put(key dataSourceReadCacheKey, config, providerMeta tftypes.Value, state *tfprotov6.DynamicValue, diags []*tfprotov6.Diagnostic, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.currentTime()
+	for k, entries := range c.entries {
+		var kept []dataSourceReadCacheEntry
+		for _, entry := range entries {
+			if !now.Before(entry.expires) || (k == key && entry.config.Equal(config) && entry.providerMeta.Equal(providerMeta)) {
+				continue
+			}
+			kept = append(kept, entry)
+		}
+		if len(kept) == 0 {
+			delete(c.entries, k)
+			continue
+		}
+		c.entries[k] = kept
+	}
+	if c.entries == nil {
+		c.entries = map[dataSourceReadCacheKey][]dataSourceReadCacheEntry{}
+	}
+	c.entries[key] = append(c.entries[key], dataSourceReadCacheEntry{
+		config:       config,
+		providerMeta: providerMeta,
+		state:        state,
+		diags:        diags,
+		expires:      now.Add(ttl),
+	})
+}
+
+func (c *dataSourceReadCache) currentTime() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,218 @@
+package tfsdk
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testReadCacheProvider struct {
+	Manifest
+}
+
+func (p *testReadCacheProvider) GetSchema(_ context.Context) (schema.Schema, []*tfprotov6.Diagnostic) {
+	return schema.Schema{}, nil
+}
+
+func (p *testReadCacheProvider) Configure(_ context.Context, _ ConfigureProviderRequest, _ *ConfigureProviderResponse) {
+}
+
+type testReadCacheDataSourceType struct {
+	dataSource *testReadCacheDataSource
+}
+
+func (t testReadCacheDataSourceType) GetSchema(_ context.Context) (schema.Schema, []*tfprotov6.Diagnostic) {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"reads": {
+				Type:     types.NumberType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (t testReadCacheDataSourceType) NewDataSource(_ context.Context, _ Provider) (DataSource, []*tfprotov6.Diagnostic) {
+	return t.dataSource, nil
+}
+
+type testReadCacheDataSource struct {
+	ttl   time.Duration
+	reads int
+	fail  bool
+}
+
+func (d *testReadCacheDataSource) ReadCacheTTL(_ context.Context) time.Duration {
+	return d.ttl
+}
+
+func (d *testReadCacheDataSource) Read(ctx context.Context, req ReadDataSourceRequest, resp *ReadDataSourceResponse) {
+	d.reads++
+	val, err := req.Config.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  err.Error(),
+		})
+		return
+	}
+	name := val.(types.String)
+	if d.fail {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Read failed",
+		})
+		return
+	}
+	resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "Read " + name.Value,
+	})
+	resp.State.Raw = tftypes.NewValue(req.Config.Raw.Type(), map[string]tftypes.Value{
+		"name":  tftypes.NewValue(tftypes.String, name.Value),
+		"reads": tftypes.NewValue(tftypes.Number, d.reads),
+	})
+}
+
+func TestServerReadDataSource_readCache(t *testing.T) {
+	t.Parallel()
+
+	dataSource := &testReadCacheDataSource{ttl: time.Minute}
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	s := &server{
+		p: &testReadCacheProvider{
+			Manifest: Manifest{
+				DataSources: []ManifestDataSource{
+					{
+						Name: "test_cached",
+						New: func() DataSourceType {
+							return testReadCacheDataSourceType{dataSource: dataSource}
+						},
+					},
+				},
+			},
+		},
+	}
+	s.readCache.now = func() time.Time { return now }
+
+	dataSourceSchema, _ := testReadCacheDataSourceType{}.GetSchema(context.Background())
+	typ := dataSourceSchema.TerraformType(context.Background())
+	read := func(name string, expectedReads int) {
+		t.Helper()
+
+		config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":  tftypes.NewValue(tftypes.String, name),
+			"reads": tftypes.NewValue(tftypes.Number, nil),
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		resp, err := s.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+			TypeName: "test_cached",
+			Config:   &config,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if dataSource.fail {
+			return
+		}
+		expectedDiags := []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityWarning,
+				Summary:  "Read " + name,
+			},
+		}
+		if diff := cmp.Diff(expectedDiags, resp.Diagnostics); diff != "" {
+			t.Fatalf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+		}
+		state, err := resp.State.Unmarshal(typ)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expectedState := tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":  tftypes.NewValue(tftypes.String, name),
+			"reads": tftypes.NewValue(tftypes.Number, expectedReads),
+		})
+		if !state.Equal(expectedState) {
+			t.Fatalf("Expected state %s, got %s", expectedState, state)
+		}
+	}
+
+	read("a", 1)
+	read("a", 1)
+	read("b", 2)
+	read("a", 1)
+	if dataSource.reads != 2 {
+		t.Errorf("Expected 2 reads, got %d", dataSource.reads)
+	}
+
+	// once the results expire, the data source is read again
+	now = now.Add(time.Minute)
+	read("a", 3)
+	read("a", 3)
+
+	// errors aren't cached
+	dataSource.fail = true
+	read("c", 0)
+	read("c", 0)
+	if dataSource.reads != 5 {
+		t.Errorf("Expected 5 reads, got %d", dataSource.reads)
+	}
+
+	// with caching turned off, every read calls Read
+	dataSource.fail = false
+	dataSource.ttl = 0
+	read("a", 6)
+	read("a", 7)
+}
+
+func TestDataSourceReadCachePut_dropsExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	c := &dataSourceReadCache{
+		now: func() time.Time { return now },
+	}
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(tftypes.String, name)
+	}
+	meta := tftypes.NewValue(tftypes.String, nil)
+
+	c.put(dataSourceReadCacheKey{typeName: "test_one", configHash: 1}, value("a"), meta, nil, nil, time.Minute)
+	c.put(dataSourceReadCacheKey{typeName: "test_two", configHash: 2}, value("b"), meta, nil, nil, 2*time.Minute)
+
+	// the first result has expired, and is dropped even though its read
+	// is never repeated
+	now = now.Add(time.Minute)
+	c.put(dataSourceReadCacheKey{typeName: "test_three", configHash: 3}, value("c"), meta, nil, nil, time.Minute)
+
+	expected := []dataSourceReadCacheKey{
+		{typeName: "test_three", configHash: 3},
+		{typeName: "test_two", configHash: 2},
+	}
+	got := make([]dataSourceReadCacheKey, 0, len(c.entries))
+	for key := range c.entries {
+		got = append(got, key)
+	}
+	sort.Slice(got, func(i, j int) bool {
+		return got[i].typeName < got[j].typeName
+	})
+	if diff := cmp.Diff(expected, got, cmp.AllowUnexported(dataSourceReadCacheKey{})); diff != "" {
+		t.Errorf("Unexpected diff in cached reads (+wanted, -got): %s", diff)
+	}
+	if _, _, ok := c.get(dataSourceReadCacheKey{typeName: "test_two", configHash: 2}, value("b"), meta); !ok {
+		t.Errorf("Expected the unexpired result to still be cached")
+	}
+}
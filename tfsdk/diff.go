@@ -0,0 +1,266 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DiffAction describes how the value at a path differs between two values.
+type DiffAction string
+
+const (
+	// DiffActionAdded indicates that the path is null or not present in
+	// the first value, but is set in the second value.
+	DiffActionAdded DiffAction = "added"
+
+	// DiffActionRemoved indicates that the path is set in the first value,
+	// but is null or not present in the second value.
+	DiffActionRemoved DiffAction = "removed"
+
+	// DiffActionChanged indicates that the path is set in both values, but
+	// to different values.
+	DiffActionChanged DiffAction = "changed"
+)
+
+// ValueDiff describes a difference between two values at a single path.
+type ValueDiff struct {
+	// Path is the location of the difference within the values.
+	Path *tftypes.AttributePath
+
+	// Action describes how the value at Path differs.
+	Action DiffAction
+
+	// Before is the value at Path in the first value. It will be nil if
+	// Path is not present in the first value.
+	Before attr.Value
+
+	// After is the value at Path in the second value. It will be nil if
+	// Path is not present in the second value.
+	After attr.Value
+}
+
+// String returns a human-readable description of the difference, suitable
+// for logs and test output.
+func (d ValueDiff) String() string {
+	return fmt.Sprintf("%s: %s: %s => %s", d.Path, d.Action, diffValueString(d.Before), diffValueString(d.After))
+}
+
+// diffValueString returns a human-readable representation of `v`.
+func diffValueString(v attr.Value) string {
+	if v == nil {
+		return "{no value set}"
+	}
+	raw, err := v.ToTerraformValue(context.Background())
+	if err != nil {
+		return fmt.Sprintf("{error: %s}", err)
+	}
+	if raw == tftypes.UnknownValue {
+		return "{unknown}"
+	}
+	switch r := raw.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(r)
+	case *big.Float:
+		return r.Text('g', -1)
+	default:
+		return fmt.Sprintf("%v", r)
+	}
+}
+
+// DiffStates returns the differences between `before` and `after`, using the
+// schema of `before` to interpret them. See DiffValues for details.
+func DiffStates(ctx context.Context, before, after State) ([]ValueDiff, error) {
+	typ := before.Schema.AttributeType()
+	if !after.Raw.Type().Is(typ.TerraformType(ctx)) {
+		return nil, fmt.Errorf("can't diff states with different types: %s and %s", before.Raw.Type(), after.Raw.Type())
+	}
+	return diffTerraformValues(ctx, typ, before.Raw, after.Raw, tftypes.NewAttributePath())
+}
+
+// DiffValues returns the differences between `before` and `after`, which must
+// both be values produced by `typ`. Objects, lists, and maps are compared
+// element by element, and a ValueDiff is returned for each of the innermost
+// paths that differ, sorted by path with list elements in index order.
+// Unknown values are always reported as changed, unless both values are
+// unknown. An empty slice means the values are equal.
+func DiffValues(ctx context.Context, typ attr.Type, before, after attr.Value) ([]ValueDiff, error) {
+	tfType := typ.TerraformType(ctx)
+	beforeVal, err := diffTerraformValue(ctx, tfType, before)
+	if err != nil {
+		return nil, fmt.Errorf("error converting before value: %w", err)
+	}
+	afterVal, err := diffTerraformValue(ctx, tfType, after)
+	if err != nil {
+		return nil, fmt.Errorf("error converting after value: %w", err)
+	}
+	return diffTerraformValues(ctx, typ, beforeVal, afterVal, tftypes.NewAttributePath())
+}
+
+func diffTerraformValue(ctx context.Context, typ tftypes.Type, val attr.Value) (tftypes.Value, error) {
+	if val == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	raw, err := val.ToTerraformValue(ctx)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	return reflect.NewTerraformValue(typ, raw)
+}
+
+func diffTerraformValues(ctx context.Context, typ attr.Type, before, after tftypes.Value, path *tftypes.AttributePath) ([]ValueDiff, error) {
+	diffs, err := appendDiffs(ctx, nil, typ, &before, &after, path)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return order.PathLess(diffs[i].Path, diffs[j].Path)
+	})
+	return diffs, nil
+}
+
+// appendDiffs appends the differences between `before` and `after` to `diffs`.
+// A nil `before` or `after` means the path isn't present in that value.
+func appendDiffs(ctx context.Context, diffs []ValueDiff, typ attr.Type, before, after *tftypes.Value, path *tftypes.AttributePath) ([]ValueDiff, error) {
+	if before != nil && after != nil && before.Equal(*after) {
+		return diffs, nil
+	}
+
+	beforeSet := before != nil && !before.IsNull()
+	afterSet := after != nil && !after.IsNull()
+	if beforeSet && afterSet && before.IsKnown() && after.IsKnown() {
+		switch t := typ.(type) {
+		case attr.TypeWithAttributeTypes:
+			return appendObjectDiffs(ctx, diffs, t, *before, *after, path)
+		case attr.TypeWithElementType:
+			if after.Type().Is(tftypes.List{}) {
+				return appendListDiffs(ctx, diffs, t, *before, *after, path)
+			}
+			if after.Type().Is(tftypes.Map{}) {
+				return appendMapDiffs(ctx, diffs, t, *before, *after, path)
+			}
+		}
+	}
+
+	diff := ValueDiff{
+		Path: path,
+	}
+	switch {
+	case !beforeSet && !afterSet:
+		// one side is null and the other isn't present, there's no
+		// meaningful difference
+		return diffs, nil
+	case !beforeSet:
+		diff.Action = DiffActionAdded
+	case !afterSet:
+		diff.Action = DiffActionRemoved
+	default:
+		diff.Action = DiffActionChanged
+	}
+	var err error
+	if before != nil {
+		diff.Before, err = typ.ValueFromTerraform(ctx, *before)
+		if err != nil {
+			return nil, path.NewError(err)
+		}
+	}
+	if after != nil {
+		diff.After, err = typ.ValueFromTerraform(ctx, *after)
+		if err != nil {
+			return nil, path.NewError(err)
+		}
+	}
+	return append(diffs, diff), nil
+}
+
+func appendObjectDiffs(ctx context.Context, diffs []ValueDiff, typ attr.TypeWithAttributeTypes, before, after tftypes.Value, path *tftypes.AttributePath) ([]ValueDiff, error) {
+	var beforeAttrs, afterAttrs map[string]tftypes.Value
+	if err := before.As(&beforeAttrs); err != nil {
+		return nil, path.NewError(err)
+	}
+	if err := after.As(&afterAttrs); err != nil {
+		return nil, path.NewError(err)
+	}
+	var err error
+	for _, name := range order.Keys(typ.AttributeTypes()) {
+		attrType := typ.AttributeTypes()[name]
+		diffs, err = appendDiffs(ctx, diffs, attrType, optionalValue(beforeAttrs, name), optionalValue(afterAttrs, name), path.WithAttributeName(name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return diffs, nil
+}
+
+func appendListDiffs(ctx context.Context, diffs []ValueDiff, typ attr.TypeWithElementType, before, after tftypes.Value, path *tftypes.AttributePath) ([]ValueDiff, error) {
+	var beforeElems, afterElems []tftypes.Value
+	if err := before.As(&beforeElems); err != nil {
+		return nil, path.NewError(err)
+	}
+	if err := after.As(&afterElems); err != nil {
+		return nil, path.NewError(err)
+	}
+	length := len(beforeElems)
+	if len(afterElems) > length {
+		length = len(afterElems)
+	}
+	var err error
+	for pos := 0; pos < length; pos++ {
+		var beforeElem, afterElem *tftypes.Value
+		if pos < len(beforeElems) {
+			beforeElem = &beforeElems[pos]
+		}
+		if pos < len(afterElems) {
+			afterElem = &afterElems[pos]
+		}
+		diffs, err = appendDiffs(ctx, diffs, typ.ElementType(), beforeElem, afterElem, path.WithElementKeyInt(int64(pos)))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return diffs, nil
+}
+
+func appendMapDiffs(ctx context.Context, diffs []ValueDiff, typ attr.TypeWithElementType, before, after tftypes.Value, path *tftypes.AttributePath) ([]ValueDiff, error) {
+	var beforeElems, afterElems map[string]tftypes.Value
+	if err := before.As(&beforeElems); err != nil {
+		return nil, path.NewError(err)
+	}
+	if err := after.As(&afterElems); err != nil {
+		return nil, path.NewError(err)
+	}
+	keys := map[string]struct{}{}
+	for key := range beforeElems {
+		keys[key] = struct{}{}
+	}
+	for key := range afterElems {
+		keys[key] = struct{}{}
+	}
+	var err error
+	for _, key := range order.Keys(keys) {
+		diffs, err = appendDiffs(ctx, diffs, typ.ElementType(), optionalValue(beforeElems, key), optionalValue(afterElems, key), path.WithElementKeyString(key))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return diffs, nil
+}
+
+// optionalValue returns a pointer to the value at `key` in `m`, or nil if
+// `key` isn't in `m`.
+func optionalValue(m map[string]tftypes.Value, key string) *tftypes.Value {
+	val, ok := m[key]
+	if !ok {
+		return nil
+	}
+	return &val
+}
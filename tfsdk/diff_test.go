@@ -0,0 +1,295 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiffValues(t *testing.T) {
+	t.Parallel()
+
+	typ := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":   types.StringType,
+			"size":   types.NumberType,
+			"tags":   types.ListType{ElemType: types.StringType},
+			"labels": types.MapType{ElemType: types.StringType},
+		},
+	}
+
+	longList := func(elems ...string) types.List {
+		list := types.List{ElemType: types.StringType}
+		for _, elem := range elems {
+			list.Elems = append(list.Elems, types.String{Value: elem})
+		}
+		return list
+	}
+
+	type testCase struct {
+		before   attr.Value
+		after    attr.Value
+		expected []ValueDiff
+	}
+	tests := map[string]testCase{
+		"equal": {
+			before: types.Object{
+				AttrTypes: typ.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   types.String{Value: "hello"},
+					"size":   types.Number{Null: true},
+					"tags":   types.List{ElemType: types.StringType, Null: true},
+					"labels": types.Map{ElemType: types.StringType, Null: true},
+				},
+			},
+			after: types.Object{
+				AttrTypes: typ.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   types.String{Value: "hello"},
+					"size":   types.Number{Null: true},
+					"tags":   types.List{ElemType: types.StringType, Null: true},
+					"labels": types.Map{ElemType: types.StringType, Null: true},
+				},
+			},
+		},
+		"nested": {
+			before: types.Object{
+				AttrTypes: typ.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"name": types.String{Value: "hello"},
+					"size": types.Number{Value: big.NewFloat(1)},
+					"tags": types.List{ElemType: types.StringType, Elems: []attr.Value{
+						types.String{Value: "a"},
+						types.String{Value: "b"},
+					}},
+					"labels": types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+						"env":  types.String{Value: "prod"},
+						"team": types.String{Value: "infra"},
+					}},
+				},
+			},
+			after: types.Object{
+				AttrTypes: typ.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"name": types.String{Value: "goodbye"},
+					"size": types.Number{Null: true},
+					"tags": types.List{ElemType: types.StringType, Elems: []attr.Value{
+						types.String{Value: "a"},
+						types.String{Value: "b"},
+						types.String{Value: "c"},
+					}},
+					"labels": types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{
+						"env":   types.String{Value: "dev"},
+						"owner": types.String{Unknown: true},
+					}},
+				},
+			},
+			expected: []ValueDiff{
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("labels").WithElementKeyString("env"),
+					Action: DiffActionChanged,
+					Before: types.String{Value: "prod"},
+					After:  types.String{Value: "dev"},
+				},
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("labels").WithElementKeyString("owner"),
+					Action: DiffActionAdded,
+					After:  types.String{Unknown: true},
+				},
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("labels").WithElementKeyString("team"),
+					Action: DiffActionRemoved,
+					Before: types.String{Value: "infra"},
+				},
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("name"),
+					Action: DiffActionChanged,
+					Before: types.String{Value: "hello"},
+					After:  types.String{Value: "goodbye"},
+				},
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("size"),
+					Action: DiffActionRemoved,
+					Before: types.Number{Value: big.NewFloat(1)},
+					After:  types.Number{Null: true},
+				},
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(2),
+					Action: DiffActionAdded,
+					After:  types.String{Value: "c"},
+				},
+			},
+		},
+		"long-list": {
+			before: types.Object{
+				AttrTypes: typ.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   types.String{Value: "hello"},
+					"size":   types.Number{Null: true},
+					"tags":   longList("a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"),
+					"labels": types.Map{ElemType: types.StringType, Null: true},
+				},
+			},
+			after: types.Object{
+				AttrTypes: typ.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   types.String{Value: "hello"},
+					"size":   types.Number{Null: true},
+					"tags":   longList("a", "b", "x", "d", "e", "f", "g", "h", "i", "j", "y"),
+					"labels": types.Map{ElemType: types.StringType, Null: true},
+				},
+			},
+			expected: []ValueDiff{
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(2),
+					Action: DiffActionChanged,
+					Before: types.String{Value: "c"},
+					After:  types.String{Value: "x"},
+				},
+				{
+					Path:   tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(10),
+					Action: DiffActionChanged,
+					Before: types.String{Value: "k"},
+					After:  types.String{Value: "y"},
+				},
+			},
+		},
+		"null-to-value": {
+			before: types.Object{AttrTypes: typ.AttrTypes, Null: true},
+			after: types.Object{
+				AttrTypes: typ.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   types.String{Value: "hello"},
+					"size":   types.Number{Null: true},
+					"tags":   types.List{ElemType: types.StringType, Null: true},
+					"labels": types.Map{ElemType: types.StringType, Null: true},
+				},
+			},
+			expected: []ValueDiff{
+				{
+					Path:   tftypes.NewAttributePath(),
+					Action: DiffActionAdded,
+					Before: types.Object{AttrTypes: typ.AttrTypes, Null: true},
+					After: types.Object{
+						AttrTypes: typ.AttrTypes,
+						Attrs: map[string]attr.Value{
+							"name":   types.String{Value: "hello"},
+							"size":   types.Number{Null: true},
+							"tags":   types.List{ElemType: types.StringType, Null: true},
+							"labels": types.Map{ElemType: types.StringType, Null: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DiffValues(context.Background(), typ, test.before, test.after)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffValues_dynamic(t *testing.T) {
+	t.Parallel()
+
+	before := types.NewDynamic(tftypes.NewValue(tftypes.String, "hello"))
+	after := types.NewDynamic(tftypes.NewValue(tftypes.Number, 123))
+	got, err := DiffValues(context.Background(), types.DynamicType, before, after)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []ValueDiff{
+		{
+			Path:   tftypes.NewAttributePath(),
+			Action: DiffActionChanged,
+			Before: before,
+			After:  after,
+		},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestDiffStates(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"disks": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"size": {
+						Type:     types.NumberType,
+						Required: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+	tfType := s.TerraformType(context.Background())
+	diskType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"size": tftypes.Number}}
+
+	before := State{
+		Schema: s,
+		Raw: tftypes.NewValue(tfType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "abc"),
+			"disks": tftypes.NewValue(tftypes.List{ElementType: diskType}, []tftypes.Value{
+				tftypes.NewValue(diskType, map[string]tftypes.Value{
+					"size": tftypes.NewValue(tftypes.Number, 10),
+				}),
+			}),
+		}),
+	}
+	after := State{
+		Schema: s,
+		Raw: tftypes.NewValue(tfType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "abc"),
+			"disks": tftypes.NewValue(tftypes.List{ElementType: diskType}, []tftypes.Value{
+				tftypes.NewValue(diskType, map[string]tftypes.Value{
+					"size": tftypes.NewValue(tftypes.Number, 20),
+				}),
+			}),
+		}),
+	}
+
+	got, err := DiffStates(context.Background(), before, after)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []ValueDiff{
+		{
+			Path:   tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("size"),
+			Action: DiffActionChanged,
+			Before: types.Number{Value: big.NewFloat(10)},
+			After:  types.Number{Value: big.NewFloat(20)},
+		},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+	if got, expected := got[0].String(), `AttributeName("disks").ElementKeyInt(0).AttributeName("size"): changed: 10 => 20`; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
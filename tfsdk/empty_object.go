@@ -0,0 +1,68 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// normalizeEmptyObjects returns `val` with the values of every attribute that
+// has EmptyObject set represented the way it asks for. When `planning` is
+// true, `val` is a plan, and only null objects of computed attributes using
+// schema.EmptyObjectOfNulls are changed, as Terraform requires the rest of the
+// plan to match the configuration.
+func normalizeEmptyObjects(ctx context.Context, resourceSchema schema.Schema, val tftypes.Value, planning bool) (tftypes.Value, error) {
+	if val.Type() == nil || !hasEmptyObject(resourceSchema.Attributes) {
+		return val, nil
+	}
+	return transformAttributeValues(resourceSchema, val, func(_ *tftypes.AttributePath, attribute schema.Attribute, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() || !v.Type().Is(tftypes.Object{}) {
+			return v, nil
+		}
+		if attribute.Attributes == nil || attribute.Attributes.GetNestingMode() != schema.NestingModeSingle {
+			return v, nil
+		}
+		switch attribute.EmptyObject {
+		case schema.EmptyObjectNull:
+			if planning || v.IsNull() {
+				return v, nil
+			}
+			var attrs map[string]tftypes.Value
+			if err := v.As(&attrs); err != nil {
+				return v, err
+			}
+			for _, attrVal := range attrs {
+				if !attrVal.IsNull() {
+					return v, nil
+				}
+			}
+			return tftypes.NewValue(v.Type(), nil), nil
+		case schema.EmptyObjectOfNulls:
+			if !v.IsNull() || !attribute.Computed {
+				return v, nil
+			}
+			attrTypes := v.Type().(tftypes.Object).AttributeTypes
+			attrs := make(map[string]tftypes.Value, len(attrTypes))
+			for name, attrType := range attrTypes {
+				attrs[name] = tftypes.NewValue(attrType, nil)
+			}
+			return tftypes.NewValue(v.Type(), attrs), nil
+		}
+		return v, nil
+	})
+}
+
+// hasEmptyObject returns true if any of `attrs`, or their nested attributes,
+// have EmptyObject set.
+func hasEmptyObject(attrs map[string]schema.Attribute) bool {
+	for _, attribute := range attrs {
+		if attribute.EmptyObject != schema.EmptyObjectUnchanged {
+			return true
+		}
+		if attribute.Attributes != nil && hasEmptyObject(attribute.Attributes.GetAttributes()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizeEmptyObjects(t *testing.T) {
+	t.Parallel()
+
+	nested := map[string]schema.Attribute{
+		"enabled": {
+			Type:     types.BoolType,
+			Optional: true,
+		},
+	}
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"logging": {
+				Attributes:  schema.SingleNestedAttributes(nested),
+				Optional:    true,
+				EmptyObject: schema.EmptyObjectNull,
+			},
+			"status": {
+				Attributes:  schema.SingleNestedAttributes(nested),
+				Computed:    true,
+				EmptyObject: schema.EmptyObjectOfNulls,
+			},
+			"options": {
+				Attributes:  schema.SingleNestedAttributes(nested),
+				Optional:    true,
+				EmptyObject: schema.EmptyObjectOfNulls,
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	nestedType := typ.(tftypes.Object).AttributeTypes["logging"]
+	empty := tftypes.NewValue(nestedType, map[string]tftypes.Value{
+		"enabled": tftypes.NewValue(tftypes.Bool, nil),
+	})
+	null := tftypes.NewValue(nestedType, nil)
+	enabled := tftypes.NewValue(nestedType, map[string]tftypes.Value{
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+	})
+	value := func(logging, status, options tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"logging": logging,
+			"status":  status,
+			"options": options,
+		})
+	}
+
+	type testCase struct {
+		val      tftypes.Value
+		planning bool
+		expected tftypes.Value
+	}
+
+	tests := map[string]testCase{
+		"state-empty": {
+			val:      value(empty, null, null),
+			expected: value(null, empty, null),
+		},
+		"state-set": {
+			val:      value(enabled, enabled, enabled),
+			expected: value(enabled, enabled, enabled),
+		},
+		"plan": {
+			val:      value(empty, null, null),
+			planning: true,
+			expected: value(empty, empty, null),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := normalizeEmptyObjects(context.Background(), resourceSchema, tc.val, tc.planning)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
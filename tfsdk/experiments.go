@@ -0,0 +1,49 @@
+package tfsdk
+
+import (
+	"context"
+)
+
+// Experiment is the name of an experimental framework behavior, which
+// providers can turn on with ServeOpts.Experiments. Experiments may change or
+// be removed in any release, and are promoted to regular, supported behavior
+// once they've settled.
+//
+// Behaviors providers already opt into where they use them don't need an
+// experiment. Lazy values, for example, are only used by fields declared as
+// types.Lazy with the "lazy" struct tag.
+type Experiment string
+
+const (
+	// ExperimentStrictUnknownHandling makes UnknownHandlingError the
+	// unknown handling policy of every resource type that doesn't choose
+	// its own by implementing ResourceTypeWithUnknownHandling.
+	ExperimentStrictUnknownHandling Experiment = "strict_unknown_handling"
+)
+
+type experimentsKey struct{}
+
+// ExperimentEnabled returns true if the provider serving the RPC `ctx`
+// belongs to turned on `experiment`. Providers can use it to check which
+// experimental behaviors their code runs with.
+func ExperimentEnabled(ctx context.Context, experiment Experiment) bool {
+	experiments, _ := ctx.Value(experimentsKey{}).(map[Experiment]bool)
+	return experiments[experiment]
+}
+
+// withExperiments returns a copy of `ctx` with `experiments` turned on.
+func withExperiments(ctx context.Context, experiments map[Experiment]bool) context.Context {
+	if len(experiments) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, experimentsKey{}, experiments)
+}
+
+// experimentSet returns `experiments` as a set.
+func experimentSet(experiments []Experiment) map[Experiment]bool {
+	set := make(map[Experiment]bool, len(experiments))
+	for _, experiment := range experiments {
+		set[experiment] = true
+	}
+	return set
+}
@@ -0,0 +1,24 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExperimentEnabled(t *testing.T) {
+	t.Parallel()
+
+	s := &server{
+		experiments: experimentSet([]Experiment{ExperimentStrictUnknownHandling}),
+	}
+	ctx := s.registerContext(context.Background())
+	if !ExperimentEnabled(ctx, ExperimentStrictUnknownHandling) {
+		t.Errorf("Expected %q to be enabled", ExperimentStrictUnknownHandling)
+	}
+	if ExperimentEnabled(ctx, Experiment("unknown")) {
+		t.Error("Expected an experiment that wasn't turned on to be disabled")
+	}
+	if ExperimentEnabled(context.Background(), ExperimentStrictUnknownHandling) {
+		t.Error("Expected experiments to be disabled outside of RPCs")
+	}
+}
@@ -0,0 +1,121 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FieldConverter converts the value of an API struct field into a value
+// that can be set on an attribute. It can return an attr.Value, or any Go
+// value that State.Set would accept for the attribute.
+type FieldConverter func(ctx context.Context, val interface{}) (interface{}, error)
+
+// FieldMapping maps a field of an API struct to an attribute.
+type FieldMapping struct {
+	// APIField is the name of the field in the API struct. Fields of
+	// nested structs can be reached by separating field names with dots,
+	// like "Properties.Name". Pointers are followed; if a nil pointer is
+	// found before reaching the field, the attribute is set to null.
+	APIField string
+
+	// Attribute is the name of the top-level attribute to set.
+	Attribute string
+
+	// Converter, if set, is used to convert the API field's value before
+	// it is set on the attribute. Without a Converter, the field's value
+	// is used as-is.
+	Converter FieldConverter
+}
+
+// FieldMap is a list of mappings from API struct fields to attributes.
+type FieldMap []FieldMapping
+
+// SetFromAPI sets the attributes in `fields` to the values of the mapped
+// fields of `apiObj`, which must be a struct or a pointer to a struct. It is
+// meant to replace hand-written functions that copy API responses into state
+// field by field.
+//
+// The attributes are set using State.SetAttributes, so either all of them
+// are set or, if an error is returned, none of them are. Attributes not in
+// `fields` keep their current values. The state must not be null; in Create
+// and Update, the response's State starts out as the plan, and in Read, as
+// the current state.
+func (s *State) SetFromAPI(ctx context.Context, apiObj interface{}, fields FieldMap) error {
+	obj := reflect.ValueOf(apiObj)
+	for obj.Kind() == reflect.Ptr && !obj.IsNil() {
+		obj = obj.Elem()
+	}
+	if obj.Kind() != reflect.Struct {
+		return fmt.Errorf("can't set state from %T, must be a struct or a pointer to a struct", apiObj)
+	}
+
+	values := make(map[*tftypes.AttributePath]attr.Value, len(fields))
+	for _, field := range fields {
+		path := tftypes.NewAttributePath().WithAttributeName(field.Attribute)
+		attrType, err := s.Schema.AttributeTypeAtPath(path)
+		if err != nil {
+			return fmt.Errorf("error getting attribute type at path %s in schema: %w", path, err)
+		}
+
+		fieldVal, found, err := apiFieldValue(obj, field.APIField)
+		if err != nil {
+			return path.NewError(err)
+		}
+		if !found {
+			val, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+			if err != nil {
+				return path.NewErrorf("error creating null value for API field %s: %w", field.APIField, err)
+			}
+			values[path] = val
+			continue
+		}
+
+		var goVal interface{} = fieldVal.Interface()
+		if field.Converter != nil {
+			goVal, err = field.Converter(ctx, goVal)
+			if err != nil {
+				return path.NewErrorf("error converting API field %s: %w", field.APIField, err)
+			}
+		}
+
+		val, err := refl.OutOf(ctx, attrType, goVal)
+		if err != nil {
+			return path.NewErrorf("error creating value from API field %s: %w", field.APIField, err)
+		}
+		values[path] = val
+	}
+	return s.SetAttributes(ctx, values)
+}
+
+// apiFieldValue returns the value of the field named by the dot-separated
+// `name` in `obj`. It returns false, without an error, if a nil pointer is
+// found before the field is reached.
+func apiFieldValue(obj reflect.Value, name string) (reflect.Value, bool, error) {
+	val := obj
+	for _, part := range strings.Split(name, ".") {
+		for val.Kind() == reflect.Ptr {
+			if val.IsNil() {
+				return reflect.Value{}, false, nil
+			}
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct {
+			return reflect.Value{}, false, fmt.Errorf("can't get field %s of API field %s, %s is not a struct", part, name, val.Type())
+		}
+		structField, ok := val.Type().FieldByName(part)
+		if !ok {
+			return reflect.Value{}, false, fmt.Errorf("API struct %s has no field %s", val.Type(), part)
+		}
+		if structField.PkgPath != "" {
+			return reflect.Value{}, false, fmt.Errorf("can't use unexported field %s of API struct %s", part, val.Type())
+		}
+		val = val.FieldByIndex(structField.Index)
+	}
+	return val, true, nil
+}
@@ -0,0 +1,190 @@
+package tfsdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testAPIProperties struct {
+	Region string
+	Labels []string
+}
+
+type testAPIResponse struct {
+	ID          string
+	DisplayName *string
+	SizeGB      int
+	Enabled     bool
+	Properties  *testAPIProperties
+	internal    string //nolint:structcheck,unused
+}
+
+func TestStateSetFromAPI(t *testing.T) {
+	t.Parallel()
+
+	fieldMapSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":      {Type: types.StringType, Computed: true},
+			"name":    {Type: types.StringType, Optional: true},
+			"size":    {Type: types.NumberType, Required: true},
+			"enabled": {Type: types.BoolType, Computed: true},
+			"region":  {Type: types.StringType, Computed: true},
+			"labels":  {Type: types.ListType{ElemType: types.StringType}, Computed: true},
+		},
+	}
+	fieldMapType := fieldMapSchema.TerraformType(context.Background())
+	priorState := tftypes.NewValue(fieldMapType, map[string]tftypes.Value{
+		"id":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":    tftypes.NewValue(tftypes.String, "kept"),
+		"size":    tftypes.NewValue(tftypes.Number, 10),
+		"enabled": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+		"region":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"labels":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+	})
+	name := "example"
+
+	type testCase struct {
+		apiObj        interface{}
+		fields        FieldMap
+		expected      tftypes.Value
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"all-fields": {
+			apiObj: &testAPIResponse{
+				ID:          "abc123",
+				DisplayName: &name,
+				SizeGB:      20,
+				Enabled:     true,
+				Properties: &testAPIProperties{
+					Region: "us-east-1",
+					Labels: []string{"a", "b"},
+				},
+			},
+			fields: FieldMap{
+				{APIField: "ID", Attribute: "id"},
+				{APIField: "DisplayName", Attribute: "name"},
+				{APIField: "SizeGB", Attribute: "size"},
+				{APIField: "Enabled", Attribute: "enabled"},
+				{APIField: "Properties.Region", Attribute: "region"},
+				{APIField: "Properties.Labels", Attribute: "labels"},
+			},
+			expected: tftypes.NewValue(fieldMapType, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, "abc123"),
+				"name":    tftypes.NewValue(tftypes.String, "example"),
+				"size":    tftypes.NewValue(tftypes.Number, 20),
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"region":  tftypes.NewValue(tftypes.String, "us-east-1"),
+				"labels": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+			}),
+		},
+		"nil-pointers-and-unmapped": {
+			apiObj: testAPIResponse{
+				ID:      "abc123",
+				Enabled: true,
+			},
+			fields: FieldMap{
+				{APIField: "ID", Attribute: "id"},
+				{APIField: "Enabled", Attribute: "enabled"},
+				{APIField: "Properties.Region", Attribute: "region"},
+				{APIField: "Properties.Labels", Attribute: "labels"},
+			},
+			expected: tftypes.NewValue(fieldMapType, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, "abc123"),
+				"name":    tftypes.NewValue(tftypes.String, "kept"),
+				"size":    tftypes.NewValue(tftypes.Number, 10),
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"region":  tftypes.NewValue(tftypes.String, nil),
+				"labels":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
+		"converter": {
+			apiObj: testAPIResponse{
+				ID: "ABC123",
+			},
+			fields: FieldMap{
+				{
+					APIField:  "ID",
+					Attribute: "id",
+					Converter: func(_ context.Context, val interface{}) (interface{}, error) {
+						return types.String{Value: strings.ToLower(val.(string))}, nil
+					},
+				},
+			},
+			expected: tftypes.NewValue(fieldMapType, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, "abc123"),
+				"name":    tftypes.NewValue(tftypes.String, "kept"),
+				"size":    tftypes.NewValue(tftypes.Number, 10),
+				"enabled": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+				"region":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"labels":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			}),
+		},
+		"not-a-struct": {
+			apiObj:        "abc123",
+			fields:        FieldMap{{APIField: "ID", Attribute: "id"}},
+			expectedError: "can't set state from string, must be a struct or a pointer to a struct",
+		},
+		"missing-api-field": {
+			apiObj:        testAPIResponse{},
+			fields:        FieldMap{{APIField: "Identifier", Attribute: "id"}},
+			expectedError: `AttributeName("id"): API struct tfsdk.testAPIResponse has no field Identifier`,
+		},
+		"unexported-api-field": {
+			apiObj:        testAPIResponse{},
+			fields:        FieldMap{{APIField: "internal", Attribute: "id"}},
+			expectedError: `AttributeName("id"): can't use unexported field internal of API struct tfsdk.testAPIResponse`,
+		},
+		"missing-attribute": {
+			apiObj:        testAPIResponse{},
+			fields:        FieldMap{{APIField: "ID", Attribute: "identifier"}},
+			expectedError: `error getting attribute type at path AttributeName("identifier") in schema: AttributeName("identifier") still remains in the path: could not find attribute "identifier" in schema`,
+		},
+		"wrong-type": {
+			apiObj:        testAPIResponse{SizeGB: 20},
+			fields:        FieldMap{{APIField: "SizeGB", Attribute: "id"}},
+			expectedError: `AttributeName("id"): error creating value from API field SizeGB: `,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := State{
+				Raw:    priorState,
+				Schema: fieldMapSchema,
+			}
+			err := state.SetFromAPI(context.Background(), tc.apiObj, tc.fields)
+			if tc.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error %q, got none", tc.expectedError)
+				}
+				if !strings.HasPrefix(err.Error(), tc.expectedError) {
+					t.Fatalf("Expected error to start with %q, got %q", tc.expectedError, err.Error())
+				}
+				if !state.Raw.Equal(priorState) {
+					t.Errorf("Expected state to be unchanged after an error, got %s", state.Raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(state.Raw, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
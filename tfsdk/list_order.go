@@ -0,0 +1,87 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// preserveListOrder returns `val`, with the lists of every attribute that has
+// IgnoreListOrder set replaced by the list at the same path in `prior`, as
+// long as the two lists contain the same elements in a different order.
+func preserveListOrder(ctx context.Context, resourceSchema schema.Schema, prior, val tftypes.Value) (tftypes.Value, error) {
+	if val.Type() == nil || prior.Type() == nil || !hasIgnoreListOrder(resourceSchema.Attributes) {
+		return val, nil
+	}
+	return transformAttributeValues(resourceSchema, val, func(path *tftypes.AttributePath, attribute schema.Attribute, v tftypes.Value) (tftypes.Value, error) {
+		if !attribute.IgnoreListOrder || !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.List{}) {
+			return v, nil
+		}
+		rawPrior, _, err := tftypes.WalkAttributePath(prior, path)
+		if errors.Is(err, tftypes.ErrInvalidStep) {
+			// the attribute isn't in the prior value
+			return v, nil
+		}
+		if err != nil {
+			return v, err
+		}
+		priorList, ok := rawPrior.(tftypes.Value)
+		if !ok || !priorList.IsKnown() || priorList.IsNull() || !priorList.Type().Is(v.Type()) {
+			return v, nil
+		}
+		same, err := sameListElements(priorList, v)
+		if err != nil {
+			return v, path.NewError(err)
+		}
+		if same {
+			return priorList, nil
+		}
+		return v, nil
+	})
+}
+
+// sameListElements returns true if the lists `a` and `b` contain the same
+// elements, the same number of times, in any order.
+func sameListElements(a, b tftypes.Value) (bool, error) {
+	var aElems, bElems []tftypes.Value
+	if err := a.As(&aElems); err != nil {
+		return false, err
+	}
+	if err := b.As(&bElems); err != nil {
+		return false, err
+	}
+	if len(aElems) != len(bElems) {
+		return false, nil
+	}
+	matched := make([]bool, len(bElems))
+	for _, aElem := range aElems {
+		found := false
+		for pos, bElem := range bElems {
+			if !matched[pos] && aElem.Equal(bElem) {
+				matched[pos] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// hasIgnoreListOrder returns true if any of `attrs`, or their nested
+// attributes, have IgnoreListOrder set.
+func hasIgnoreListOrder(attrs map[string]schema.Attribute) bool {
+	for _, attribute := range attrs {
+		if attribute.IgnoreListOrder {
+			return true
+		}
+		if attribute.Attributes != nil && hasIgnoreListOrder(attribute.Attributes.GetAttributes()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPreserveListOrder(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"unordered": {
+				Type:            types.ListType{ElemType: types.StringType},
+				Computed:        true,
+				IgnoreListOrder: true,
+			},
+			"ordered": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Computed: true,
+			},
+			"rules": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"port": {
+						Type:     types.NumberType,
+						Required: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional:        true,
+				IgnoreListOrder: true,
+			},
+		},
+	}
+	listType := tftypes.List{ElementType: tftypes.String}
+	ruleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"port": tftypes.Number}}
+	rulesType := tftypes.List{ElementType: ruleType}
+	objType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"unordered": listType,
+			"ordered":   listType,
+			"rules":     rulesType,
+		},
+	}
+	strs := func(vals ...string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(vals))
+		for _, v := range vals {
+			elems = append(elems, tftypes.NewValue(tftypes.String, v))
+		}
+		return tftypes.NewValue(listType, elems)
+	}
+	rules := func(ports ...int64) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(ports))
+		for _, p := range ports {
+			elems = append(elems, tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				"port": tftypes.NewValue(tftypes.Number, p),
+			}))
+		}
+		return tftypes.NewValue(rulesType, elems)
+	}
+	obj := func(unordered, ordered, rules tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"unordered": unordered,
+			"ordered":   ordered,
+			"rules":     rules,
+		})
+	}
+
+	type testCase struct {
+		prior    tftypes.Value
+		val      tftypes.Value
+		expected tftypes.Value
+	}
+
+	tests := map[string]testCase{
+		"reordered": {
+			prior:    obj(strs("a", "b", "c"), strs("a", "b"), rules(80, 443)),
+			val:      obj(strs("c", "a", "b"), strs("b", "a"), rules(443, 80)),
+			expected: obj(strs("a", "b", "c"), strs("b", "a"), rules(80, 443)),
+		},
+		"duplicates": {
+			prior:    obj(strs("a", "a", "b"), strs(), rules()),
+			val:      obj(strs("a", "b", "b"), strs(), rules()),
+			expected: obj(strs("a", "b", "b"), strs(), rules()),
+		},
+		"changed": {
+			prior:    obj(strs("a", "b"), strs(), rules(80)),
+			val:      obj(strs("b", "c"), strs(), rules(8080)),
+			expected: obj(strs("b", "c"), strs(), rules(8080)),
+		},
+		"different-length": {
+			prior:    obj(strs("a", "b"), strs(), rules(80)),
+			val:      obj(strs("b", "a", "a"), strs(), rules(80, 80)),
+			expected: obj(strs("b", "a", "a"), strs(), rules(80, 80)),
+		},
+		"null-prior-attribute": {
+			prior:    obj(tftypes.NewValue(listType, nil), strs(), tftypes.NewValue(rulesType, nil)),
+			val:      obj(strs("b", "a"), strs(), rules(443, 80)),
+			expected: obj(strs("b", "a"), strs(), rules(443, 80)),
+		},
+		"null-prior": {
+			prior:    tftypes.NewValue(objType, nil),
+			val:      obj(strs("b", "a"), strs(), rules(443, 80)),
+			expected: obj(strs("b", "a"), strs(), rules(443, 80)),
+		},
+		"null-value": {
+			prior:    obj(strs("a", "b"), strs(), rules(80)),
+			val:      tftypes.NewValue(objType, nil),
+			expected: tftypes.NewValue(objType, nil),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := preserveListOrder(context.Background(), testSchema, tc.prior, tc.val)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Manifest declares the resource and data source types of a provider, each
+// with its name and a function returning it, so providers with many of them
+// don't need to build the maps returned by GetResources and GetDataSources by
+// hand. Embedding a Manifest in the type implementing Provider implements
+// both methods:
+//
+//	type provider struct {
+//		tfsdk.Manifest
+//	}
+//
+//	func New() tfsdk.Provider {
+//		return &provider{
+//			Manifest: tfsdk.Manifest{
+//				Resources: []tfsdk.ManifestResource{
+//					{Name: "example_server", New: newServerType},
+//				},
+//			},
+//		}
+//	}
+type Manifest struct {
+	Resources   []ManifestResource
+	DataSources []ManifestDataSource
+}
+
+// ManifestResource is a resource type declared in a Manifest.
+type ManifestResource struct {
+	// Name is the name of the resource type, like "example_server".
+	Name string
+
+	// New returns the resource type.
+	New func() ResourceType
+}
+
+// ManifestDataSource is a data source type declared in a Manifest.
+type ManifestDataSource struct {
+	// Name is the name of the data source type, like "example_server".
+	Name string
+
+	// New returns the data source type.
+	New func() DataSourceType
+}
+
+// GetResources returns the resource types declared in the manifest, keyed by
+// name. It returns error diagnostics, in the order the resource types are
+// declared, for resource types without a name or New function, and for names
+// declared more than once.
+func (m Manifest) GetResources(_ context.Context) (map[string]ResourceType, []*tfprotov6.Diagnostic) {
+	var diags []*tfprotov6.Diagnostic
+	resources := make(map[string]ResourceType, len(m.Resources))
+	declared := make(map[string]bool, len(m.Resources))
+	for i, r := range m.Resources {
+		if diag := validateManifestEntry("resource", i, r.Name, r.New == nil, declared[r.Name]); diag != nil {
+			diags = append(diags, diag)
+			continue
+		}
+		declared[r.Name] = true
+		resources[r.Name] = r.New()
+	}
+	if diagsHasErrors(diags) {
+		return nil, diags
+	}
+	return resources, nil
+}
+
+// GetDataSources returns the data source types declared in the manifest,
+// keyed by name. It returns error diagnostics, in the order the data source
+// types are declared, for data source types without a name or New function,
+// and for names declared more than once.
+func (m Manifest) GetDataSources(_ context.Context) (map[string]DataSourceType, []*tfprotov6.Diagnostic) {
+	var diags []*tfprotov6.Diagnostic
+	dataSources := make(map[string]DataSourceType, len(m.DataSources))
+	declared := make(map[string]bool, len(m.DataSources))
+	for i, d := range m.DataSources {
+		if diag := validateManifestEntry("data source", i, d.Name, d.New == nil, declared[d.Name]); diag != nil {
+			diags = append(diags, diag)
+			continue
+		}
+		declared[d.Name] = true
+		dataSources[d.Name] = d.New()
+	}
+	if diagsHasErrors(diags) {
+		return nil, diags
+	}
+	return dataSources, nil
+}
+
+// validateManifestEntry returns an error diagnostic if the `i`th entry of a
+// manifest, a `kind` type named `name`, is invalid.
+func validateManifestEntry(kind string, i int, name string, noNew, duplicate bool) *tfprotov6.Diagnostic {
+	var problem string
+	switch {
+	case name == "":
+		problem = fmt.Sprintf("The %s type at index %d has no name.", kind, i)
+	case noNew:
+		problem = fmt.Sprintf("The %s type %q has no New function.", kind, name)
+	case duplicate:
+		problem = fmt.Sprintf("The %s type %q is declared more than once.", kind, name)
+	default:
+		return nil
+	}
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Invalid provider manifest",
+		Detail:   problem + " This is always a problem with the provider. Please report this to the provider developer.",
+	}
+}
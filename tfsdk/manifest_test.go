@@ -0,0 +1,118 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestManifestGetResources(t *testing.T) {
+	t.Parallel()
+
+	newOne := func() ResourceType { return testServeResourceTypeOne{} }
+	newTwo := func() ResourceType { return testServeResourceTypeTwo{} }
+
+	type testCase struct {
+		manifest      Manifest
+		expected      map[string]ResourceType
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+
+	tests := map[string]testCase{
+		"empty": {
+			expected: map[string]ResourceType{},
+		},
+		"valid": {
+			manifest: Manifest{
+				Resources: []ManifestResource{
+					{Name: "test_one", New: newOne},
+					{Name: "test_two", New: newTwo},
+				},
+			},
+			expected: map[string]ResourceType{
+				"test_one": testServeResourceTypeOne{},
+				"test_two": testServeResourceTypeTwo{},
+			},
+		},
+		"invalid": {
+			manifest: Manifest{
+				Resources: []ManifestResource{
+					{Name: "test_one", New: newOne},
+					{New: newTwo},
+					{Name: "test_two"},
+					{Name: "test_one", New: newTwo},
+				},
+			},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Invalid provider manifest",
+					Detail:   "The resource type at index 1 has no name. This is always a problem with the provider. Please report this to the provider developer.",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Invalid provider manifest",
+					Detail:   `The resource type "test_two" has no New function. This is always a problem with the provider. Please report this to the provider developer.`,
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Invalid provider manifest",
+					Detail:   `The resource type "test_one" is declared more than once. This is always a problem with the provider. Please report this to the provider developer.`,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.manifest.GetResources(context.Background())
+			if diff := cmp.Diff(tc.expectedDiags, diags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("Unexpected diff in resource types (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestManifestGetDataSources(t *testing.T) {
+	t.Parallel()
+
+	newOne := func() DataSourceType { return testServeDataSourceTypeOne{} }
+
+	got, diags := Manifest{
+		DataSources: []ManifestDataSource{
+			{Name: "test_one", New: newOne},
+		},
+	}.GetDataSources(context.Background())
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diags: %+v", diags)
+	}
+	if diff := cmp.Diff(map[string]DataSourceType{"test_one": testServeDataSourceTypeOne{}}, got); diff != "" {
+		t.Errorf("Unexpected diff in data source types (+wanted, -got): %s", diff)
+	}
+
+	_, diags = Manifest{
+		DataSources: []ManifestDataSource{
+			{Name: "test_one", New: newOne},
+			{Name: "test_one", New: newOne},
+		},
+	}.GetDataSources(context.Background())
+	expectedDiags := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Invalid provider manifest",
+			Detail:   `The data source type "test_one" is declared more than once. This is always a problem with the provider. Please report this to the provider developer.`,
+		},
+	}
+	if diff := cmp.Diff(expectedDiags, diags); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+}
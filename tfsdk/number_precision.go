@@ -0,0 +1,41 @@
+package tfsdk
+
+import (
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newDynamicValue returns `val` as a tfprotov6.DynamicValue of type `typ`,
+// ready to be sent to Terraform, with its numbers rounded as configured by
+// ServeOpts.NumberPrecision and ServeOpts.NumberRoundingMode.
+func (s *server) newDynamicValue(typ tftypes.Type, val tftypes.Value) (tfprotov6.DynamicValue, error) {
+	val, err := roundNumbers(val, s.numberPrecision, s.numberRoundingMode)
+	if err != nil {
+		return tfprotov6.DynamicValue{}, err
+	}
+	return tfprotov6.NewDynamicValue(typ, val)
+}
+
+// roundNumbers returns `val` with every known, non-null number in it rounded
+// to `prec` bits of precision using `mode`. If `prec` is zero, `val` is
+// returned unchanged.
+func roundNumbers(val tftypes.Value, prec uint, mode big.RoundingMode) (tftypes.Value, error) {
+	if prec == 0 || val.Type() == nil {
+		return val, nil
+	}
+	return tftypes.Transform(val, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.Type().Is(tftypes.Number) || !v.IsKnown() || v.IsNull() {
+			return v, nil
+		}
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return v, path.NewError(err)
+		}
+		if n.Prec() == prec && n.Mode() == mode {
+			return v, nil
+		}
+		return tftypes.NewValue(v.Type(), new(big.Float).SetMode(mode).SetPrec(prec).Set(n)), nil
+	})
+}
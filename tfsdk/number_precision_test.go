@@ -0,0 +1,76 @@
+package tfsdk
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRoundNumbers(t *testing.T) {
+	t.Parallel()
+
+	objType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"num":  tftypes.Number,
+		"nums": tftypes.List{ElementType: tftypes.Number},
+		"str":  tftypes.String,
+	}}
+	obj := func(num interface{}, nums ...interface{}) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(nums))
+		for _, n := range nums {
+			elems = append(elems, tftypes.NewValue(tftypes.Number, n))
+		}
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"num":  tftypes.NewValue(tftypes.Number, num),
+			"nums": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, elems),
+			"str":  tftypes.NewValue(tftypes.String, "7"),
+		})
+	}
+
+	type testCase struct {
+		val      tftypes.Value
+		prec     uint
+		mode     big.RoundingMode
+		expected tftypes.Value
+	}
+
+	tests := map[string]testCase{
+		"unset": {
+			val:      obj(big.NewFloat(7), big.NewFloat(0.1)),
+			expected: obj(big.NewFloat(7), big.NewFloat(0.1)),
+		},
+		"nearest-even": {
+			val:      obj(big.NewFloat(7), big.NewFloat(5), nil, tftypes.UnknownValue),
+			prec:     2,
+			expected: obj(big.NewFloat(8), big.NewFloat(4), nil, tftypes.UnknownValue),
+		},
+		"to-zero": {
+			val:      obj(big.NewFloat(7), big.NewFloat(5)),
+			prec:     2,
+			mode:     big.ToZero,
+			expected: obj(big.NewFloat(6), big.NewFloat(4)),
+		},
+		"null": {
+			val:      tftypes.NewValue(objType, nil),
+			prec:     512,
+			expected: tftypes.NewValue(objType, nil),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := roundNumbers(tc.val, tc.prec, tc.mode)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
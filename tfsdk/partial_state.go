@@ -0,0 +1,51 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// partialState returns the state recorded by the Checkpoint method of a
+// CreateResourceResponse or UpdateResourceResponse, ready to be sent to
+// Terraform alongside error diagnostics in place of the prior state, or nil if
+// no state was recorded. Terraform doesn't accept unknown values after apply,
+// so they're replaced with nulls.
+func (s *server) partialState(ctx context.Context, typeName string, resourceSchema schema.Schema, checkpoint *tftypes.Value) (*tfprotov6.DynamicValue, []*tfprotov6.Diagnostic) {
+	if checkpoint == nil || checkpoint.Type() == nil {
+		return nil, nil
+	}
+	val, err := tftypes.Transform(*checkpoint, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err == nil {
+		val, err = normalizeEmptyObjects(ctx, resourceSchema, val, false)
+	}
+	if err != nil {
+		return nil, []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error preparing partial state",
+				Detail:   "An unexpected error was encountered when preparing the partial state recorded before the error. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+			},
+		}
+	}
+	val, diags := s.encodeState(ctx, typeName, resourceSchema, val)
+	if diagsHasErrors(diags) {
+		return nil, diags
+	}
+	newState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), val)
+	if err != nil {
+		return nil, append(diags, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error converting partial state",
+			Detail:   "An unexpected error was encountered when converting the partial state recorded before the error to a usable type. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+		})
+	}
+	return &newState, diags
+}
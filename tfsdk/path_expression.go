@@ -0,0 +1,252 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PathExpression describes a set of attribute paths. It is built like a
+// tftypes.AttributePath, but can also contain wildcard steps that match any
+// attribute of an object or any element of a list, set, map, or tuple.
+//
+// PathExpressions are immutable; each method returns a new PathExpression
+// with the step added.
+type PathExpression struct {
+	steps []pathExpressionStep
+}
+
+// pathExpressionStep is a single step of a PathExpression. Either `step` is
+// set, and the step matches only that attribute or element, or one of the
+// wildcards is set.
+type pathExpressionStep struct {
+	step         tftypes.AttributePathStep
+	anyAttribute bool
+	anyElement   bool
+}
+
+// NewPathExpression returns an empty PathExpression, which matches only the
+// root of a value.
+func NewPathExpression() PathExpression {
+	return PathExpression{}
+}
+
+// AttributeName returns a copy of the PathExpression with a step matching
+// the attribute `name` of an object.
+func (e PathExpression) AttributeName(name string) PathExpression {
+	return e.withStep(pathExpressionStep{step: tftypes.AttributeName(name)})
+}
+
+// ElementKeyString returns a copy of the PathExpression with a step matching
+// the element `key` of a map.
+func (e PathExpression) ElementKeyString(key string) PathExpression {
+	return e.withStep(pathExpressionStep{step: tftypes.ElementKeyString(key)})
+}
+
+// ElementKeyInt returns a copy of the PathExpression with a step matching
+// the element at `index` of a list or tuple.
+func (e PathExpression) ElementKeyInt(index int64) PathExpression {
+	return e.withStep(pathExpressionStep{step: tftypes.ElementKeyInt(index)})
+}
+
+// AnyAttributeName returns a copy of the PathExpression with a step matching
+// every attribute of an object.
+func (e PathExpression) AnyAttributeName() PathExpression {
+	return e.withStep(pathExpressionStep{anyAttribute: true})
+}
+
+// AnyElementKey returns a copy of the PathExpression with a step matching
+// every element of a list, set, map, or tuple.
+func (e PathExpression) AnyElementKey() PathExpression {
+	return e.withStep(pathExpressionStep{anyElement: true})
+}
+
+// String returns a human-readable representation of the PathExpression,
+// using `*` for wildcard steps, like
+// `AttributeName("disks").ElementKey(*).AttributeName("id")`.
+func (e PathExpression) String() string {
+	parts := make([]string, 0, len(e.steps))
+	for _, step := range e.steps {
+		switch {
+		case step.anyAttribute:
+			parts = append(parts, "AttributeName(*)")
+		case step.anyElement:
+			parts = append(parts, "ElementKey(*)")
+		default:
+			parts = append(parts, tftypes.NewAttributePathWithSteps([]tftypes.AttributePathStep{step.step}).String())
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// resolveWildcards returns a copy of the PathExpression with each wildcard
+// step replaced by the step at the same position in `path`, if `path` has a
+// step of the kind the wildcard matches there. It resolves expressions
+// relative to an attribute, so `AttributeName("rules").ElementKey(*)` refers
+// to the element of rules the attribute at `path` is in.
+func (e PathExpression) resolveWildcards(path *tftypes.AttributePath) PathExpression {
+	pathSteps := path.Steps()
+	steps := make([]pathExpressionStep, len(e.steps))
+	copy(steps, e.steps)
+	for i, step := range steps {
+		if i >= len(pathSteps) {
+			break
+		}
+		_, isAttribute := pathSteps[i].(tftypes.AttributeName)
+		switch {
+		case step.anyAttribute && isAttribute, step.anyElement && !isAttribute:
+			steps[i] = pathExpressionStep{step: pathSteps[i]}
+		}
+	}
+	return PathExpression{steps: steps}
+}
+
+// hasWildcards returns true if any of the steps of the PathExpression are
+// wildcards.
+func (e PathExpression) hasWildcards() bool {
+	for _, step := range e.steps {
+		if step.anyAttribute || step.anyElement {
+			return true
+		}
+	}
+	return false
+}
+
+func (e PathExpression) withStep(step pathExpressionStep) PathExpression {
+	steps := make([]pathExpressionStep, len(e.steps), len(e.steps)+1)
+	copy(steps, e.steps)
+	return PathExpression{steps: append(steps, step)}
+}
+
+// PathMatch is a concrete attribute path that matched a PathExpression,
+// along with the value found at that path.
+type PathMatch struct {
+	Path  *tftypes.AttributePath
+	Value attr.Value
+}
+
+// pathMatches returns a PathMatch for every path in `val` matching `expr`,
+// with values produced by the attr.Types in `s`. Lists and tuples are matched
+// in index order, and maps and objects in key order. Null and unknown values
+// can be matched, but nothing inside them can be; neither can map keys or
+// list indexes that aren't in `val`.
+func pathMatches(ctx context.Context, s schema.Schema, val tftypes.Value, expr PathExpression) ([]PathMatch, error) {
+	var paths []*tftypes.AttributePath
+	var vals []tftypes.Value
+	var walk func(path *tftypes.AttributePath, v tftypes.Value, steps []pathExpressionStep) error
+	walk = func(path *tftypes.AttributePath, v tftypes.Value, steps []pathExpressionStep) error {
+		if len(steps) == 0 {
+			paths = append(paths, path)
+			vals = append(vals, v)
+			return nil
+		}
+		step, rest := steps[0], steps[1:]
+		if !step.anyAttribute && !step.anyElement {
+			if name, ok := step.step.(tftypes.AttributeName); ok && v.Type().Is(tftypes.Object{}) {
+				if _, ok := v.Type().(tftypes.Object).AttributeTypes[string(name)]; !ok {
+					return path.NewErrorf("object has no attribute %q", string(name))
+				}
+			}
+			res, err := v.ApplyTerraform5AttributePathStep(step.step)
+			if errors.Is(err, tftypes.ErrInvalidStep) {
+				return nil
+			}
+			if err != nil {
+				return path.NewError(err)
+			}
+			next, ok := res.(tftypes.Value)
+			if !ok {
+				return path.NewErrorf("got non-tftypes.Value result %v", res)
+			}
+			nextSteps := make([]tftypes.AttributePathStep, 0, len(path.Steps())+1)
+			nextSteps = append(nextSteps, path.Steps()...)
+			return walk(tftypes.NewAttributePathWithSteps(append(nextSteps, step.step)), next, rest)
+		}
+		if !v.IsKnown() || v.IsNull() {
+			return nil
+		}
+		switch {
+		case step.anyAttribute && v.Type().Is(tftypes.Object{}):
+			var attrs map[string]tftypes.Value
+			if err := v.As(&attrs); err != nil {
+				return path.NewError(err)
+			}
+			names := make([]string, 0, len(attrs))
+			for name := range attrs {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				if err := walk(path.WithAttributeName(name), attrs[name], rest); err != nil {
+					return err
+				}
+			}
+		case step.anyElement && v.Type().Is(tftypes.Map{}):
+			var elems map[string]tftypes.Value
+			if err := v.As(&elems); err != nil {
+				return path.NewError(err)
+			}
+			keys := make([]string, 0, len(elems))
+			for key := range elems {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if err := walk(path.WithElementKeyString(key), elems[key], rest); err != nil {
+					return err
+				}
+			}
+		case step.anyElement && (v.Type().Is(tftypes.List{}) || v.Type().Is(tftypes.Tuple{})):
+			var elems []tftypes.Value
+			if err := v.As(&elems); err != nil {
+				return path.NewError(err)
+			}
+			for i, elem := range elems {
+				if err := walk(path.WithElementKeyInt(int64(i)), elem, rest); err != nil {
+					return err
+				}
+			}
+		case step.anyElement && v.Type().Is(tftypes.Set{}):
+			var elems []tftypes.Value
+			if err := v.As(&elems); err != nil {
+				return path.NewError(err)
+			}
+			for _, elem := range elems {
+				if err := walk(path.WithElementKeyValue(elem), elem, rest); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(tftypes.NewAttributePath(), val, expr.steps); err != nil {
+		return nil, err
+	}
+
+	matches := make([]PathMatch, 0, len(paths))
+	for i, path := range paths {
+		attrType := s.AttributeType()
+		if len(path.Steps()) > 0 {
+			var err error
+			attrType, err = s.AttributeTypeAtPath(path)
+			if err != nil {
+				return nil, fmt.Errorf("error walking schema: %w", err)
+			}
+		}
+		attrVal, err := attrType.ValueFromTerraform(ctx, vals[i])
+		if err != nil {
+			return nil, path.NewError(err)
+		}
+		matches = append(matches, PathMatch{
+			Path:  path,
+			Value: attrVal,
+		})
+	}
+	return matches, nil
+}
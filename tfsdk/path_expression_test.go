@@ -0,0 +1,122 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPathExpressionString(t *testing.T) {
+	t.Parallel()
+
+	expr := NewPathExpression().AttributeName("disks").AnyElementKey().AnyAttributeName()
+	expected := `AttributeName("disks").ElementKey(*).AttributeName(*)`
+	if got := expr.String(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	// adding steps must not change the expression they're added to
+	base := NewPathExpression().AttributeName("disks")
+	_ = base.ElementKeyInt(0)
+	_ = base.ElementKeyInt(1)
+	if got := base.String(); got != `AttributeName("disks")` {
+		t.Errorf("Expected base expression to be unchanged, got %q", got)
+	}
+}
+
+func TestStatePathMatches(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		expr          PathExpression
+		expected      []PathMatch
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"concrete": {
+			expr: NewPathExpression().AttributeName("name"),
+			expected: []PathMatch{
+				{
+					Path:  tftypes.NewAttributePath().WithAttributeName("name"),
+					Value: types.String{Value: "hello, world"},
+				},
+			},
+		},
+		"any-element": {
+			expr: NewPathExpression().AttributeName("disks").AnyElementKey().AttributeName("id"),
+			expected: []PathMatch{
+				{
+					Path:  tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("id"),
+					Value: types.String{Value: "disk0"},
+				},
+				{
+					Path:  tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1).WithAttributeName("id"),
+					Value: types.String{Value: "disk1"},
+				},
+			},
+		},
+		"any-attribute": {
+			expr: NewPathExpression().AttributeName("boot_disk").AnyAttributeName(),
+			expected: []PathMatch{
+				{
+					Path:  tftypes.NewAttributePath().WithAttributeName("boot_disk").WithAttributeName("delete_with_instance"),
+					Value: types.Bool{Value: true},
+				},
+				{
+					Path:  tftypes.NewAttributePath().WithAttributeName("boot_disk").WithAttributeName("id"),
+					Value: types.String{Value: "bootdisk"},
+				},
+			},
+		},
+		"missing-element": {
+			expr:     NewPathExpression().AttributeName("tags").ElementKeyInt(5),
+			expected: []PathMatch{},
+		},
+		"unknown-attribute": {
+			expr:          NewPathExpression().AttributeName("disks").AnyElementKey().AttributeName("size"),
+			expectedError: `error matching paths in state: AttributeName("disks").ElementKeyInt(0): object has no attribute "size"`,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := makeTestState().PathMatches(context.Background(), tc.expr)
+			if tc.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error %q, got none", tc.expectedError)
+				}
+				if err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %q", tc.expectedError, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStatePathMatchesNull(t *testing.T) {
+	t.Parallel()
+
+	state := makeTestState()
+	state.Raw = tftypes.NewValue(state.Raw.Type(), nil)
+	got, err := state.PathMatches(context.Background(), NewPathExpression().AttributeName("disks").AnyElementKey())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expected no matches in a null state, got %v", got)
+	}
+}
@@ -77,7 +77,7 @@ func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, va
 
 	transformFunc := func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
 		if p.Equal(path) {
-			return tftypes.NewValue(attrType.TerraformType(ctx), newTfVal), nil
+			return reflect.NewTerraformValue(attrType.TerraformType(ctx), newTfVal)
 		}
 		return v, nil
 	}
@@ -90,6 +90,17 @@ func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, va
 	return nil
 }
 
+// PathMatches returns every path in the plan matching `expr`, along with the
+// value at that path. Consumers should assert the type of each value with the
+// desired attr.Type.
+func (p Plan) PathMatches(ctx context.Context, expr PathExpression) ([]PathMatch, error) {
+	matches, err := pathMatches(ctx, p.Schema, p.Raw, expr)
+	if err != nil {
+		return nil, fmt.Errorf("error matching paths in plan: %w", err)
+	}
+	return matches, nil
+}
+
 func (p Plan) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
 	rawValue, remaining, err := tftypes.WalkAttributePath(p.Raw, path)
 	if err != nil {
@@ -101,3 +112,55 @@ func (p Plan) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value,
 	}
 	return attrValue, err
 }
+
+// PlanAction is what Terraform will do to a resource when a plan is applied.
+type PlanAction uint8
+
+const (
+	// PlanActionUnknown is an invalid PlanAction, used to catch when a
+	// PlanAction hasn't been set.
+	PlanActionUnknown PlanAction = iota
+
+	// PlanActionCreate means the resource will be created.
+	PlanActionCreate
+
+	// PlanActionUpdate means the resource will be updated in place.
+	PlanActionUpdate
+
+	// PlanActionReplace means the resource will be destroyed and created
+	// again.
+	PlanActionReplace
+
+	// PlanActionDestroy means the resource will be destroyed.
+	PlanActionDestroy
+)
+
+// String returns the name of the PlanAction, like "create".
+func (a PlanAction) String() string {
+	switch a {
+	case PlanActionCreate:
+		return "create"
+	case PlanActionUpdate:
+		return "update"
+	case PlanActionReplace:
+		return "replace"
+	case PlanActionDestroy:
+		return "destroy"
+	}
+	return "unknown"
+}
+
+// planAction returns the PlanAction for a resource going from `priorState`
+// to `plan`, where `requiresReplace` lists the attributes whose changes
+// require replacing it.
+func planAction(priorState, plan tftypes.Value, requiresReplace []*tftypes.AttributePath) PlanAction {
+	switch {
+	case plan.IsNull():
+		return PlanActionDestroy
+	case priorState.IsNull():
+		return PlanActionCreate
+	case len(requiresReplace) > 0:
+		return PlanActionReplace
+	}
+	return PlanActionUpdate
+}
@@ -0,0 +1,161 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PlanValueSource is where the planned value of an attribute came from.
+type PlanValueSource string
+
+const (
+	// PlanValueSourceConfig means the planned value is the configured
+	// value.
+	PlanValueSourceConfig PlanValueSource = "config"
+
+	// PlanValueSourceState means the attribute isn't configured and is
+	// computed, and the planned value was copied from the prior state.
+	PlanValueSourceState PlanValueSource = "state"
+
+	// PlanValueSourceUnknown means the attribute isn't configured and is
+	// computed, and the framework marked it unknown, to be set when the
+	// plan is applied.
+	PlanValueSourceUnknown PlanValueSource = "unknown"
+
+	// PlanValueSourceNull means the attribute isn't configured and has no
+	// value.
+	PlanValueSourceNull PlanValueSource = "null"
+
+	// PlanValueSourcePlanModifier means the planned value was set by the
+	// attribute's plan modifiers. Defaults are applied this way.
+	PlanValueSourcePlanModifier PlanValueSource = "plan_modifier"
+
+	// PlanValueSourceModifyPlan means the planned value was set by the
+	// resource's ModifyPlan method.
+	PlanValueSourceModifyPlan PlanValueSource = "modify_plan"
+)
+
+// PlanExplanation explains why the framework planned each attribute value of
+// a resource. It is logged at the DEBUG level, as JSON, for each plan when
+// ServeOpts.ExplainPlans is set.
+type PlanExplanation struct {
+	// TypeName is the resource type the plan is for.
+	TypeName string `json:"type_name"`
+
+	// Attributes explain each attribute in the plan, including nested
+	// attributes, in order of their paths.
+	Attributes []AttributePlanExplanation `json:"attributes"`
+}
+
+// AttributePlanExplanation explains the planned value of one attribute.
+type AttributePlanExplanation struct {
+	// Path is the attribute's path.
+	Path *tftypes.AttributePath `json:"-"`
+
+	// Source is where the planned value came from.
+	Source PlanValueSource `json:"source"`
+
+	// PlanModifiers are the descriptions of the plan modifiers that
+	// changed the value, in the order they ran. It's only set when
+	// Source is PlanValueSourcePlanModifier.
+	PlanModifiers []string `json:"plan_modifiers,omitempty"`
+}
+
+// MarshalJSON returns the explanation as JSON, with its path formatted like
+// `AttributeName("rules").ElementKeyInt(0).AttributeName("port")`.
+func (e AttributePlanExplanation) MarshalJSON() ([]byte, error) {
+	type explanation AttributePlanExplanation
+	return json.Marshal(struct {
+		Path string `json:"path"`
+		explanation
+	}{
+		Path:        e.Path.String(),
+		explanation: explanation(e),
+	})
+}
+
+// planModifierChanges are the descriptions of the plan modifiers that changed
+// each attribute's planned value, keyed by the attribute's path.
+type planModifierChanges map[string][]string
+
+// explainPlan returns the explanation of `plan`, the final plan for a
+// resource. `modified` is the plan before the resource's ModifyPlan method
+// was called, and `changes` are the plan modifiers that changed each value.
+func explainPlan(typeName string, resourceSchema schema.Schema, config, modified, plan tftypes.Value, changes planModifierChanges) (PlanExplanation, error) {
+	explanation := PlanExplanation{TypeName: typeName}
+	err := walkAttributeValues(resourceSchema, plan, func(path *tftypes.AttributePath, attribute schema.Attribute, val tftypes.Value) (bool, error) {
+		modifiers := changes[path.String()]
+		source := planValueSource(path, val, config, modified, modifiers)
+		if source != PlanValueSourcePlanModifier {
+			modifiers = nil
+		}
+		explanation.Attributes = append(explanation.Attributes, AttributePlanExplanation{
+			Path:          path,
+			Source:        source,
+			PlanModifiers: modifiers,
+		})
+		return attribute.Attributes != nil, nil
+	})
+	if err != nil {
+		return explanation, err
+	}
+	sort.Slice(explanation.Attributes, func(i, j int) bool {
+		return order.PathLess(explanation.Attributes[i].Path, explanation.Attributes[j].Path)
+	})
+	return explanation, nil
+}
+
+// planValueSource returns where `val`, the planned value at `path`, came from.
+// `modifiers` are the plan modifiers that changed it.
+func planValueSource(path *tftypes.AttributePath, val, config, modified tftypes.Value, modifiers []string) PlanValueSource {
+	if before, ok := planValueAt(modified, path); !ok || !before.Equal(val) {
+		return PlanValueSourceModifyPlan
+	}
+	if len(modifiers) > 0 {
+		return PlanValueSourcePlanModifier
+	}
+	if configVal, ok := planValueAt(config, path); ok && !configVal.IsNull() {
+		return PlanValueSourceConfig
+	}
+	switch {
+	case !val.IsKnown():
+		return PlanValueSourceUnknown
+	case val.IsNull():
+		return PlanValueSourceNull
+	}
+	// Terraform only proposes values for attributes that aren't
+	// configured by copying them from the prior state
+	return PlanValueSourceState
+}
+
+// planValueAt returns the value at `path` in `val`, and whether there is one.
+func planValueAt(val tftypes.Value, path *tftypes.AttributePath) (tftypes.Value, bool) {
+	raw, _, err := tftypes.WalkAttributePath(val, path)
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+	v, ok := raw.(tftypes.Value)
+	return v, ok
+}
+
+// logPlanExplanation logs the explanation of `plan` at the DEBUG level. The
+// explanation is only a development aid, so failing to build it is logged
+// rather than failing the plan.
+func logPlanExplanation(ctx context.Context, typeName string, resourceSchema schema.Schema, config, modified, plan tftypes.Value, changes planModifierChanges) {
+	explanation, err := explainPlan(typeName, resourceSchema, config, modified, plan, changes)
+	if err != nil {
+		logf(ctx, "WARN", "couldn't explain plan for resource %q: %s", typeName, err)
+		return
+	}
+	b, err := json.Marshal(explanation)
+	if err != nil {
+		logf(ctx, "WARN", "couldn't explain plan for resource %q: %s", typeName, err)
+		return
+	}
+	logf(ctx, "DEBUG", "plan explanation: %s", b)
+}
@@ -0,0 +1,169 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExplainPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"id": {
+				Type:          types.StringType,
+				Computed:      true,
+				PlanModifiers: []schema.AttributePlanModifier{schema.UseStateForUnknown()},
+			},
+			"arn": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"etag": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"region": {
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+			},
+			"rules": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"port": {
+						Type:     types.NumberType,
+						Required: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(ctx)
+	rulesType := typ.(tftypes.Object).AttributeTypes["rules"]
+	ruleType := rulesType.(tftypes.List).ElementType
+	value := func(id, arn, etag, region interface{}) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, "example"),
+			"description": tftypes.NewValue(tftypes.String, nil),
+			"id":          tftypes.NewValue(tftypes.String, id),
+			"arn":         tftypes.NewValue(tftypes.String, arn),
+			"etag":        tftypes.NewValue(tftypes.String, etag),
+			"region":      tftypes.NewValue(tftypes.String, region),
+			"rules": tftypes.NewValue(rulesType, []tftypes.Value{
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"port": tftypes.NewValue(tftypes.Number, 443),
+				}),
+			}),
+		})
+	}
+	config := value(nil, nil, nil, nil)
+	state := value("abc", "arn:abc", "1", "us-east-1")
+	// Terraform proposed the prior state for the computed attributes, and
+	// the framework marked the ones it doesn't keep unknown
+	plan := value(tftypes.UnknownValue, "arn:abc", tftypes.UnknownValue, tftypes.UnknownValue)
+
+	changes := planModifierChanges{}
+	var diags []*tfprotov6.Diagnostic
+	var requiresReplace []*tftypes.AttributePath
+	modified, err := tftypes.Transform(plan, runAttributePlanModifiers(ctx, resourceSchema, config, state, plan, &diags, &requiresReplace, changes))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	// the resource's ModifyPlan method set the region
+	final := value("abc", "arn:abc", tftypes.UnknownValue, "eu-west-1")
+
+	got, err := explainPlan("test_resource", resourceSchema, config, modified, final, changes)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := map[string]interface{}{
+		"type_name": "test_resource",
+		"attributes": []interface{}{
+			map[string]interface{}{"path": `AttributeName("arn")`, "source": "state"},
+			map[string]interface{}{"path": `AttributeName("description")`, "source": "null"},
+			map[string]interface{}{"path": `AttributeName("etag")`, "source": "unknown"},
+			map[string]interface{}{"path": `AttributeName("id")`, "source": "plan_modifier", "plan_modifiers": []interface{}{"Once set, the value of this attribute in state will not change."}},
+			map[string]interface{}{"path": `AttributeName("name")`, "source": "config"},
+			map[string]interface{}{"path": `AttributeName("region")`, "source": "modify_plan"},
+			map[string]interface{}{"path": `AttributeName("rules")`, "source": "config"},
+			map[string]interface{}{"path": `AttributeName("rules").ElementKeyInt(0).AttributeName("port")`, "source": "config"},
+		},
+	}
+	var gotDecoded map[string]interface{}
+	if err := json.Unmarshal(gotJSON, &gotDecoded); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(gotDecoded, expected); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestExplainPlan_longList(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ports": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"port": {
+						Type:     types.NumberType,
+						Required: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Required: true,
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(ctx)
+	portsType := typ.(tftypes.Object).AttributeTypes["ports"]
+	portType := portsType.(tftypes.List).ElementType
+	ports := make([]tftypes.Value, 0, 11)
+	for i := 0; i < 11; i++ {
+		ports = append(ports, tftypes.NewValue(portType, map[string]tftypes.Value{
+			"port": tftypes.NewValue(tftypes.Number, 8000+i),
+		}))
+	}
+	value := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"ports": tftypes.NewValue(portsType, ports),
+	})
+
+	got, err := explainPlan("test_resource", resourceSchema, value, value, value, planModifierChanges{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("ports"),
+	}
+	for i := 0; i < 11; i++ {
+		expected = append(expected, tftypes.NewAttributePath().WithAttributeName("ports").WithElementKeyInt(int64(i)).WithAttributeName("port"))
+	}
+	paths := make([]*tftypes.AttributePath, 0, len(got.Attributes))
+	for _, attribute := range got.Attributes {
+		paths = append(paths, attribute.Path)
+	}
+	if diff := cmp.Diff(expected, paths); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
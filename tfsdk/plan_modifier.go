@@ -0,0 +1,243 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// RequiresReplaceWhenChanged returns a schema.AttributePlanModifier that
+// requires the resource to be replaced whenever the values matching `expr`
+// differ between the resource's state and its plan, like Terraform's
+// replace_triggered_by. A value that is unknown in the plan counts as a
+// change. It has no effect when the resource is being created.
+func RequiresReplaceWhenChanged(expr PathExpression) schema.AttributePlanModifier {
+	return changeTriggerModifier{expr: expr, replace: true}
+}
+
+// RecomputeWhenChanged returns a schema.AttributePlanModifier that marks a
+// computed attribute as unknown whenever the values matching `expr` differ
+// between the resource's state and its plan, so the provider can set a new
+// value for it, like rotating a credential when its key_version changes. A
+// value that is unknown in the plan counts as a change. Values set in the
+// configuration are never marked as unknown.
+func RecomputeWhenChanged(expr PathExpression) schema.AttributePlanModifier {
+	return changeTriggerModifier{expr: expr}
+}
+
+// ChangesWith returns a schema.AttributePlanModifier for computed attributes
+// whose values change whenever the values matching `expr` change, and only
+// then. It works like RecomputeWhenChanged, except that when the values
+// matching `expr` don't change, the attribute's prior state value is planned,
+// as it would be with schema.UseStateForUnknown.
+//
+// For example, an "etag" attribute that the API regenerates whenever the
+// "content" attribute is updated can use ChangesWith with an expression
+// matching "content", so practitioners only see "(known after apply)" for it
+// when the content changes.
+func ChangesWith(expr PathExpression) schema.AttributePlanModifier {
+	return changeTriggerModifier{expr: expr, keepState: true}
+}
+
+// changeTriggerModifier is the modifier returned by RequiresReplaceWhenChanged,
+// RecomputeWhenChanged, and ChangesWith, which all act on whether the values
+// matching `expr` changed, as reported by matchesChanged.
+type changeTriggerModifier struct {
+	expr      PathExpression
+	replace   bool
+	keepState bool
+}
+
+func (m changeTriggerModifier) Description(_ context.Context) string {
+	if m.replace {
+		return fmt.Sprintf("Changing %s will force the resource to be replaced.", m.expr)
+	}
+	if m.keepState {
+		return fmt.Sprintf("The value of this attribute changes whenever %s changes, and keeps its value in state otherwise.", m.expr)
+	}
+	return fmt.Sprintf("Changing %s will cause this attribute to be recomputed.", m.expr)
+}
+
+func (m changeTriggerModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m changeTriggerModifier) Modify(ctx context.Context, req schema.ModifyAttributePlanRequest, resp *schema.ModifyAttributePlanResponse) {
+	if req.State.Type() == nil || req.State.IsNull() || req.Plan.Type() == nil || req.Plan.IsNull() {
+		// the resource is being created or destroyed, there's
+		// nothing to compare
+		return
+	}
+	changed, err := matchesChanged(ctx, req.Schema, req.State, req.Plan, m.expr)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   fmt.Sprintf("An unexpected error was encountered comparing the values matching %s in the state and plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n%s", m.expr, err.Error()),
+		})
+		return
+	}
+	if !changed && !m.keepState {
+		return
+	}
+	if m.replace {
+		resp.RequiresReplace = true
+		return
+	}
+	if req.AttributeConfig != nil && !req.AttributeConfig.IsNull() {
+		// the practitioner chose the value, it can't be recomputed
+		return
+	}
+	if !changed {
+		if req.AttributeState != nil && !req.AttributeState.IsNull() {
+			resp.AttributePlan = req.AttributeState
+		}
+		return
+	}
+	attrType, err := req.Schema.AttributeTypeAtPath(req.AttributePath)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   "An unexpected error was encountered finding the attribute's type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return
+	}
+	unknown, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), tftypes.UnknownValue))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   "An unexpected error was encountered creating an unknown value for the attribute. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return
+	}
+	resp.AttributePlan = unknown
+}
+
+// matchesChanged returns true if the paths matching `expr` in `state` and
+// `plan`, or the values at them, differ, or if any of the values in `plan`
+// are unknown.
+func matchesChanged(ctx context.Context, s schema.Schema, state, plan tftypes.Value, expr PathExpression) (bool, error) {
+	stateMatches, err := pathMatches(ctx, s, state, expr)
+	if err != nil {
+		return false, fmt.Errorf("error matching paths in state: %w", err)
+	}
+	planMatches, err := pathMatches(ctx, s, plan, expr)
+	if err != nil {
+		return false, fmt.Errorf("error matching paths in plan: %w", err)
+	}
+	if len(stateMatches) != len(planMatches) {
+		return true, nil
+	}
+	for i, planMatch := range planMatches {
+		if planMatch.Value.IsUnknown() {
+			return true, nil
+		}
+		if !planMatch.Path.Equal(stateMatches[i].Path) || !planMatch.Value.Equal(stateMatches[i].Value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// appendMissingPaths appends each of `paths` to `dst` that isn't already in
+// it.
+func appendMissingPaths(dst []*tftypes.AttributePath, paths ...*tftypes.AttributePath) []*tftypes.AttributePath {
+	for _, path := range paths {
+		var found bool
+		for _, existing := range dst {
+			if existing.Equal(path) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dst = append(dst, path)
+		}
+	}
+	return dst
+}
+
+// DefaultFromAttribute returns a schema.AttributePlanModifier that plans the
+// configured value of the attribute matching `expr` when the attribute it's
+// set on isn't configured, like a display_name that defaults to the name. It
+// is meant for optional and computed attributes. Wildcards in `expr` are
+// resolved relative to the attribute, so an attribute of an element of
+// nested attributes can default to an attribute of the same element:
+//
+//	DefaultFromAttribute(NewPathExpression().AttributeName("rules").AnyElementKey().AttributeName("name"))
+//
+// The attributes must be of the same type. Nothing is changed when the other
+// attribute isn't configured either.
+func DefaultFromAttribute(expr PathExpression) schema.AttributePlanModifier {
+	return defaultFromAttributeModifier{expr: expr}
+}
+
+type defaultFromAttributeModifier struct {
+	expr PathExpression
+}
+
+func (m defaultFromAttributeModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Defaults to the value of %s.", m.expr)
+}
+
+func (m defaultFromAttributeModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m defaultFromAttributeModifier) Modify(ctx context.Context, req schema.ModifyAttributePlanRequest, resp *schema.ModifyAttributePlanResponse) {
+	if req.Config.Type() == nil || req.Config.IsNull() || req.AttributeConfig == nil || !req.AttributeConfig.IsNull() {
+		// the resource is being destroyed, or the practitioner
+		// configured a value for the attribute
+		return
+	}
+	expr := m.expr.resolveWildcards(req.AttributePath)
+	matches, err := pathMatches(ctx, req.Schema, req.Config, expr)
+	if err == nil && (expr.hasWildcards() || len(matches) > 1) {
+		err = fmt.Errorf("%s must match a single attribute, but matched %d", m.expr, len(matches))
+	}
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   "An unexpected error was encountered finding the attribute to default to. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return
+	}
+	if len(matches) == 0 || matches[0].Value.IsNull() {
+		return
+	}
+	attrType, err := req.Schema.AttributeTypeAtPath(req.AttributePath)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   "An unexpected error was encountered finding the attribute's type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return
+	}
+	raw, err := matches[0].Value.ToTerraformValue(ctx)
+	var tfVal tftypes.Value
+	if err == nil {
+		tfVal, err = reflect.NewTerraformValue(attrType.TerraformType(ctx), raw)
+	}
+	var val attr.Value
+	if err == nil {
+		val, err = attrType.ValueFromTerraform(ctx, tfVal)
+	}
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   fmt.Sprintf("An unexpected error was encountered using the value of %s as the attribute's default. This is always a problem with the provider. Please report the following to the provider developer:\n\n%s", matches[0].Path, err.Error()),
+		})
+		return
+	}
+	resp.AttributePlan = val
+}
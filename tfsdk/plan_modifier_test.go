@@ -0,0 +1,522 @@
+package tfsdk
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestChangeTriggerModifiers(t *testing.T) {
+	t.Parallel()
+
+	keyVersion := NewPathExpression().AttributeName("key_version")
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_version": {
+				Type:     types.NumberType,
+				Required: true,
+			},
+			"name": {
+				Type:          types.StringType,
+				Required:      true,
+				PlanModifiers: []schema.AttributePlanModifier{RequiresReplaceWhenChanged(keyVersion)},
+			},
+			"secret": {
+				Type:          types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []schema.AttributePlanModifier{schema.UseStateForUnknown(), RecomputeWhenChanged(keyVersion)},
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	value := func(keyVersion interface{}, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"key_version": tftypes.NewValue(tftypes.Number, keyVersion),
+			"name":        tftypes.NewValue(tftypes.String, "example"),
+			"secret":      tftypes.NewValue(tftypes.String, secret),
+		})
+	}
+
+	type testCase struct {
+		config                  tftypes.Value
+		state                   tftypes.Value
+		plan                    tftypes.Value
+		expectedPlan            tftypes.Value
+		expectedRequiresReplace []*tftypes.AttributePath
+	}
+
+	tests := map[string]testCase{
+		"create": {
+			config:       value(1, nil),
+			state:        tftypes.NewValue(typ, nil),
+			plan:         value(1, tftypes.UnknownValue),
+			expectedPlan: value(1, tftypes.UnknownValue),
+		},
+		"unchanged": {
+			config:       value(1, nil),
+			state:        value(1, "hunter2"),
+			plan:         value(1, tftypes.UnknownValue),
+			expectedPlan: value(1, "hunter2"),
+		},
+		"changed": {
+			config:       value(2, nil),
+			state:        value(1, "hunter2"),
+			plan:         value(2, tftypes.UnknownValue),
+			expectedPlan: value(2, tftypes.UnknownValue),
+			expectedRequiresReplace: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("name"),
+			},
+		},
+		"unknown": {
+			config:       value(tftypes.UnknownValue, nil),
+			state:        value(1, "hunter2"),
+			plan:         value(tftypes.UnknownValue, tftypes.UnknownValue),
+			expectedPlan: value(tftypes.UnknownValue, tftypes.UnknownValue),
+			expectedRequiresReplace: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("name"),
+			},
+		},
+		"changed-configured": {
+			config:       value(2, "correcthorse"),
+			state:        value(1, "hunter2"),
+			plan:         value(2, "correcthorse"),
+			expectedPlan: value(2, "correcthorse"),
+			expectedRequiresReplace: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("name"),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags []*tfprotov6.Diagnostic
+			var requiresReplace []*tftypes.AttributePath
+			got, err := tftypes.Transform(tc.plan, runAttributePlanModifiers(context.Background(), resourceSchema, tc.config, tc.state, tc.plan, &diags, &requiresReplace, nil))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(diags) > 0 {
+				t.Fatalf("Unexpected diagnostics: %+v", diags)
+			}
+			if diff := cmp.Diff(got, tc.expectedPlan); diff != "" {
+				t.Errorf("Unexpected diff in plan (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(requiresReplace, tc.expectedRequiresReplace); diff != "" {
+				t.Errorf("Unexpected diff in requires replace (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangesWith(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"content": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"etag": {
+				Type:          types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []schema.AttributePlanModifier{ChangesWith(NewPathExpression().AttributeName("content"))},
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	value := func(content, etag interface{}) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"content": tftypes.NewValue(tftypes.String, content),
+			"etag":    tftypes.NewValue(tftypes.String, etag),
+		})
+	}
+
+	type testCase struct {
+		config       tftypes.Value
+		state        tftypes.Value
+		plan         tftypes.Value
+		expectedPlan tftypes.Value
+	}
+
+	tests := map[string]testCase{
+		"create": {
+			config:       value("a", nil),
+			state:        tftypes.NewValue(typ, nil),
+			plan:         value("a", tftypes.UnknownValue),
+			expectedPlan: value("a", tftypes.UnknownValue),
+		},
+		"unchanged": {
+			config:       value("a", nil),
+			state:        value("a", "1"),
+			plan:         value("a", tftypes.UnknownValue),
+			expectedPlan: value("a", "1"),
+		},
+		"changed": {
+			config:       value("b", nil),
+			state:        value("a", "1"),
+			plan:         value("b", "1"),
+			expectedPlan: value("b", tftypes.UnknownValue),
+		},
+		"changed-to-unknown": {
+			config:       value(tftypes.UnknownValue, nil),
+			state:        value("a", "1"),
+			plan:         value(tftypes.UnknownValue, "1"),
+			expectedPlan: value(tftypes.UnknownValue, tftypes.UnknownValue),
+		},
+		"configured": {
+			config:       value("b", "2"),
+			state:        value("a", "1"),
+			plan:         value("b", "2"),
+			expectedPlan: value("b", "2"),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags []*tfprotov6.Diagnostic
+			var requiresReplace []*tftypes.AttributePath
+			got, err := tftypes.Transform(tc.plan, runAttributePlanModifiers(context.Background(), resourceSchema, tc.config, tc.state, tc.plan, &diags, &requiresReplace, nil))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(diags) > 0 {
+				t.Fatalf("Unexpected diagnostics: %+v", diags)
+			}
+			if diff := cmp.Diff(got, tc.expectedPlan); diff != "" {
+				t.Errorf("Unexpected diff in plan (+wanted, -got): %s", diff)
+			}
+			if len(requiresReplace) > 0 {
+				t.Errorf("Unexpected requires replace: %v", requiresReplace)
+			}
+		})
+	}
+}
+
+func TestDefaultFromAttribute(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"display_name": {
+				Type:          types.StringType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []schema.AttributePlanModifier{DefaultFromAttribute(NewPathExpression().AttributeName("name"))},
+			},
+			"rules": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"name": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"label": {
+						Type:          types.StringType,
+						Optional:      true,
+						Computed:      true,
+						PlanModifiers: []schema.AttributePlanModifier{DefaultFromAttribute(NewPathExpression().AttributeName("rules").AnyElementKey().AttributeName("name"))},
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	rulesType := typ.(tftypes.Object).AttributeTypes["rules"]
+	ruleType := rulesType.(tftypes.List).ElementType
+	value := func(name, displayName interface{}, labels ...interface{}) tftypes.Value {
+		rules := []tftypes.Value{}
+		for i, label := range labels {
+			rules = append(rules, tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, []string{"first", "second"}[i]),
+				"label": tftypes.NewValue(tftypes.String, label),
+			}))
+		}
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":         tftypes.NewValue(tftypes.String, name),
+			"display_name": tftypes.NewValue(tftypes.String, displayName),
+			"rules":        tftypes.NewValue(rulesType, rules),
+		})
+	}
+	unknown := tftypes.UnknownValue
+
+	type testCase struct {
+		config       tftypes.Value
+		plan         tftypes.Value
+		expectedPlan tftypes.Value
+	}
+
+	tests := map[string]testCase{
+		"defaulted": {
+			config:       value("example", nil, nil, "custom"),
+			plan:         value("example", unknown, unknown, "custom"),
+			expectedPlan: value("example", "example", "first", "custom"),
+		},
+		"configured": {
+			config:       value("example", "Example", "custom", "custom"),
+			plan:         value("example", "Example", "custom", "custom"),
+			expectedPlan: value("example", "Example", "custom", "custom"),
+		},
+		"unknown-source": {
+			config:       value(unknown, nil),
+			plan:         value(unknown, unknown),
+			expectedPlan: value(unknown, unknown),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags []*tfprotov6.Diagnostic
+			var requiresReplace []*tftypes.AttributePath
+			state := tftypes.NewValue(typ, nil)
+			got, err := tftypes.Transform(tc.plan, runAttributePlanModifiers(context.Background(), resourceSchema, tc.config, state, tc.plan, &diags, &requiresReplace, nil))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if len(diags) > 0 {
+				t.Fatalf("Unexpected diagnostics: %+v", diags)
+			}
+			if diff := cmp.Diff(got, tc.expectedPlan); diff != "" {
+				t.Errorf("Unexpected diff in plan (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultFromAttribute_dynamic(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source": {
+				Type:     types.DynamicType,
+				Required: true,
+			},
+			"copy": {
+				Type:          types.DynamicType,
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []schema.AttributePlanModifier{DefaultFromAttribute(NewPathExpression().AttributeName("source"))},
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	value := func(source, copied tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"source": source,
+			"copy":   copied,
+		})
+	}
+	source := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+	})
+	config := value(source, tftypes.NewValue(tftypes.DynamicPseudoType, nil))
+	plan := value(source, tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue))
+
+	var diags []*tfprotov6.Diagnostic
+	var requiresReplace []*tftypes.AttributePath
+	got, err := tftypes.Transform(plan, runAttributePlanModifiers(context.Background(), resourceSchema, config, tftypes.NewValue(typ, nil), plan, &diags, &requiresReplace, nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", diags)
+	}
+	if diff := cmp.Diff(got, value(source, source)); diff != "" {
+		t.Errorf("Unexpected diff in plan (+wanted, -got): %s", diff)
+	}
+}
+
+// testElementModifier plans "default" for unknown string elements and
+// requires replacement when a known element changes, recording the paths it
+// runs for.
+type testElementModifier struct {
+	paths *[]string
+}
+
+func (m testElementModifier) Description(_ context.Context) string {
+	return "Defaults elements to \"default\"."
+}
+
+func (m testElementModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m testElementModifier) Modify(_ context.Context, req schema.ModifyAttributePlanRequest, resp *schema.ModifyAttributePlanResponse) {
+	*m.paths = append(*m.paths, req.AttributePath.String())
+	if req.AttributePlan.IsUnknown() {
+		resp.AttributePlan = types.StringValue("default")
+		return
+	}
+	if !req.AttributeState.IsNull() && !req.AttributeState.Equal(req.AttributePlan) {
+		resp.RequiresReplace = true
+	}
+}
+
+func TestRunAttributePlanModifiers_elements(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+	modifier := testElementModifier{paths: &paths}
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": {
+				Type:                 types.ListType{ElemType: types.StringType},
+				Optional:             true,
+				ElementPlanModifiers: []schema.AttributePlanModifier{modifier},
+			},
+			"labels": {
+				Type:                 types.MapType{ElemType: types.StringType},
+				Optional:             true,
+				ElementPlanModifiers: []schema.AttributePlanModifier{modifier},
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	listType := tftypes.List{ElementType: tftypes.String}
+	mapType := tftypes.Map{AttributeType: tftypes.String}
+	value := func(tags []interface{}, labels map[string]interface{}) tftypes.Value {
+		tagVals := make([]tftypes.Value, 0, len(tags))
+		for _, tag := range tags {
+			tagVals = append(tagVals, tftypes.NewValue(tftypes.String, tag))
+		}
+		labelVals := make(map[string]tftypes.Value, len(labels))
+		for key, label := range labels {
+			labelVals[key] = tftypes.NewValue(tftypes.String, label)
+		}
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"tags":   tftypes.NewValue(listType, tagVals),
+			"labels": tftypes.NewValue(mapType, labelVals),
+		})
+	}
+	unknown := tftypes.UnknownValue
+
+	state := value([]interface{}{"a", "b"}, map[string]interface{}{"x": "1"})
+	plan := value([]interface{}{"a", "c", unknown}, map[string]interface{}{"x": "1", "y": unknown})
+	var diags []*tfprotov6.Diagnostic
+	var requiresReplace []*tftypes.AttributePath
+	got, err := tftypes.Transform(plan, runAttributePlanModifiers(context.Background(), resourceSchema, plan, state, plan, &diags, &requiresReplace, nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", diags)
+	}
+	expectedPlan := value([]interface{}{"a", "c", "default"}, map[string]interface{}{"x": "1", "y": "default"})
+	if diff := cmp.Diff(expectedPlan, got); diff != "" {
+		t.Errorf("Unexpected diff in plan (+wanted, -got): %s", diff)
+	}
+	expectedReplace := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(1),
+	}
+	if diff := cmp.Diff(expectedReplace, requiresReplace); diff != "" {
+		t.Errorf("Unexpected diff in requires replace (+wanted, -got): %s", diff)
+	}
+	sort.Strings(paths)
+	expectedPaths := []string{
+		`AttributeName("labels").ElementKeyString("x")`,
+		`AttributeName("labels").ElementKeyString("y")`,
+		`AttributeName("tags").ElementKeyInt(0)`,
+		`AttributeName("tags").ElementKeyInt(1)`,
+		`AttributeName("tags").ElementKeyInt(2)`,
+	}
+	if diff := cmp.Diff(expectedPaths, paths); diff != "" {
+		t.Errorf("Unexpected diff in modified paths (+wanted, -got): %s", diff)
+	}
+}
+
+// testSharedDiagnosticModifier returns the same diagnostic every time it
+// runs.
+type testSharedDiagnosticModifier struct {
+	diag *tfprotov6.Diagnostic
+}
+
+func (m testSharedDiagnosticModifier) Description(_ context.Context) string {
+	return "Always warns."
+}
+
+func (m testSharedDiagnosticModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m testSharedDiagnosticModifier) Modify(_ context.Context, _ schema.ModifyAttributePlanRequest, resp *schema.ModifyAttributePlanResponse) {
+	resp.Diagnostics = append(resp.Diagnostics, m.diag)
+}
+
+func TestRunAttributePlanModifiers_sharedDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	shared := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "Deprecated value",
+	}
+	modifier := testSharedDiagnosticModifier{diag: shared}
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"one": {
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []schema.AttributePlanModifier{modifier},
+			},
+			"two": {
+				Type:          types.StringType,
+				Optional:      true,
+				PlanModifiers: []schema.AttributePlanModifier{modifier},
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	plan := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"one": tftypes.NewValue(tftypes.String, "hello"),
+		"two": tftypes.NewValue(tftypes.String, "world"),
+	})
+
+	var diags []*tfprotov6.Diagnostic
+	var requiresReplace []*tftypes.AttributePath
+	_, err := tftypes.Transform(plan, runAttributePlanModifiers(context.Background(), resourceSchema, plan, tftypes.NewValue(typ, nil), plan, &diags, &requiresReplace, nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	sort.Slice(diags, func(i, j int) bool {
+		return diags[i].Attribute.String() < diags[j].Attribute.String()
+	})
+	expected := []*tfprotov6.Diagnostic{
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Deprecated value",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("one"),
+		},
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Deprecated value",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("two"),
+		},
+	}
+	if diff := cmp.Diff(expected, diags); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+	if shared.Attribute != nil {
+		t.Errorf("Expected the modifier's diagnostic to be unchanged, got attribute %s", shared.Attribute)
+	}
+}
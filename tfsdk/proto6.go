@@ -0,0 +1,27 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto6"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// SchemaToProto6 returns the *tfprotov6.Schema the framework sends to
+// Terraform for `s`. It is meant for tooling that needs the protocol
+// representation of a schema, like muxers, documentation generators, and test
+// harnesses. At least one attribute must be set in the schema, or an error
+// will be returned.
+func SchemaToProto6(ctx context.Context, s schema.Schema) (*tfprotov6.Schema, error) {
+	return proto6.Schema(ctx, s)
+}
+
+// ProviderSchemaToProto6 returns the response the framework sends to
+// Terraform when it asks for the schemas of `p`, including the schemas of all
+// its resources and data sources. Any problems retrieving or converting the
+// schemas are returned as diagnostics in the response.
+func ProviderSchemaToProto6(ctx context.Context, p Provider) *tfprotov6.GetProviderSchemaResponse {
+	return providerSchemaResponse(ctx, p)
+}
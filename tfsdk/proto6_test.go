@@ -0,0 +1,46 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestSchemaToProto6(t *testing.T) {
+	t.Parallel()
+
+	s, diags := testServeResourceTypeThree{}.GetSchema(context.Background())
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	got, err := SchemaToProto6(context.Background(), s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(testServeResourceTypeThreeSchema, got); diff != "" {
+		t.Errorf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
+
+func TestProviderSchemaToProto6(t *testing.T) {
+	t.Parallel()
+
+	got := ProviderSchemaToProto6(context.Background(), new(testServeProvider))
+	expected := &tfprotov6.GetProviderSchemaResponse{
+		Provider: testServeProviderProviderSchema,
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"test_one":   testServeResourceTypeOneSchema,
+			"test_two":   testServeResourceTypeTwoSchema,
+			"test_three": testServeResourceTypeThreeSchema,
+		},
+		DataSourceSchemas: map[string]*tfprotov6.Schema{
+			"test_one": testServeDataSourceTypeOneSchema,
+			"test_two": testServeDataSourceTypeTwoSchema,
+		},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
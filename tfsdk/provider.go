@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/schema"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Provider is the core interface that all Terraform providers must implement.
@@ -40,3 +41,36 @@ type ProviderWithProviderMeta interface {
 	// GetMetaSchema returns the provider meta schema.
 	GetMetaSchema(context.Context) (schema.Schema, []*tfprotov6.Diagnostic)
 }
+
+// ProviderWithStateTransform is a provider that transforms the state of all
+// of its resources where it crosses between Terraform and the framework, for
+// example to encrypt sensitive attributes before Terraform stores them.
+//
+// DecodeState is called with each non-null resource state, plan, or
+// proposed new state the framework receives from Terraform, before it's
+// used, and EncodeState is called with each non-null resource state or plan
+// before it's returned to Terraform. Both must return a value of the same
+// type as the value they're given, and DecodeState must reverse
+// EncodeState. Terraform compares planned values with the values after
+// apply, so EncodeState must always return the same encoded value for the
+// same value.
+//
+// Only the values of computed-only attributes, those that are Computed but
+// neither Optional nor Required, are taken from the values EncodeState and
+// DecodeState return. Terraform requires the plan and state to hold the
+// configured values of every other attribute exactly as they were
+// configured, so those are never transformed.
+//
+// Upgrading state doesn't change any values, so it passes encoded state
+// through without decoding it.
+type ProviderWithStateTransform interface {
+	Provider
+
+	// EncodeState returns the value Terraform should store for the
+	// state of a resource of type `typeName`.
+	EncodeState(ctx context.Context, typeName string, state tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic)
+
+	// DecodeState returns the state of a resource of type `typeName`
+	// from the value Terraform stored for it.
+	DecodeState(ctx context.Context, typeName string, state tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic)
+}
@@ -0,0 +1,124 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// applyProviderDefaults returns `config`, the provider's configuration, with
+// the value of each top-level attribute that is null replaced by the value of
+// its first environment variable that is set, or by its default, as described
+// by schema.Attribute.EnvVars and schema.Attribute.Default. `lookupEnv` looks
+// up environment variables, like os.LookupEnv.
+func applyProviderDefaults(ctx context.Context, providerSchema schema.Schema, config tftypes.Value, lookupEnv func(string) (string, bool)) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	if config.IsNull() || !config.IsKnown() {
+		return config, nil
+	}
+	var attrs map[string]tftypes.Value
+	if err := config.As(&attrs); err != nil {
+		return config, []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error applying provider defaults",
+				Detail:   "An unexpected error was encountered reading the provider configuration. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			},
+		}
+	}
+
+	var diags []*tfprotov6.Diagnostic
+	changed := false
+	for _, name := range order.Keys(providerSchema.Attributes) {
+		attribute := providerSchema.Attributes[name]
+		if len(attribute.EnvVars) == 0 && attribute.Default == nil {
+			continue
+		}
+		val, ok := attrs[name]
+		if !ok || !val.IsNull() {
+			continue
+		}
+		path := tftypes.NewAttributePath().WithAttributeName(name)
+		typ := val.Type()
+		newVal, diag := providerDefault(ctx, attribute, typ, lookupEnv)
+		if diag != nil {
+			diag.Attribute = path
+			diags = append(diags, diag)
+			continue
+		}
+		if newVal == nil {
+			continue
+		}
+		attrs[name] = *newVal
+		changed = true
+	}
+	if !changed {
+		return config, diags
+	}
+	return tftypes.NewValue(config.Type(), attrs), diags
+}
+
+// providerDefault returns the value `attribute`, of the Terraform type `typ`,
+// defaults to, or nil if it has no default.
+func providerDefault(ctx context.Context, attribute schema.Attribute, typ tftypes.Type, lookupEnv func(string) (string, bool)) (*tftypes.Value, *tfprotov6.Diagnostic) {
+	for _, envVar := range attribute.EnvVars {
+		s, ok := lookupEnv(envVar)
+		if !ok || s == "" {
+			continue
+		}
+		raw, err := parseEnvValue(typ, s)
+		if err != nil {
+			return nil, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Invalid environment variable",
+				Detail:   fmt.Sprintf("The %s environment variable, used when this attribute isn't set, has an invalid value: %s.", envVar, err),
+			}
+		}
+		val := tftypes.NewValue(typ, raw)
+		return &val, nil
+	}
+	if attribute.Default == nil {
+		return nil, nil
+	}
+	raw, err := attribute.Default.ToTerraformValue(ctx)
+	var val tftypes.Value
+	if err == nil {
+		val, err = reflect.NewTerraformValue(typ, raw)
+	}
+	if err != nil {
+		return nil, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Invalid default value",
+			Detail:   "The default value of this attribute couldn't be used. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		}
+	}
+	return &val, nil
+}
+
+// parseEnvValue returns `s`, the value of an environment variable, as a value
+// of the Terraform type `typ`.
+func parseEnvValue(typ tftypes.Type, s string) (interface{}, error) {
+	switch {
+	case typ.Is(tftypes.String):
+		return s, nil
+	case typ.Is(tftypes.Number):
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("%q isn't a number", s)
+		}
+		return f, nil
+	case typ.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q isn't a bool", s)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("attributes of type %s can't be set from environment variables", typ)
+}
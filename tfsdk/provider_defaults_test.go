@@ -0,0 +1,141 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestApplyProviderDefaults(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": {
+				Type:     types.StringType,
+				Optional: true,
+				EnvVars:  []string{"EXAMPLE_REGION", "EXAMPLE_DEFAULT_REGION"},
+				Default:  types.String{Value: "us-east-1"},
+			},
+			"retries": {
+				Type:     types.NumberType,
+				Optional: true,
+				EnvVars:  []string{"EXAMPLE_RETRIES"},
+			},
+			"insecure": {
+				Type:     types.BoolType,
+				Optional: true,
+				Default:  types.Bool{Value: false},
+			},
+		},
+	}
+	typ := s.TerraformType(context.Background())
+	config := func(region, retries, insecure interface{}) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"region":   tftypes.NewValue(tftypes.String, region),
+			"retries":  tftypes.NewValue(tftypes.Number, retries),
+			"insecure": tftypes.NewValue(tftypes.Bool, insecure),
+		})
+	}
+
+	type testCase struct {
+		env           map[string]string
+		config        tftypes.Value
+		expected      tftypes.Value
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+	tests := map[string]testCase{
+		"defaults": {
+			config:   config(nil, nil, nil),
+			expected: config("us-east-1", nil, false),
+		},
+		"env": {
+			env: map[string]string{
+				"EXAMPLE_REGION":         "",
+				"EXAMPLE_DEFAULT_REGION": "eu-west-1",
+				"EXAMPLE_RETRIES":        "3",
+			},
+			config:   config(nil, nil, nil),
+			expected: config("eu-west-1", big.NewFloat(3), false),
+		},
+		"configured": {
+			env: map[string]string{
+				"EXAMPLE_REGION": "eu-west-1",
+			},
+			config:   config("ap-south-1", tftypes.UnknownValue, true),
+			expected: config("ap-south-1", tftypes.UnknownValue, true),
+		},
+		"invalid-env": {
+			env: map[string]string{
+				"EXAMPLE_RETRIES": "three",
+			},
+			config:   config(nil, nil, nil),
+			expected: config("us-east-1", nil, false),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Invalid environment variable",
+					Detail:    `The EXAMPLE_RETRIES environment variable, used when this attribute isn't set, has an invalid value: "three" isn't a number.`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName("retries"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lookupEnv := func(name string) (string, bool) {
+				v, ok := tc.env[name]
+				return v, ok
+			}
+			got, diags := applyProviderDefaults(context.Background(), s, tc.config, lookupEnv)
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestApplyProviderDefaults_dynamic(t *testing.T) {
+	t.Parallel()
+
+	defaultVal := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "us-east-1"),
+	})
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"regions": {
+				Type:     types.DynamicType,
+				Optional: true,
+				Default:  types.NewDynamic(defaultVal),
+			},
+		},
+	}
+	typ := s.TerraformType(context.Background())
+	config := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"regions": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+	})
+	expected := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"regions": defaultVal,
+	})
+
+	got, diags := applyProviderDefaults(context.Background(), s, config, func(string) (string, bool) { return "", false })
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", diags)
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
@@ -0,0 +1,157 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NormalizeFunc returns the value that should be stored in state for an
+// attribute, given the value the resource's Read method returned for it
+// (`current`) and the value in the prior state (`prior`). `prior` is null if
+// the prior state has no value at the attribute's path. Returning `prior`
+// keeps the prior value, hiding a difference that isn't a real change.
+type NormalizeFunc func(ctx context.Context, prior, current attr.Value) (attr.Value, error)
+
+// ReadNormalizer applies Normalize to every value matching Expression in the
+// state returned by a resource's Read method, before it's compared with the
+// prior state.
+type ReadNormalizer struct {
+	Expression PathExpression
+	Normalize  NormalizeFunc
+}
+
+// IgnoreCaseChanges returns a ReadNormalizer that keeps the prior value of
+// the string attributes matching `expr` when the value returned by Read only
+// differs from it in case, for APIs that change the case of values.
+func IgnoreCaseChanges(expr PathExpression) ReadNormalizer {
+	return ReadNormalizer{
+		Expression: expr,
+		Normalize: normalizeStrings(func(prior, current string) bool {
+			return strings.EqualFold(prior, current)
+		}),
+	}
+}
+
+// IgnoreSurroundingSpace returns a ReadNormalizer that keeps the prior value
+// of the string attributes matching `expr` when the value returned by Read
+// only differs from it in leading or trailing whitespace, for APIs that pad
+// or trim values.
+func IgnoreSurroundingSpace(expr PathExpression) ReadNormalizer {
+	return ReadNormalizer{
+		Expression: expr,
+		Normalize: normalizeStrings(func(prior, current string) bool {
+			return strings.TrimSpace(prior) == strings.TrimSpace(current)
+		}),
+	}
+}
+
+// normalizeStrings returns a NormalizeFunc that keeps the prior value of a
+// types.String when it and the current value are both known and `equivalent`
+// says they're the same.
+func normalizeStrings(equivalent func(prior, current string) bool) NormalizeFunc {
+	return func(_ context.Context, prior, current attr.Value) (attr.Value, error) {
+		priorString, ok := prior.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("can only normalize types.String values, not %T", prior)
+		}
+		currentString, ok := current.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("can only normalize types.String values, not %T", current)
+		}
+		if priorString.Null || priorString.Unknown || currentString.Null || currentString.Unknown {
+			return current, nil
+		}
+		if equivalent(priorString.Value, currentString.Value) {
+			return prior, nil
+		}
+		return current, nil
+	}
+}
+
+// normalizeReadResult returns `val`, the state returned by a resource's Read
+// method, with each of `normalizers` applied in order. `prior` is the state
+// before Read was called.
+func normalizeReadResult(ctx context.Context, resourceSchema schema.Schema, normalizers []ReadNormalizer, prior, val tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	var diags []*tfprotov6.Diagnostic
+	for _, normalizer := range normalizers {
+		matches, err := pathMatches(ctx, resourceSchema, val, normalizer.Expression)
+		if err != nil {
+			return val, append(diags, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error normalizing read result",
+				Detail:   fmt.Sprintf("An unexpected error was encountered finding the values matching %s. This is always a problem with the provider. Please report the following to the provider developer:\n\n%s", normalizer.Expression, err.Error()),
+			})
+		}
+		replacements := map[string]tftypes.Value{}
+		for _, match := range matches {
+			normalized, err := normalizeMatch(ctx, resourceSchema, normalizer.Normalize, prior, match)
+			if err != nil {
+				diags = append(diags, &tfprotov6.Diagnostic{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Error normalizing read result",
+					Detail:    "An unexpected error was encountered normalizing the value returned by Read. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+					Attribute: match.Path,
+				})
+				continue
+			}
+			replacements[match.Path.String()] = normalized
+		}
+		if diagsHasErrors(diags) {
+			return val, diags
+		}
+		if len(replacements) == 0 {
+			continue
+		}
+		val, err = tftypes.Transform(val, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+			if replacement, ok := replacements[path.String()]; ok {
+				return replacement, nil
+			}
+			return v, nil
+		})
+		if err != nil {
+			return val, append(diags, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error normalizing read result",
+				Detail:   "An unexpected error was encountered updating the state with normalized values. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+		}
+	}
+	return val, diags
+}
+
+// normalizeMatch returns the result of calling `normalize` on the value of
+// `match` and the value at the same path in `prior`.
+func normalizeMatch(ctx context.Context, resourceSchema schema.Schema, normalize NormalizeFunc, prior tftypes.Value, match PathMatch) (tftypes.Value, error) {
+	attrType, err := resourceSchema.AttributeTypeAtPath(match.Path)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("error finding attribute type: %w", err)
+	}
+	priorVal, err := attributeValueAtPath(ctx, attrType, prior, match.Path)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("error retrieving prior value: %w", err)
+	}
+	normalized, err := normalize(ctx, priorVal, match.Value)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if normalized == nil {
+		return tftypes.Value{}, fmt.Errorf("normalizer returned a nil value")
+	}
+	raw, err := normalized.ToTerraformValue(ctx)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("error converting normalized value: %w", err)
+	}
+	val, err := reflect.NewTerraformValue(attrType.TerraformType(ctx), raw)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("normalizer returned an invalid value: %w", err)
+	}
+	return val, nil
+}
@@ -0,0 +1,230 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizeReadResult(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"aliases": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	value := func(name string, aliases ...string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(aliases))
+		for _, alias := range aliases {
+			elems = append(elems, tftypes.NewValue(tftypes.String, alias))
+		}
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":    tftypes.NewValue(tftypes.String, name),
+			"aliases": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elems),
+		})
+	}
+
+	type testCase struct {
+		normalizers   []ReadNormalizer
+		prior         tftypes.Value
+		read          tftypes.Value
+		expected      tftypes.Value
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+
+	tests := map[string]testCase{
+		"case": {
+			normalizers: []ReadNormalizer{
+				IgnoreCaseChanges(NewPathExpression().AttributeName("name")),
+				IgnoreCaseChanges(NewPathExpression().AttributeName("aliases").AnyElementKey()),
+			},
+			prior:    value("Example", "First", "Second"),
+			read:     value("example", "first", "third", "fourth"),
+			expected: value("Example", "First", "third", "fourth"),
+		},
+		"chained": {
+			normalizers: []ReadNormalizer{
+				IgnoreSurroundingSpace(NewPathExpression().AttributeName("name")),
+				IgnoreCaseChanges(NewPathExpression().AttributeName("name")),
+			},
+			prior:    value("Example"),
+			read:     value("EXAMPLE"),
+			expected: value("Example"),
+		},
+		"real-change": {
+			normalizers: []ReadNormalizer{
+				IgnoreCaseChanges(NewPathExpression().AttributeName("name")),
+			},
+			prior:    value("Example"),
+			read:     value("other"),
+			expected: value("other"),
+		},
+		"no-prior": {
+			normalizers: []ReadNormalizer{
+				IgnoreCaseChanges(NewPathExpression().AttributeName("name")),
+			},
+			prior:    tftypes.NewValue(typ, nil),
+			read:     value("example"),
+			expected: value("example"),
+		},
+		"error": {
+			normalizers: []ReadNormalizer{
+				{
+					Expression: NewPathExpression().AttributeName("name"),
+					Normalize: func(_ context.Context, _, _ attr.Value) (attr.Value, error) {
+						return nil, errors.New("boom")
+					},
+				},
+			},
+			prior:    value("Example"),
+			read:     value("example"),
+			expected: value("example"),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Error normalizing read result",
+					Detail:    "An unexpected error was encountered normalizing the value returned by Read. This is always a problem with the provider. Please report the following to the provider developer:\n\nboom",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("name"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := normalizeReadResult(context.Background(), resourceSchema, tc.normalizers, tc.prior, tc.read)
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff in state (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeReadResult_dynamic(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"settings": {
+				Type:     types.DynamicType,
+				Optional: true,
+			},
+		},
+	}
+	typ := resourceSchema.TerraformType(context.Background())
+	value := func(settings tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"settings": settings,
+		})
+	}
+	prior := value(tftypes.NewValue(tftypes.String, "prior"))
+	read := value(tftypes.NewValue(tftypes.String, "read"))
+	normalizers := []ReadNormalizer{
+		{
+			Expression: NewPathExpression().AttributeName("settings"),
+			Normalize: func(_ context.Context, prior, _ attr.Value) (attr.Value, error) {
+				return prior, nil
+			},
+		},
+	}
+
+	got, diags := normalizeReadResult(context.Background(), resourceSchema, normalizers, prior, read)
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", diags)
+	}
+	if diff := cmp.Diff(got, prior); diff != "" {
+		t.Errorf("Unexpected diff in state (+wanted, -got): %s", diff)
+	}
+}
+
+type testReadNormalizerResourceType struct {
+	testStateTransformResourceType
+}
+
+func (rt testReadNormalizerResourceType) NewResource(_ context.Context, _ Provider) (Resource, []*tfprotov6.Diagnostic) {
+	return testReadNormalizerResource{testStateTransformResource{readSecrets: rt.readSecrets}}, nil
+}
+
+// testReadNormalizerResource fails to normalize the state Read returns.
+type testReadNormalizerResource struct {
+	testStateTransformResource
+}
+
+func (r testReadNormalizerResource) ReadNormalizers(_ context.Context) []ReadNormalizer {
+	return []ReadNormalizer{
+		{
+			Expression: NewPathExpression().AttributeName("name"),
+			Normalize: func(_ context.Context, _, _ attr.Value) (attr.Value, error) {
+				return nil, errors.New("boom")
+			},
+		},
+	}
+}
+
+type testReadNormalizerProvider struct {
+	*testServeProvider
+	readSecrets *[]string
+}
+
+func (p testReadNormalizerProvider) GetResources(_ context.Context) (map[string]ResourceType, []*tfprotov6.Diagnostic) {
+	return map[string]ResourceType{
+		"test_normalized": testReadNormalizerResourceType{testStateTransformResourceType{readSecrets: p.readSecrets}},
+	}, nil
+}
+
+func TestServerReadResourceNormalizeError(t *testing.T) {
+	t.Parallel()
+
+	var readSecrets []string
+	testServer := &server{
+		p: testReadNormalizerProvider{
+			testServeProvider: &testServeProvider{},
+			readSecrets:       &readSecrets,
+		},
+	}
+	resourceSchema, _ := testStateTransformResourceType{}.GetSchema(context.Background())
+	typ := resourceSchema.TerraformType(context.Background())
+	stateDV, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"name":   tftypes.NewValue(tftypes.String, "example"),
+		"secret": tftypes.NewValue(tftypes.String, "hunter2"),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, err := testServer.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     "test_normalized",
+		CurrentState: &stateDV,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !diagsHasErrors(got.Diagnostics) {
+		t.Errorf("Expected an error diagnostic, got %+v", got.Diagnostics)
+	}
+	if diff := cmp.Diff(got.NewState, &stateDV); diff != "" {
+		t.Errorf("Expected the current state to be kept (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,35 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateReadOnlyAttributes returns an error diagnostic for each attribute
+// in `config` that is computed only, and so can't be configured, but has a
+// value anyway. Terraform rejects these itself, so they only reach the
+// provider through bugs in other tooling, but catching them here names the
+// attribute at fault, rather than letting them fail confusingly later.
+func validateReadOnlyAttributes(s schema.Schema, config tftypes.Value) ([]*tfprotov6.Diagnostic, error) {
+	var diags []*tfprotov6.Diagnostic
+	err := walkAttributeValues(s, config, func(path *tftypes.AttributePath, attribute schema.Attribute, val tftypes.Value) (bool, error) {
+		if !attribute.Computed || attribute.Optional || attribute.Required {
+			// only nested attributes have attributes of their own
+			// to check
+			return attribute.Attributes != nil, nil
+		}
+		if !val.IsNull() {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity:  tfprotov6.DiagnosticSeverityError,
+				Summary:   "Read-only attribute configured",
+				Detail:    "This attribute is set by the provider, and can't be set in configuration. Remove it from the configuration.",
+				Attribute: path,
+			})
+		}
+		// everything inside a read-only attribute is read-only too,
+		// and was reported with it
+		return false, nil
+	})
+	return diags, err
+}
@@ -0,0 +1,119 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateReadOnlyAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"size": {
+				Type:     types.NumberType,
+				Optional: true,
+				Computed: true,
+			},
+			"addresses": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Computed: true,
+			},
+			"rules": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"port": {
+						Type:     types.NumberType,
+						Required: true,
+					},
+					"rule_id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}.WithIDAttribute()
+	typ := s.TerraformType(context.Background())
+	rulesType := typ.(tftypes.Object).AttributeTypes["rules"]
+	ruleType := rulesType.(tftypes.List).ElementType
+	addressesType := tftypes.List{ElementType: tftypes.String}
+	config := func(id, addresses interface{}, ruleID interface{}) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"id":        tftypes.NewValue(tftypes.String, id),
+			"name":      tftypes.NewValue(tftypes.String, "example"),
+			"size":      tftypes.NewValue(tftypes.Number, 2),
+			"addresses": tftypes.NewValue(addressesType, addresses),
+			"rules": tftypes.NewValue(rulesType, []tftypes.Value{
+				tftypes.NewValue(ruleType, map[string]tftypes.Value{
+					"port":    tftypes.NewValue(tftypes.Number, 80),
+					"rule_id": tftypes.NewValue(tftypes.String, ruleID),
+				}),
+			}),
+		})
+	}
+	readOnlyDiag := func(path *tftypes.AttributePath) *tfprotov6.Diagnostic {
+		return &tfprotov6.Diagnostic{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Read-only attribute configured",
+			Detail:    "This attribute is set by the provider, and can't be set in configuration. Remove it from the configuration.",
+			Attribute: path,
+		}
+	}
+
+	type testCase struct {
+		config        tftypes.Value
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+	tests := map[string]testCase{
+		"valid": {
+			config: config(nil, nil, nil),
+		},
+		"read-only-set": {
+			config: config("abc", []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "10.0.0.1"),
+			}, tftypes.UnknownValue),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				readOnlyDiag(tftypes.NewAttributePath().WithAttributeName("addresses")),
+				readOnlyDiag(tftypes.NewAttributePath().WithAttributeName("id")),
+				readOnlyDiag(tftypes.NewAttributePath().WithAttributeName("rules").WithElementKeyInt(0).WithAttributeName("rule_id")),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := validateReadOnlyAttributes(s, tc.config)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			// the order diagnostics are returned in depends on the
+			// order attributes are walked in, so compare them keyed
+			// by path
+			gotByPath := map[string]*tfprotov6.Diagnostic{}
+			for _, diag := range got {
+				gotByPath[diag.Attribute.String()] = diag
+			}
+			expectedByPath := map[string]*tfprotov6.Diagnostic{}
+			for _, diag := range tc.expectedDiags {
+				expectedByPath[diag.Attribute.String()] = diag
+			}
+			if diff := cmp.Diff(gotByPath, expectedByPath); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
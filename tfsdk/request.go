@@ -1,5 +1,7 @@
 package tfsdk
 
+import "github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
 // ConfigureProviderRequest represents a request containing the values the user
 // specified for the provider configuration block, along with other runtime
 // information from Terraform or the Plugin SDK. An instance of this request
@@ -16,6 +18,10 @@ type ConfigureProviderRequest struct {
 	// that's implementing the Provider interface, for use in later
 	// resource CRUD operations.
 	Config Config
+
+	// Raw is the request's values as Terraform sent them, before they
+	// were decoded using the schema.
+	Raw RawRequest
 }
 
 // CreateResourceRequest represents a request for the provider to create a
@@ -34,6 +40,10 @@ type CreateResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// Raw is the request's values as Terraform sent them, before they
+	// were decoded using the schema.
+	Raw RawRequest
 }
 
 // ReadResourceRequest represents a request for the provider to read a
@@ -47,6 +57,10 @@ type ReadResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// Raw is the request's values as Terraform sent them, before they
+	// were decoded using the schema.
+	Raw RawRequest
 }
 
 // UpdateResourceRequest represents a request for the provider to update a
@@ -69,6 +83,10 @@ type UpdateResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// Raw is the request's values as Terraform sent them, before they
+	// were decoded using the schema.
+	Raw RawRequest
 }
 
 // DeleteResourceRequest represents a request for the provider to delete a
@@ -81,6 +99,73 @@ type DeleteResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// Raw is the request's values as Terraform sent them, before they
+	// were decoded using the schema.
+	Raw RawRequest
+}
+
+// ImportResourceStateRequest represents a request for the provider to import
+// a resource into state. An instance of this request struct is supplied as an
+// argument to the resource's ImportState function.
+type ImportResourceStateRequest struct {
+	// ID is the identifier the practitioner supplied to the import
+	// command. Each resource decides and documents the format of the ID,
+	// and uses it to find the resource to import.
+	ID string
+}
+
+// ModifyResourcePlanRequest represents a request for the provider to modify
+// the planned new state of a resource. An instance of this request struct is
+// supplied as an argument to the resource's ModifyPlan function.
+type ModifyResourcePlanRequest struct {
+	// Config is the configuration the user supplied for the resource.
+	//
+	// This configuration may contain unknown values if a user uses
+	// interpolation or other functionality that would prevent Terraform
+	// from knowing the value at request time.
+	Config Config
+
+	// State is the current state of the resource. It is null when the
+	// resource is being created.
+	State State
+
+	// Plan is the planned new state for the resource, after the
+	// attributes' plan modifiers have run. It is null when the resource
+	// is being destroyed.
+	Plan Plan
+
+	// ProviderMeta is metadata from the provider_meta block of the module.
+	ProviderMeta Config
+
+	// Action is what Terraform will do to the resource if the plan is
+	// applied.
+	Action PlanAction
+
+	// Raw is the request's values as Terraform sent them, before they
+	// were decoded using the schema.
+	Raw RawRequest
+}
+
+// IsCreate returns true if the resource is being created.
+func (r ModifyResourcePlanRequest) IsCreate() bool {
+	return r.Action == PlanActionCreate
+}
+
+// IsUpdate returns true if the resource is being updated in place.
+func (r ModifyResourcePlanRequest) IsUpdate() bool {
+	return r.Action == PlanActionUpdate
+}
+
+// IsReplace returns true if the resource is being destroyed and created
+// again.
+func (r ModifyResourcePlanRequest) IsReplace() bool {
+	return r.Action == PlanActionReplace
+}
+
+// IsDestroy returns true if the resource is being destroyed.
+func (r ModifyResourcePlanRequest) IsDestroy() bool {
+	return r.Action == PlanActionDestroy
 }
 
 // ReadDataSourceRequest represents a request for the provider to read a data
@@ -97,4 +182,28 @@ type ReadDataSourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// Raw is the request's values as Terraform sent them, before they
+	// were decoded using the schema.
+	Raw RawRequest
+}
+
+// RawRequest holds the values of a request exactly as Terraform sent them,
+// before the framework decoded them using the schema, for providers and
+// middleware that need data the schema can't represent. Most providers should
+// use the request's Config, Plan, and State instead. Values Terraform didn't
+// send, or that the request doesn't have, are nil.
+type RawRequest struct {
+	// Config is the configuration.
+	Config *tfprotov6.DynamicValue
+
+	// State is the prior state of the resource.
+	State *tfprotov6.DynamicValue
+
+	// Plan is the planned state of the resource. For ModifyPlan, it's
+	// the new state Terraform proposed, before any plan modifiers ran.
+	Plan *tfprotov6.DynamicValue
+
+	// ProviderMeta is the provider_meta block of the module.
+	ProviderMeta *tfprotov6.DynamicValue
 }
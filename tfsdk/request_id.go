@@ -0,0 +1,72 @@
+package tfsdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+type requestIDKey struct{}
+
+// RequestID returns the unique ID the framework generated for the RPC `ctx`
+// belongs to, or an empty string if there isn't one. Including it in log
+// lines and error reports lets them be matched up with the framework's own
+// log lines for the same request.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// withRequestID returns a copy of `ctx` with a new request ID.
+func withRequestID(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, newRequestID())
+}
+
+// newRequestID returns a random, UUID-formatted ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// this should never happen, and a request ID isn't worth
+		// failing the request over
+		return ""
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	s := hex.EncodeToString(b)
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+// logf logs a message, prefixed with the request ID of `ctx` if it has one.
+func logf(ctx context.Context, level, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if id := RequestID(ctx); id != "" {
+		msg = "[request_id=" + id + "] " + msg
+	}
+	log.Printf("[%s] %s", level, msg)
+}
+
+// addRequestIDToDiagnostics appends the request ID of `ctx` to the detail of
+// each error diagnostic in `diags`, if the server was configured to. The
+// diagnostics are copied rather than modified, as the provider may reuse
+// them.
+func (s *server) addRequestIDToDiagnostics(ctx context.Context, diags *[]*tfprotov6.Diagnostic) {
+	id := RequestID(ctx)
+	if !s.requestIDInDiagnostics || id == "" {
+		return
+	}
+	for i, diag := range *diags {
+		if diag == nil || diag.Severity != tfprotov6.DiagnosticSeverityError {
+			continue
+		}
+		annotated := *diag
+		if annotated.Detail != "" {
+			annotated.Detail += "\n\n"
+		}
+		annotated.Detail += "Request ID: " + id
+		(*diags)[i] = &annotated
+	}
+}
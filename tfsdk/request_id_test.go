@@ -0,0 +1,83 @@
+package tfsdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	if id := RequestID(context.Background()); id != "" {
+		t.Errorf("Expected no request ID, got %q", id)
+	}
+
+	s := &server{}
+	first := RequestID(s.registerContext(context.Background()))
+	second := RequestID(s.registerContext(context.Background()))
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !uuid.MatchString(first) {
+		t.Errorf("Expected a UUID request ID, got %q", first)
+	}
+	if first == second {
+		t.Errorf("Expected each request to get a different ID, got %q twice", first)
+	}
+}
+
+func TestAddRequestIDToDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "example-id")
+	original := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "API error",
+		Detail:   "The API returned a 500 error.",
+	}
+	warning := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "Deprecated",
+	}
+
+	type testCase struct {
+		enabled  bool
+		expected []*tfprotov6.Diagnostic
+	}
+
+	tests := map[string]testCase{
+		"disabled": {
+			expected: []*tfprotov6.Diagnostic{original, warning},
+		},
+		"enabled": {
+			enabled: true,
+			expected: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "API error",
+					Detail:   "The API returned a 500 error.\n\nRequest ID: example-id",
+				},
+				warning,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &server{requestIDInDiagnostics: tc.enabled}
+			diags := []*tfprotov6.Diagnostic{original, warning}
+			s.addRequestIDToDiagnostics(ctx, &diags)
+			if diff := cmp.Diff(diags, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+			if original.Detail != "The API returned a 500 error." {
+				t.Errorf("Expected the original diagnostic to be left unchanged, got detail %q", original.Detail)
+			}
+		})
+	}
+}
@@ -43,3 +43,157 @@ type Resource interface {
 	// values may be read from the DeleteResourceRequest.
 	Delete(context.Context, DeleteResourceRequest, *DeleteResourceResponse)
 }
+
+// ResourceTypeWithAdditiveStateUpgrades is a ResourceType that allows the
+// framework to upgrade prior state automatically. Automatic upgrades only
+// succeed when every change between the prior schema version and the current
+// schema is the addition of optional or computed attributes; the new
+// attributes are set to null in the upgraded state.
+type ResourceTypeWithAdditiveStateUpgrades interface {
+	ResourceType
+
+	// AdditiveStateUpgrades returns true if the framework should upgrade
+	// prior state by filling any attributes missing from it with null
+	// values, rather than passing the prior state through unchanged.
+	AdditiveStateUpgrades(context.Context) bool
+}
+
+// ResourceTypeWithSkipRead is a ResourceType that can opt out of refreshing
+// its state. This is meant for resources backed by APIs that are expensive to
+// read, where drift detection is handled somewhere other than Terraform.
+type ResourceTypeWithSkipRead interface {
+	ResourceType
+
+	// SkipRead returns true if the framework should not call the
+	// resource's Read method, and should instead return the current state
+	// unchanged.
+	SkipRead(context.Context) bool
+}
+
+// ResourceTypeWithCreateOnly is a ResourceType that can declare that its
+// resources can't be updated in place. This is meant for resources backed by
+// APIs that have no update operation.
+type ResourceTypeWithCreateOnly interface {
+	ResourceType
+
+	// CreateOnly returns true if every change to the resource requires it
+	// to be replaced. The framework will plan a replacement whenever a
+	// configured attribute changes, and the resource's Update method will
+	// never be called. If Terraform asks to update the resource anyway,
+	// the framework returns an error and keeps the prior state.
+	CreateOnly(context.Context) bool
+}
+
+// ResourceTypeWithSkipDelete is a ResourceType that can declare that its
+// resources can't be deleted. This is meant for resources backed by APIs that
+// have no delete operation, or for which deletion is too dangerous to
+// automate.
+type ResourceTypeWithSkipDelete interface {
+	ResourceType
+
+	// SkipDelete returns true if the framework should not call the
+	// resource's Delete method when the resource is destroyed, and should
+	// instead only remove it from state, warning the practitioner that it
+	// still exists.
+	SkipDelete(context.Context) bool
+}
+
+// ResourceTypeWithTimeouts is a ResourceType whose operations have default
+// timeouts. The framework adds a TimeoutsAttributeName attribute to the
+// resource's schema, letting practitioners override the timeout of each
+// operation with a default. The framework cancels the context passed to
+// Create, Read, Update, and Delete when their timeouts pass, and returns an
+// error diagnostic if they return after that.
+//
+// Because the attribute is part of the schema, structs the resource passes
+// to Get must have a field for it, like:
+//
+//	Timeouts types.Object `tfsdk:"timeouts"`
+type ResourceTypeWithTimeouts interface {
+	ResourceType
+
+	// Timeouts returns the default timeouts for the resource's
+	// operations.
+	Timeouts(context.Context) Timeouts
+}
+
+// ResourceTypeWithStrictState is a ResourceType that can ask the framework to
+// check prior state for attributes its schema doesn't have, like those left
+// behind by editing state by hand or by removing attributes from the schema.
+type ResourceTypeWithStrictState interface {
+	ResourceType
+
+	// StrictState returns true if the framework should remove any
+	// attributes the schema doesn't have from prior state when upgrading
+	// it, returning a warning diagnostic for each one, rather than
+	// passing them through to fail later.
+	StrictState(context.Context) bool
+}
+
+// ResourceWithImportState is a Resource that can be imported into state with
+// the `terraform import` command. Resources that don't implement it return an
+// error when practitioners try to import them.
+type ResourceWithImportState interface {
+	Resource
+
+	// ImportState is called when the practitioner imports the resource.
+	// The ID supplied by the practitioner should be read from the
+	// ImportResourceStateRequest and the imported state set on the
+	// ImportResourceStateResponse.
+	ImportState(context.Context, ImportResourceStateRequest, *ImportResourceStateResponse)
+}
+
+// ResourceWithModifyPlan is a Resource that can modify its planned new state
+// beyond what the plan modifiers on its attributes can do, like setting
+// attributes based on each other or requiring replacement based on the
+// values of several attributes.
+type ResourceWithModifyPlan interface {
+	Resource
+
+	// ModifyPlan is called when Terraform plans a change to the
+	// resource, after the plan modifiers on its attributes have run. It
+	// is also called when the resource is being destroyed, so the
+	// resource can return diagnostics about it, but changes to the plan
+	// are ignored then.
+	ModifyPlan(context.Context, ModifyResourcePlanRequest, *ModifyResourcePlanResponse)
+}
+
+// ResourceWithReadNormalizers is a Resource whose Read results need cleaning
+// up before they're compared with the prior state, like when its API changes
+// the case of values or pads them with whitespace. The framework applies the
+// normalizers in order to the state returned by Read.
+type ResourceWithReadNormalizers interface {
+	Resource
+
+	// ReadNormalizers returns the normalizers to apply to the state
+	// returned by Read.
+	ReadNormalizers(context.Context) []ReadNormalizer
+}
+
+// ResourceTypeWithUnknownHandling is a ResourceType that chooses how the
+// framework handles unknown values in the config passed to Create and
+// Update, rather than leaving it to each call to Get. Terraform normally
+// only applies fully known configs, but unknown values can reach a resource
+// through bugs in other providers or in Terraform itself.
+//
+// Deferring the change until the values are known isn't offered, as the
+// version of the protocol the framework uses has no way to do that.
+type ResourceTypeWithUnknownHandling interface {
+	ResourceType
+
+	// UnknownHandling returns the policy for unknown values in the
+	// config.
+	UnknownHandling(context.Context) UnknownHandling
+}
+
+// ResourceTypeWithSDKv2State is a ResourceType that was previously built with
+// terraform-plugin-sdk v2, and whose prior state the framework should convert
+// from the formats SDKv2 used, rather than every resource needing its own
+// state upgrader. The conversion runs before any other state upgrade.
+type ResourceTypeWithSDKv2State interface {
+	ResourceType
+
+	// SDKv2State returns the options for converting state written by
+	// SDKv2.
+	SDKv2State(context.Context) SDKv2StateOptions
+}
@@ -0,0 +1,80 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// skipsDelete returns true if `resourceType` declares that its resources
+// can't be deleted.
+func skipsDelete(ctx context.Context, resourceType ResourceType) bool {
+	skipper, ok := resourceType.(ResourceTypeWithSkipDelete)
+	return ok && skipper.SkipDelete(ctx)
+}
+
+// skipDeleteDiagnostic returns the warning shown to practitioners when a
+// resource that can't be deleted is destroyed. `action` describes what
+// happens to the resource, like "will only be removed".
+func skipDeleteDiagnostic(typeName, action string) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+		Summary:  "Resource not deleted",
+		Detail:   fmt.Sprintf("The %q resource can't be deleted by Terraform, so it %s from the Terraform state. It will continue to exist, and must be deleted outside of Terraform if it's no longer needed.", typeName, action),
+	}
+}
+
+// createOnlyUpdateDiagnostic returns the error returned when Terraform asks
+// to update a resource that can't be updated in place. The framework plans a
+// replacement for every change to these resources, so this only happens if
+// the plan was changed after the framework produced it.
+func createOnlyUpdateDiagnostic(typeName string) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Resource can't be updated",
+		Detail:   fmt.Sprintf("The %q resource can't be updated in place, but Terraform planned an update for it. This is always a problem with the provider. Please report this to the provider developer.", typeName),
+	}
+}
+
+// changedConfiguredAttributes returns the paths of the top-level attributes
+// whose value in `plan` differs from their value in `priorState`, sorted by
+// name. Computed attributes that aren't set in `config` are ignored, as the
+// provider, not the practitioner, controls their values, and so is the
+// TimeoutsAttributeName attribute.
+func changedConfiguredAttributes(resourceSchema schema.Schema, config, priorState, plan tftypes.Value) ([]*tftypes.AttributePath, error) {
+	var configAttrs, priorAttrs, planAttrs map[string]tftypes.Value
+	if err := config.As(&configAttrs); err != nil {
+		return nil, fmt.Errorf("error reading config: %w", err)
+	}
+	if err := priorState.As(&priorAttrs); err != nil {
+		return nil, fmt.Errorf("error reading prior state: %w", err)
+	}
+	if err := plan.As(&planAttrs); err != nil {
+		return nil, fmt.Errorf("error reading plan: %w", err)
+	}
+	var names []string
+	for name, attribute := range resourceSchema.Attributes {
+		if attribute.Computed && configAttrs[name].IsNull() {
+			continue
+		}
+		// changing how long operations can take never requires
+		// replacing the resource
+		if name == TimeoutsAttributeName {
+			continue
+		}
+		if planAttrs[name].Equal(priorAttrs[name]) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var paths []*tftypes.AttributePath
+	for _, name := range names {
+		paths = append(paths, tftypes.NewAttributePath().WithAttributeName(name))
+	}
+	return paths, nil
+}
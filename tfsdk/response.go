@@ -72,6 +72,21 @@ type CreateResourceResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics []*tfprotov6.Diagnostic
+
+	// checkpoint is the State.Raw recorded by the last call to
+	// Checkpoint, or nil if it wasn't called.
+	checkpoint *tftypes.Value
+}
+
+// Checkpoint records the current State as the resource's partial state. If
+// Create later returns error diagnostics, the most recently recorded State is
+// sent to Terraform alongside them, so remote objects already created by
+// Create are kept in state instead of leaking. Unknown values in the recorded
+// State are sent as null. If Checkpoint isn't called, the prior state is sent
+// with error diagnostics, which is null for Create.
+func (r *CreateResourceResponse) Checkpoint() {
+	raw := r.State.Raw
+	r.checkpoint = &raw
 }
 
 // AddWarning appends a warning diagnostic to the response. If the warning
@@ -188,6 +203,21 @@ type UpdateResourceResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics []*tfprotov6.Diagnostic
+
+	// checkpoint is the State.Raw recorded by the last call to
+	// Checkpoint, or nil if it wasn't called.
+	checkpoint *tftypes.Value
+}
+
+// Checkpoint records the current State as the resource's partial state. If
+// Update later returns error diagnostics, the most recently recorded State is
+// sent to Terraform alongside them, so remote objects already created by
+// Update are kept in state instead of leaking. Unknown values in the recorded
+// State are sent as null. If Checkpoint isn't called, the prior state is sent
+// with error diagnostics.
+func (r *UpdateResourceResponse) Checkpoint() {
+	raw := r.State.Raw
+	r.checkpoint = &raw
 }
 
 // AddWarning appends a warning diagnostic to the response. If the warning
@@ -290,6 +320,132 @@ func (r *DeleteResourceResponse) AddAttributeError(attributePath *tftypes.Attrib
 	})
 }
 
+// ImportResourceStateResponse represents a response to an
+// ImportResourceStateRequest. An instance of this response struct is supplied
+// as an argument to the resource's ImportState function, in which the
+// provider should set values on the ImportResourceStateResponse as
+// appropriate.
+type ImportResourceStateResponse struct {
+	// State is the state of the imported resource. It is pre-populated
+	// with every attribute set to null, and should be set during the
+	// resource's ImportState operation. Terraform will call the
+	// resource's Read function with this state after the import, so it
+	// only needs to hold enough information for Read to find the
+	// resource, usually just its ID.
+	State State
+
+	// Diagnostics report errors or warnings related to importing the
+	// resource. An empty slice indicates a successful operation with no
+	// warnings or errors generated.
+	Diagnostics []*tfprotov6.Diagnostic
+}
+
+// AddWarning appends a warning diagnostic to the response. If the warning
+// concerns a particular attribute, AddAttributeWarning should be used instead.
+func (r *ImportResourceStateResponse) AddWarning(summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Summary:  summary,
+		Detail:   detail,
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+	})
+}
+
+// AddAttributeWarning appends a warning diagnostic to the response and labels
+// it with a specific attribute.
+func (r *ImportResourceStateResponse) AddAttributeWarning(attributePath *tftypes.AttributePath, summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Attribute: attributePath,
+		Summary:   summary,
+		Detail:    detail,
+		Severity:  tfprotov6.DiagnosticSeverityWarning,
+	})
+}
+
+// AddError appends an error diagnostic to the response. If the error concerns a
+// particular attribute, AddAttributeError should be used instead.
+func (r *ImportResourceStateResponse) AddError(summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Summary:  summary,
+		Detail:   detail,
+		Severity: tfprotov6.DiagnosticSeverityError,
+	})
+}
+
+// AddAttributeError appends an error diagnostic to the response and labels it
+// with a specific attribute.
+func (r *ImportResourceStateResponse) AddAttributeError(attributePath *tftypes.AttributePath, summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Attribute: attributePath,
+		Summary:   summary,
+		Detail:    detail,
+		Severity:  tfprotov6.DiagnosticSeverityError,
+	})
+}
+
+// ModifyResourcePlanResponse represents a response to a
+// ModifyResourcePlanRequest. An instance of this response struct is supplied
+// as an argument to the resource's ModifyPlan function, in which the provider
+// should set values on the ModifyResourcePlanResponse as appropriate.
+type ModifyResourcePlanResponse struct {
+	// Plan is the planned new state for the resource. It is
+	// pre-populated from ModifyResourcePlanRequest.Plan. Changes to it
+	// are ignored when the resource is being destroyed.
+	Plan Plan
+
+	// RequiresReplace is the list of paths to attributes whose changes
+	// require the resource to be replaced, rather than updated in place.
+	// It is pre-populated with the paths the framework already found, and
+	// is ignored when the resource is being created or destroyed.
+	RequiresReplace []*tftypes.AttributePath
+
+	// Diagnostics report errors or warnings related to modifying the
+	// plan. An empty slice indicates a successful operation with no
+	// warnings or errors generated.
+	Diagnostics []*tfprotov6.Diagnostic
+}
+
+// AddWarning appends a warning diagnostic to the response. If the warning
+// concerns a particular attribute, AddAttributeWarning should be used instead.
+func (r *ModifyResourcePlanResponse) AddWarning(summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Summary:  summary,
+		Detail:   detail,
+		Severity: tfprotov6.DiagnosticSeverityWarning,
+	})
+}
+
+// AddAttributeWarning appends a warning diagnostic to the response and labels
+// it with a specific attribute.
+func (r *ModifyResourcePlanResponse) AddAttributeWarning(attributePath *tftypes.AttributePath, summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Attribute: attributePath,
+		Summary:   summary,
+		Detail:    detail,
+		Severity:  tfprotov6.DiagnosticSeverityWarning,
+	})
+}
+
+// AddError appends an error diagnostic to the response. If the error concerns a
+// particular attribute, AddAttributeError should be used instead.
+func (r *ModifyResourcePlanResponse) AddError(summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Summary:  summary,
+		Detail:   detail,
+		Severity: tfprotov6.DiagnosticSeverityError,
+	})
+}
+
+// AddAttributeError appends an error diagnostic to the response and labels it
+// with a specific attribute.
+func (r *ModifyResourcePlanResponse) AddAttributeError(attributePath *tftypes.AttributePath, summary, detail string) {
+	r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+		Attribute: attributePath,
+		Summary:   summary,
+		Detail:    detail,
+		Severity:  tfprotov6.DiagnosticSeverityError,
+	})
+}
+
 // ReadDataSourceResponse represents a response to a ReadDataSourceRequest. An
 // instance of this response struct is supplied as an argument to the data
 // source's Read function, in which the provider should set values on the
@@ -0,0 +1,315 @@
+package tfsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SDKv2StateOptions control how the framework converts state written by a
+// version of a resource built with terraform-plugin-sdk v2.
+type SDKv2StateOptions struct {
+	// LastSDKv2Version is the last schema version of the resource that
+	// was built with terraform-plugin-sdk v2. Only state written with
+	// this version or earlier is converted, as state the framework wrote
+	// is already in the framework's format.
+	LastSDKv2Version int64
+
+	// ZeroValuesAsNull converts empty strings, zeros, and false values of
+	// attributes that are optional and not computed to null. SDKv2
+	// couldn't tell unset attributes from attributes set to their zero
+	// value, and stored the zero value for both, which the framework
+	// would otherwise report as a difference from configs that don't set
+	// the attribute.
+	ZeroValuesAsNull bool
+}
+
+// convertSDKv2State returns `raw`, state written by terraform-plugin-sdk v2,
+// as JSON state in the framework's format for the resource with the schema
+// `resourceSchema`. It:
+//
+//   - converts flatmap state, written by resources with schema version 0,
+//     to JSON;
+//   - converts strings in bool and number attributes, like "1", "0",
+//     "true", and "42", to bools and numbers, and empty ones to null;
+//   - removes the "%" and "#" count keys of flatmap-era maps;
+//   - converts lists of at most one object, which SDKv2 used for nested
+//     blocks with MaxItems set to 1, to a single object or null;
+//   - converts zero values to null, if opts.ZeroValuesAsNull is set.
+func convertSDKv2State(ctx context.Context, resourceSchema schema.Schema, raw *tfprotov6.RawState, opts SDKv2StateOptions) ([]byte, error) {
+	typ := resourceSchema.TerraformType(ctx)
+	var state interface{}
+	switch {
+	case raw.JSON != nil:
+		dec := json.NewDecoder(bytes.NewReader(raw.JSON))
+		dec.UseNumber()
+		if err := dec.Decode(&state); err != nil {
+			return nil, fmt.Errorf("error decoding state: %w", err)
+		}
+	case raw.Flatmap != nil:
+		state = flatmapValue(typ, "", raw.Flatmap)
+	default:
+		return nil, nil
+	}
+
+	zeroAsNull := func(path *tftypes.AttributePath) bool {
+		if !opts.ZeroValuesAsNull {
+			return false
+		}
+		attribute, err := resourceSchema.AttributeAtPath(path)
+		return err == nil && attribute.Optional && !attribute.Computed
+	}
+	converted, err := convertSDKv2Value(typ, state, tftypes.NewAttributePath(), zeroAsNull)
+	if err != nil {
+		return nil, err
+	}
+	result, err := json.Marshal(converted)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding state: %w", err)
+	}
+	return result, nil
+}
+
+// convertSDKv2Value returns `val`, a value of type `typ` decoded from SDKv2
+// JSON state, in the framework's format. `zeroAsNull` reports whether the
+// zero value of the attribute at a path should be converted to null.
+func convertSDKv2Value(typ tftypes.Type, val interface{}, path *tftypes.AttributePath, zeroAsNull func(*tftypes.AttributePath) bool) (interface{}, error) {
+	if val == nil {
+		return nil, nil
+	}
+	switch {
+	case typ.Is(tftypes.String):
+		switch v := val.(type) {
+		case json.Number:
+			val = v.String()
+		case bool:
+			val = strconv.FormatBool(v)
+		}
+		if zeroAsNull(path) && val == "" {
+			return nil, nil
+		}
+		return val, nil
+	case typ.Is(tftypes.Number):
+		if s, ok := val.(string); ok {
+			if s == "" {
+				return nil, nil
+			}
+			if _, ok := new(big.Float).SetString(s); !ok {
+				return nil, path.NewErrorf("%q isn't a number", s)
+			}
+			val = json.Number(s)
+		}
+		if n, ok := val.(json.Number); ok && zeroAsNull(path) {
+			if f, ok := new(big.Float).SetString(n.String()); ok && f.Sign() == 0 {
+				return nil, nil
+			}
+		}
+		return val, nil
+	case typ.Is(tftypes.Bool):
+		if s, ok := val.(string); ok {
+			switch s {
+			case "":
+				return nil, nil
+			case "true", "1":
+				val = true
+			case "false", "0":
+				val = false
+			default:
+				return nil, path.NewErrorf("%q isn't a bool", s)
+			}
+		}
+		if val == false && zeroAsNull(path) {
+			return nil, nil
+		}
+		return val, nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		// SDKv2 stored nested blocks as lists, even when they could
+		// only have one element
+		if elems, ok := val.([]interface{}); ok {
+			switch len(elems) {
+			case 0:
+				return nil, nil
+			case 1:
+				val = elems[0]
+			default:
+				return nil, path.NewErrorf("can't convert a list of %d elements to an object", len(elems))
+			}
+		}
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, path.NewErrorf("expected an object, got %T", val)
+		}
+		result := make(map[string]interface{}, len(obj))
+		for _, name := range order.Keys(obj) {
+			attrVal := obj[name]
+			attrType, ok := typ.AttributeTypes[name]
+			if !ok {
+				// leave unexpected attributes for the
+				// strict state check or unmarshaling to
+				// report
+				result[name] = attrVal
+				continue
+			}
+			converted, err := convertSDKv2Value(attrType, attrVal, path.WithAttributeName(name), zeroAsNull)
+			if err != nil {
+				return nil, err
+			}
+			result[name] = converted
+		}
+		return result, nil
+	case tftypes.Map:
+		elems, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, path.NewErrorf("expected a map, got %T", val)
+		}
+		result := make(map[string]interface{}, len(elems))
+		for _, key := range order.Keys(elems) {
+			elem := elems[key]
+			if key == "%" || key == "#" {
+				continue
+			}
+			converted, err := convertSDKv2Value(typ.AttributeType, elem, path.WithElementKeyString(key), zeroAsNull)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = converted
+		}
+		return result, nil
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		elems, ok := val.([]interface{})
+		if !ok {
+			return nil, path.NewErrorf("expected a list, got %T", val)
+		}
+		result := make([]interface{}, 0, len(elems))
+		for i, elem := range elems {
+			var elemType tftypes.Type
+			switch typ := typ.(type) {
+			case tftypes.List:
+				elemType = typ.ElementType
+			case tftypes.Set:
+				elemType = typ.ElementType
+			case tftypes.Tuple:
+				if i >= len(typ.ElementTypes) {
+					return nil, path.NewErrorf("tuple has %d elements, expected %d", len(elems), len(typ.ElementTypes))
+				}
+				elemType = typ.ElementTypes[i]
+			}
+			converted, err := convertSDKv2Value(elemType, elem, path.WithElementKeyInt(int64(i)), zeroAsNull)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, converted)
+		}
+		return result, nil
+	}
+	return nil, path.NewErrorf("can't convert values of type %s", typ)
+}
+
+// flatmapValue returns the value of type `typ` at `prefix` in the flatmap
+// state `flat`, with primitive values left as strings for convertSDKv2Value
+// to convert. It returns nil if `flat` has no value there.
+func flatmapValue(typ tftypes.Type, prefix string, flat map[string]string) interface{} {
+	key := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "." + name
+	}
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		if prefix != "" {
+			if _, ok := flat[key("#")]; ok {
+				// a nested block, stored as a list
+				return flatmapValue(tftypes.List{ElementType: typ}, prefix, flat)
+			}
+		}
+		obj := make(map[string]interface{}, len(typ.AttributeTypes))
+		for name, attrType := range typ.AttributeTypes {
+			obj[name] = flatmapValue(attrType, key(name), flat)
+		}
+		return obj
+	case tftypes.List, tftypes.Set:
+		if _, ok := flat[key("#")]; !ok {
+			return nil
+		}
+		var elemType tftypes.Type
+		if list, ok := typ.(tftypes.List); ok {
+			elemType = list.ElementType
+		} else {
+			elemType = typ.(tftypes.Set).ElementType
+		}
+		// set elements are keyed by their hashes, rather than by
+		// their indexes, so sort the keys numerically either way
+		indexes := flatmapChildren(prefix, flat, "#")
+		sort.Slice(indexes, func(i, j int) bool {
+			a, _ := strconv.ParseInt(indexes[i], 10, 64)
+			b, _ := strconv.ParseInt(indexes[j], 10, 64)
+			return a < b
+		})
+		elems := make([]interface{}, 0, len(indexes))
+		for _, index := range indexes {
+			elems = append(elems, flatmapValue(elemType, key(index), flat))
+		}
+		return elems
+	case tftypes.Map:
+		if _, ok := flat[key("%")]; !ok {
+			return nil
+		}
+		elems := map[string]interface{}{}
+		if isFlatmapPrimitive(typ.AttributeType) {
+			// keys of maps of primitives can contain dots, so
+			// everything after the prefix is the key
+			for k, v := range flat {
+				if strings.HasPrefix(k, key("")) && k != key("%") {
+					elems[strings.TrimPrefix(k, key(""))] = v
+				}
+			}
+			return elems
+		}
+		for _, child := range flatmapChildren(prefix, flat, "%") {
+			elems[child] = flatmapValue(typ.AttributeType, key(child), flat)
+		}
+		return elems
+	}
+	v, ok := flat[prefix]
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+// flatmapChildren returns the distinct first segments of the keys nested
+// under `prefix` in `flat`, other than the count key `countKey`.
+func flatmapChildren(prefix string, flat map[string]string, countKey string) []string {
+	seen := map[string]bool{}
+	var children []string
+	for k := range flat {
+		if !strings.HasPrefix(k, prefix+".") {
+			continue
+		}
+		child := strings.SplitN(strings.TrimPrefix(k, prefix+"."), ".", 2)[0]
+		if child == countKey || seen[child] {
+			continue
+		}
+		seen[child] = true
+		children = append(children, child)
+	}
+	return children
+}
+
+func isFlatmapPrimitive(typ tftypes.Type) bool {
+	return typ.Is(tftypes.String) || typ.Is(tftypes.Number) || typ.Is(tftypes.Bool)
+}
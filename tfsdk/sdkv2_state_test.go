@@ -0,0 +1,152 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConvertSDKv2State(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"size": {
+				Type:     types.NumberType,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"ports": {
+				Type:     types.ListType{ElemType: types.NumberType},
+				Optional: true,
+			},
+			"settings": {
+				Attributes: schema.SingleNestedAttributes(map[string]schema.Attribute{
+					"mode": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+	typ := s.TerraformType(context.Background())
+	settingsType := typ.(tftypes.Object).AttributeTypes["settings"]
+
+	expected := func(enabled, description interface{}) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "abc"),
+			"enabled":     tftypes.NewValue(tftypes.Bool, enabled),
+			"size":        tftypes.NewValue(tftypes.Number, big.NewFloat(0)),
+			"description": tftypes.NewValue(tftypes.String, description),
+			"tags": tftypes.NewValue(tftypes.Map{AttributeType: tftypes.String}, map[string]tftypes.Value{
+				"a.b": tftypes.NewValue(tftypes.String, "c"),
+			}),
+			"ports": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, big.NewFloat(80)),
+				tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+			}),
+			"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+				"mode": tftypes.NewValue(tftypes.String, "fast"),
+			}),
+		})
+	}
+
+	type testCase struct {
+		raw      *tfprotov6.RawState
+		opts     SDKv2StateOptions
+		expected tftypes.Value
+	}
+	tests := map[string]testCase{
+		"json": {
+			raw: &tfprotov6.RawState{
+				JSON: []byte(`{"id":"abc","enabled":"1","size":"0","description":"","tags":{"%":"1","a.b":"c"},"ports":[80,443],"settings":[{"mode":"fast"}]}`),
+			},
+			expected: expected(true, ""),
+		},
+		"json-zero-values-as-null": {
+			raw: &tfprotov6.RawState{
+				JSON: []byte(`{"id":"abc","enabled":false,"size":0,"description":"","tags":{"a.b":"c"},"ports":[80,443],"settings":{"mode":"fast"}}`),
+			},
+			opts:     SDKv2StateOptions{ZeroValuesAsNull: true},
+			expected: expected(nil, nil),
+		},
+		"flatmap": {
+			raw: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"id":              "abc",
+					"enabled":         "true",
+					"size":            "0",
+					"description":     "",
+					"tags.%":          "1",
+					"tags.a.b":        "c",
+					"ports.#":         "2",
+					"ports.0":         "80",
+					"ports.1":         "443",
+					"settings.#":      "1",
+					"settings.0.mode": "fast",
+				},
+			},
+			expected: expected(true, ""),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			converted, err := convertSDKv2State(context.Background(), s, tc.raw, tc.opts)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			got, err := (&tfprotov6.RawState{JSON: converted}).Unmarshal(typ)
+			if err != nil {
+				t.Fatalf("Unexpected error unmarshaling %s: %s", converted, err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestConvertSDKv2StateInvalid(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": {
+				Type:     types.BoolType,
+				Optional: true,
+			},
+		},
+	}
+	_, err := convertSDKv2State(context.Background(), s, &tfprotov6.RawState{JSON: []byte(`{"enabled":"yes"}`)}, SDKv2StateOptions{})
+	expected := `AttributeName("enabled"): "yes" isn't a bool`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
@@ -0,0 +1,84 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// preserveSemanticEquals returns `val`, with every value whose attr.Value
+// implements attr.ValueWithSemanticEquals replaced by the value at the same
+// path in `prior`, as long as the two aren't equal but SemanticEquals says
+// they mean the same thing.
+func preserveSemanticEquals(ctx context.Context, resourceSchema schema.Schema, prior, val tftypes.Value) (tftypes.Value, error) {
+	if val.Type() == nil || prior.Type() == nil || !hasSemanticEquals(ctx, resourceSchema.AttributeType()) {
+		return val, nil
+	}
+	return tftypes.Transform(val, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if len(path.Steps()) < 1 || !v.IsKnown() || v.IsNull() {
+			return v, nil
+		}
+		attrType, err := resourceSchema.AttributeTypeAtPath(path)
+		if err != nil {
+			// the path is inside a value without a schema, like
+			// a dynamic value
+			return v, nil
+		}
+		current, err := attrType.ValueFromTerraform(ctx, v)
+		if err != nil {
+			return v, path.NewError(err)
+		}
+		semantic, ok := current.(attr.ValueWithSemanticEquals)
+		if !ok {
+			return v, nil
+		}
+		rawPrior, _, err := tftypes.WalkAttributePath(prior, path)
+		if errors.Is(err, tftypes.ErrInvalidStep) {
+			// the value isn't in the prior value
+			return v, nil
+		}
+		if err != nil {
+			return v, err
+		}
+		priorVal, ok := rawPrior.(tftypes.Value)
+		if !ok || !priorVal.IsKnown() || priorVal.IsNull() || !priorVal.Type().Is(v.Type()) || priorVal.Equal(v) {
+			return v, nil
+		}
+		priorAttr, err := attrType.ValueFromTerraform(ctx, priorVal)
+		if err != nil {
+			return v, path.NewError(err)
+		}
+		equal, err := semantic.SemanticEquals(ctx, priorAttr)
+		if err != nil {
+			return v, path.NewError(err)
+		}
+		if equal {
+			return priorVal, nil
+		}
+		return v, nil
+	})
+}
+
+// hasSemanticEquals returns true if the values of `typ`, or of any type
+// nested in it, implement attr.ValueWithSemanticEquals.
+func hasSemanticEquals(ctx context.Context, typ attr.Type) bool {
+	if null, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil)); err == nil {
+		if _, ok := null.(attr.ValueWithSemanticEquals); ok {
+			return true
+		}
+	}
+	switch t := typ.(type) {
+	case attr.TypeWithElementType:
+		return hasSemanticEquals(ctx, t.ElementType())
+	case attr.TypeWithAttributeTypes:
+		for _, attrType := range t.AttributeTypes() {
+			if hasSemanticEquals(ctx, attrType) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testCaseInsensitiveStringType struct {
+	attr.Type
+}
+
+func (t testCaseInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := types.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return testCaseInsensitiveString{String: val.(types.String)}, nil
+}
+
+type testCaseInsensitiveString struct {
+	types.String
+}
+
+func (s testCaseInsensitiveString) SemanticEquals(_ context.Context, prior attr.Value) (bool, error) {
+	other, ok := prior.(testCaseInsensitiveString)
+	if !ok {
+		return false, errors.New("unexpected prior value type")
+	}
+	return strings.EqualFold(s.Value, other.Value), nil
+}
+
+func TestPreserveSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	caseInsensitive := testCaseInsensitiveStringType{Type: types.StringType}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     caseInsensitive,
+				Required: true,
+			},
+			"plain": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"tags": {
+				Type:     types.ListType{ElemType: caseInsensitive},
+				Optional: true,
+			},
+		},
+	}
+	listType := tftypes.List{ElementType: tftypes.String}
+	objType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"plain": tftypes.String,
+			"tags":  listType,
+		},
+	}
+	str := func(s interface{}) tftypes.Value {
+		return tftypes.NewValue(tftypes.String, s)
+	}
+	strs := func(vals ...string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(vals))
+		for _, v := range vals {
+			elems = append(elems, str(v))
+		}
+		return tftypes.NewValue(listType, elems)
+	}
+	obj := func(name, plain, tags tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"name":  name,
+			"plain": plain,
+			"tags":  tags,
+		})
+	}
+
+	type testCase struct {
+		prior    tftypes.Value
+		val      tftypes.Value
+		expected tftypes.Value
+	}
+
+	tests := map[string]testCase{
+		"semantically-equal": {
+			prior:    obj(str("Example"), str("Plain"), strs("Foo", "bar")),
+			val:      obj(str("example"), str("plain"), strs("foo", "BAR")),
+			expected: obj(str("Example"), str("plain"), strs("Foo", "bar")),
+		},
+		"changed": {
+			prior:    obj(str("Example"), str("plain"), strs("foo")),
+			val:      obj(str("other"), str("plain"), strs("bar", "foo")),
+			expected: obj(str("other"), str("plain"), strs("bar", "foo")),
+		},
+		"prior-null": {
+			prior:    obj(str(nil), str(nil), tftypes.NewValue(listType, nil)),
+			val:      obj(str("example"), str("plain"), strs("foo")),
+			expected: obj(str("example"), str("plain"), strs("foo")),
+		},
+		"unknown": {
+			prior:    obj(str("Example"), str("plain"), strs("foo")),
+			val:      obj(str(tftypes.UnknownValue), str("plain"), strs("FOO")),
+			expected: obj(str(tftypes.UnknownValue), str("plain"), strs("foo")),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := preserveSemanticEquals(context.Background(), testSchema, tc.prior, tc.val)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -4,9 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"os"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/proto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/schema"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -20,6 +26,16 @@ type server struct {
 	p                Provider
 	contextCancels   []context.CancelFunc
 	contextCancelsMu sync.Mutex
+	limiter          *operationLimiter
+
+	checkApplyConsistency  bool
+	requestIDInDiagnostics bool
+	explainPlans           bool
+	experiments            map[Experiment]bool
+	numberPrecision        uint
+	numberRoundingMode     big.RoundingMode
+
+	readCache dataSourceReadCache
 }
 
 // ServeOpts are options for serving the provider.
@@ -27,13 +43,75 @@ type ServeOpts struct {
 	// Name is the name of the provider, in full address form. For example:
 	// registry.terraform.io/hashicorp/random.
 	Name string
+
+	// MaxConcurrentOperations limits how many resource and data source
+	// operations (reading and applying changes to resources, and reading
+	// data sources) can run at the same time across the
+	// provider. Operations over the limit wait until others complete.
+	// Zero means there is no limit.
+	MaxConcurrentOperations int
+
+	// MaxConcurrentOperationsPerType limits how many resource and data
+	// source operations can run at the same time for each resource or data
+	// source type name, in addition to MaxConcurrentOperations. A resource
+	// and a data source with the same type name are limited separately,
+	// each to the mapped limit. Type names that aren't in the map, or are
+	// mapped to zero, have no limit of their own.
+	MaxConcurrentOperationsPerType map[string]int
+
+	// CheckApplyConsistency turns on a development mode in which the new
+	// state returned by each resource's Create and Update methods is
+	// compared with the plan, and an error diagnostic is returned for
+	// each attribute Terraform would reject as inconsistent with it: a
+	// value that was known in the plan and changed, or a value that is
+	// still unknown. This names the attributes at fault, which
+	// Terraform's own error doesn't always make clear.
+	CheckApplyConsistency bool
+
+	// RequestIDInDiagnostics adds the ID the framework generates for each
+	// request to the detail of the error diagnostics returned for it, so
+	// users reporting an error can give the ID, and it can be found in the
+	// provider's logs. See RequestID.
+	RequestIDInDiagnostics bool
+
+	// ExplainPlans turns on a development mode in which the framework
+	// logs, at the DEBUG level, where it got the planned value of each
+	// attribute of each resource it plans, like the configuration, the
+	// prior state, or a plan modifier, as a JSON PlanExplanation.
+	ExplainPlans bool
+
+	// Experiments turns on experimental framework behaviors for the
+	// provider. See Experiment.
+	Experiments []Experiment
+
+	// NumberPrecision, if set, is the precision in bits, like
+	// big.Float.SetPrec takes, that every number sent to Terraform is
+	// rounded to, using NumberRoundingMode. Terraform parses numbers with
+	// 512 bits of precision, so setting it to 512 keeps large integers,
+	// like uint64 IDs, and high-precision decimals from being rounded to
+	// the precision of whatever *big.Float they were computed in. Zero
+	// sends numbers with the precision they have.
+	NumberPrecision uint
+
+	// NumberRoundingMode is the rounding mode used to round numbers to
+	// NumberPrecision. It defaults to big.ToNearestEven, like Terraform
+	// uses.
+	NumberRoundingMode big.RoundingMode
 }
 
 // Serve serves a provider, blocking until the context is canceled.
 func Serve(ctx context.Context, factory func() Provider, opts ServeOpts) error {
 	return tf6server.Serve(opts.Name, func() tfprotov6.ProviderServer {
 		return &server{
-			p: factory(),
+			p:       factory(),
+			limiter: newOperationLimiter(opts.MaxConcurrentOperations, opts.MaxConcurrentOperationsPerType),
+
+			checkApplyConsistency:  opts.CheckApplyConsistency,
+			requestIDInDiagnostics: opts.RequestIDInDiagnostics,
+			explainPlans:           opts.ExplainPlans,
+			experiments:            experimentSet(opts.Experiments),
+			numberPrecision:        opts.NumberPrecision,
+			numberRoundingMode:     opts.NumberRoundingMode,
 		}
 	}) // TODO: set up debug serving if the --debug flag is passed
 }
@@ -51,7 +129,7 @@ func diagsHasErrors(in []*tfprotov6.Diagnostic) bool {
 }
 
 func (s *server) registerContext(in context.Context) context.Context {
-	ctx, cancel := context.WithCancel(in)
+	ctx, cancel := context.WithCancel(withExperiments(withRequestID(in), s.experiments))
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
 	s.contextCancels = append(s.contextCancels, cancel)
@@ -100,14 +178,24 @@ func (s *server) getDataSourceType(ctx context.Context, typ string) (DataSourceT
 func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
 	ctx = s.registerContext(ctx)
 
+	resp := providerSchemaResponse(ctx, s.p)
+	s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
+	return resp, nil
+}
+
+// providerSchemaResponse gets the schemas of `p` and all its resources and
+// data sources, and converts them into a *tfprotov6.GetProviderSchemaResponse.
+// Problems retrieving or converting the schemas are returned as diagnostics
+// on the response, without any schemas.
+func providerSchemaResponse(ctx context.Context, p Provider) *tfprotov6.GetProviderSchemaResponse {
 	resp := new(tfprotov6.GetProviderSchemaResponse)
 
 	// get the provider schema
-	providerSchema, diags := s.p.GetSchema(ctx)
+	providerSchema, diags := p.GetSchema(ctx)
 	if diags != nil {
 		resp.Diagnostics = append(resp.Diagnostics, diags...)
 		if diagsHasErrors(resp.Diagnostics) {
-			return resp, nil
+			return resp
 		}
 	}
 	// convert the provider schema to a *tfprotov6.Schema
@@ -118,7 +206,7 @@ func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProvider
 			Summary:  "Error converting provider schema",
 			Detail:   "The provider schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
 		})
-		return resp, nil
+		return resp
 	}
 
 	// don't set the schema on the response yet, we want it to be able to
@@ -128,12 +216,12 @@ func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProvider
 
 	// if we have a provider_meta schema, get it
 	var providerMeta6Schema *tfprotov6.Schema
-	if pm, ok := s.p.(ProviderWithProviderMeta); ok {
+	if pm, ok := p.(ProviderWithProviderMeta); ok {
 		providerMetaSchema, diags := pm.GetMetaSchema(ctx)
 		if diags != nil {
 			resp.Diagnostics = append(resp.Diagnostics, diags...)
 			if diagsHasErrors(resp.Diagnostics) {
-				return resp, nil
+				return resp
 			}
 		}
 		pm6Schema, err := proto6.Schema(ctx, providerMetaSchema)
@@ -143,26 +231,34 @@ func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProvider
 				Summary:  "Error converting provider_meta schema",
 				Detail:   "The provider_meta schema couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
 			})
-			return resp, nil
+			return resp
 		}
 		providerMeta6Schema = pm6Schema
 	}
 
 	// get our resource schemas
-	resourceSchemas, diags := s.p.GetResources(ctx)
+	resourceSchemas, diags := p.GetResources(ctx)
 	if diags != nil {
 		resp.Diagnostics = append(resp.Diagnostics, diags...)
 		if diagsHasErrors(resp.Diagnostics) {
-			return resp, nil
+			return resp
 		}
 	}
 	resource6Schemas := map[string]*tfprotov6.Schema{}
-	for k, v := range resourceSchemas {
-		schema, diags := v.GetSchema(ctx)
+	// visit the types in order, so the same error is always returned
+	// first
+	resourceNames := make([]string, 0, len(resourceSchemas))
+	for k := range resourceSchemas {
+		resourceNames = append(resourceNames, k)
+	}
+	sort.Strings(resourceNames)
+	for _, k := range resourceNames {
+		v := resourceSchemas[k]
+		schema, diags := resourceTypeSchema(ctx, v)
 		if diags != nil {
 			resp.Diagnostics = append(resp.Diagnostics, diags...)
 			if diagsHasErrors(resp.Diagnostics) {
-				return resp, nil
+				return resp
 			}
 		}
 		schema6, err := proto6.Schema(ctx, schema)
@@ -172,26 +268,32 @@ func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProvider
 				Summary:  "Error converting resource schema",
 				Detail:   "The schema for the resource \"" + k + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
 			})
-			return resp, nil
+			return resp
 		}
 		resource6Schemas[k] = schema6
 	}
 
 	// get our data source schemas
-	dataSourceSchemas, diags := s.p.GetDataSources(ctx)
+	dataSourceSchemas, diags := p.GetDataSources(ctx)
 	if diags != nil {
 		resp.Diagnostics = append(resp.Diagnostics, diags...)
 		if diagsHasErrors(resp.Diagnostics) {
-			return resp, nil
+			return resp
 		}
 	}
 	dataSource6Schemas := map[string]*tfprotov6.Schema{}
-	for k, v := range dataSourceSchemas {
+	dataSourceNames := make([]string, 0, len(dataSourceSchemas))
+	for k := range dataSourceSchemas {
+		dataSourceNames = append(dataSourceNames, k)
+	}
+	sort.Strings(dataSourceNames)
+	for _, k := range dataSourceNames {
+		v := dataSourceSchemas[k]
 		schema, diags := v.GetSchema(ctx)
 		if diags != nil {
 			resp.Diagnostics = append(resp.Diagnostics, diags...)
 			if diagsHasErrors(resp.Diagnostics) {
-				return resp, nil
+				return resp
 			}
 		}
 		schema6, err := proto6.Schema(ctx, schema)
@@ -201,7 +303,7 @@ func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProvider
 				Summary:  "Error converting data sourceschema",
 				Detail:   "The schema for the data source \"" + k + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
 			})
-			return resp, nil
+			return resp
 		}
 		dataSource6Schemas[k] = schema6
 	}
@@ -212,7 +314,7 @@ func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProvider
 	resp.ProviderMeta = providerMeta6Schema
 	resp.ResourceSchemas = resource6Schemas
 	resp.DataSourceSchemas = dataSource6Schemas
-	return resp, nil
+	return resp
 }
 
 func (s *server) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
@@ -231,6 +333,7 @@ func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov6.Configure
 	ctx = s.registerContext(ctx)
 
 	resp := &tfprotov6.ConfigureProviderResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
 	schema, diags := s.p.GetSchema(ctx)
 	if diags != nil {
 		resp.Diagnostics = append(resp.Diagnostics, diags...)
@@ -247,12 +350,20 @@ func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov6.Configure
 		})
 		return resp, nil
 	}
+	config, diags = applyProviderDefaults(ctx, schema, config, os.LookupEnv)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
 	r := ConfigureProviderRequest{
 		TerraformVersion: req.TerraformVersion,
 		Config: Config{
 			Raw:    config,
 			Schema: schema,
 		},
+		Raw: RawRequest{
+			Config: req.Config,
+		},
 	}
 	res := &ConfigureProviderResponse{}
 	s.p.Configure(ctx, r, res)
@@ -266,36 +377,281 @@ func (s *server) StopProvider(ctx context.Context, _ *tfprotov6.StopProviderRequ
 	return &tfprotov6.StopProviderResponse{}, nil
 }
 
-func (s *server) ValidateResourceConfig(ctx context.Context, _ *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
-	// uncomment when we implement this function
-	//ctx = s.registerContext(ctx)
+// validateAttributeTypes calls the Validate method of every
+// attr.TypeWithValidate in `s` on the matching value in `config`, including
+// the types of nested attributes and of elements. Any diagnostics returned
+// without an attribute path are attached to the path of the value that was
+// being validated, so they always point somewhere useful.
+func validateAttributeTypes(ctx context.Context, s schema.Schema, config tftypes.Value) ([]*tfprotov6.Diagnostic, error) {
+	var diags []*tfprotov6.Diagnostic
+	err := tftypes.Walk(config, func(path *tftypes.AttributePath, val tftypes.Value) (bool, error) {
+		if len(path.Steps()) < 1 {
+			return true, nil
+		}
+		attrType, err := s.AttributeTypeAtPath(path)
+		if err != nil {
+			return false, fmt.Errorf("couldn't find attribute type in schema: %w", err)
+		}
+		validator, ok := attrType.(attr.TypeWithValidate)
+		if !ok {
+			return true, nil
+		}
+		for _, diag := range validator.Validate(ctx, val) {
+			if diag == nil {
+				continue
+			}
+			if diag.Attribute == nil {
+				// copy the diagnostic rather than changing it,
+				// the type may reuse it
+				withPath := *diag
+				withPath.Attribute = path
+				diag = &withPath
+			}
+			diags = append(diags, diag)
+		}
+		return true, nil
+	})
+	return diags, err
+}
 
-	// TODO: support validation
-	return &tfprotov6.ValidateResourceConfigResponse{}, nil
+func (s *server) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	ctx = s.registerContext(ctx)
+	resp := &tfprotov6.ValidateResourceConfigResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
+
+	resourceType, diags := s.getResourceType(ctx, req.TypeName)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	if req.Config == nil {
+		return resp, nil
+	}
+	config, err := req.Config.Unmarshal(resourceSchema.TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error parsing config",
+			Detail:   "There was an unexpected error parsing the config. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+
+	// TODO: support validation beyond attr.TypeWithValidate
+	diags, err = validateAttributeTypes(ctx, resourceSchema, config)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if err == nil {
+		diags, err = validateReadOnlyAttributes(resourceSchema, config)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	}
+	if err == nil {
+		diags, err = validateSupersededAttributes(resourceSchema, config)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	}
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error validating config",
+			Detail:   "There was an unexpected error validating the config. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+	}
+	resp.Diagnostics = append(resp.Diagnostics, validateTimeouts(ctx, resourceType, config)...)
+	return resp, nil
+}
+
+func requiredNullsInUpgradedState(ctx context.Context, resourceSchema schema.Schema, paths *[]*tftypes.AttributePath) func(*tftypes.AttributePath, tftypes.Value) (bool, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (bool, error) {
+		if len(path.Steps()) < 1 || !val.IsNull() {
+			return true, nil
+		}
+		attribute, err := resourceSchema.AttributeAtPath(path)
+		if err != nil {
+			if errors.Is(err, schema.ErrPathInsideAtomicAttribute) {
+				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
+				return false, nil
+			}
+			return false, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+		}
+		if attribute.Required {
+			*paths = append(*paths, path)
+		}
+		return false, nil
+	}
 }
 
 func (s *server) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
-	// uncomment when we implement this function
-	//ctx = s.registerContext(ctx)
+	ctx = s.registerContext(ctx)
+	resp := &tfprotov6.UpgradeResourceStateResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
 
-	// TODO: support state upgrades
-	return &tfprotov6.UpgradeResourceStateResponse{
-		UpgradedState: &tfprotov6.DynamicValue{
-			JSON: req.RawState.JSON,
-		},
-	}, nil
+	if req.RawState == nil {
+		return resp, nil
+	}
+
+	resourceType, diags := s.getResourceType(ctx, req.TypeName)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+
+	rawState := req.RawState
+	if sdkv2, ok := resourceType.(ResourceTypeWithSDKv2State); ok {
+		opts := sdkv2.SDKv2State(ctx)
+		if req.Version <= opts.LastSDKv2Version {
+			resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
+			resp.Diagnostics = append(resp.Diagnostics, diags...)
+			if diagsHasErrors(resp.Diagnostics) {
+				return resp, nil
+			}
+			converted, err := convertSDKv2State(ctx, resourceSchema, rawState, opts)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error upgrading state",
+					Detail:   fmt.Sprintf("The prior state, written with schema version %d, couldn't be converted from the format of terraform-plugin-sdk v2. This is always a problem with the provider. Please report the following to the provider developer:\n\n%s", req.Version, err.Error()),
+				})
+				return resp, nil
+			}
+			rawState = &tfprotov6.RawState{
+				JSON: converted,
+			}
+		}
+	}
+	if rawState.JSON != nil {
+		resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(resp.Diagnostics) {
+			return resp, nil
+		}
+		upgraded, err := upgradeSupersededState(resourceSchema, rawState.JSON)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error upgrading state",
+				Detail:   "An unexpected error was encountered copying the values of renamed attributes in the prior state. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		rawState = &tfprotov6.RawState{
+			JSON:    upgraded,
+			Flatmap: rawState.Flatmap,
+		}
+	}
+	if strict, ok := resourceType.(ResourceTypeWithStrictState); ok && strict.StrictState(ctx) && rawState.JSON != nil {
+		resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(resp.Diagnostics) {
+			return resp, nil
+		}
+		pruned, removed, err := removeUnexpectedAttributes(resourceSchema.TerraformType(ctx), rawState.JSON)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error checking prior state",
+				Detail:   "An unexpected error was encountered checking the prior state for unexpected attributes. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		for _, path := range removed {
+			resp.Diagnostics = append(resp.Diagnostics, unexpectedAttributeDiagnostic(path))
+		}
+		rawState = &tfprotov6.RawState{
+			JSON:    pruned,
+			Flatmap: rawState.Flatmap,
+		}
+	}
+
+	upgrader, ok := resourceType.(ResourceTypeWithAdditiveStateUpgrades)
+	if !ok || !upgrader.AdditiveStateUpgrades(ctx) {
+		// TODO: support state upgrades
+		resp.UpgradedState = &tfprotov6.DynamicValue{
+			JSON: rawState.JSON,
+		}
+		return resp, nil
+	}
+
+	resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+
+	// unmarshaling the prior state using the current schema fills any
+	// attributes the prior state doesn't have with nulls, and fails if
+	// the prior state has attributes the current schema doesn't
+	state, err := rawState.Unmarshal(resourceSchema.TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error upgrading state",
+			Detail:   fmt.Sprintf("The prior state, written with schema version %d, couldn't be automatically upgraded to schema version %d. Automatic upgrades only support adding optional or computed attributes. This is always a problem with the provider. Please report the following to the provider developer:\n\n%s", req.Version, resourceSchema.Version, err.Error()),
+		})
+		return resp, nil
+	}
+
+	var missing []*tftypes.AttributePath
+	err = tftypes.Walk(state, requiredNullsInUpgradedState(ctx, resourceSchema, &missing))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error upgrading state",
+			Detail:   "There was an unexpected error checking the upgraded state. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+	for _, path := range missing {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Error upgrading state",
+			Detail:    fmt.Sprintf("The prior state, written with schema version %d, has no value for a required attribute in schema version %d. Automatic upgrades only support adding optional or computed attributes. This is always a problem with the provider. Please report this to the provider developer.", req.Version, resourceSchema.Version),
+			Attribute: path,
+		})
+	}
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+
+	upgradedState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), state)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error converting upgraded state",
+			Detail:   "An unexpected error was encountered when converting the upgraded state to a usable type. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+	resp.UpgradedState = &upgradedState
+	return resp, nil
 }
 
 func (s *server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	resp := &tfprotov6.ReadResourceResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
+
+	release, diags := s.acquireOperation(ctx, limitedType{name: req.TypeName})
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	defer release()
 
 	resourceType, diags := s.getResourceType(ctx, req.TypeName)
 	resp.Diagnostics = append(resp.Diagnostics, diags...)
 	if diagsHasErrors(resp.Diagnostics) {
 		return resp, nil
 	}
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	if skipper, ok := resourceType.(ResourceTypeWithSkipRead); ok && skipper.SkipRead(ctx) {
+		logf(ctx, "DEBUG", "skipping read for resource %q, returning current state unchanged", req.TypeName)
+		resp.NewState = req.CurrentState
+		return resp, nil
+	}
+	resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
 	resp.Diagnostics = append(resp.Diagnostics, diags...)
 	if diagsHasErrors(resp.Diagnostics) {
 		return resp, nil
@@ -314,11 +670,20 @@ func (s *server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRe
 		})
 		return resp, nil
 	}
+	state, diags = s.decodeState(ctx, req.TypeName, resourceSchema, state)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
 	readReq := ReadResourceRequest{
 		State: State{
 			Raw:    state,
 			Schema: resourceSchema,
 		},
+		Raw: RawRequest{
+			State:        req.CurrentState,
+			ProviderMeta: req.ProviderMeta,
+		},
 	}
 	if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 		pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -349,15 +714,74 @@ func (s *server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRe
 	readResp := ReadResourceResponse{
 		State: State{
 			Schema: resourceSchema,
+			Raw:    state,
 		},
 		Diagnostics: resp.Diagnostics,
 	}
-	resource.Read(ctx, readReq, &readResp)
+	readCtx, cancel, timeout, diags := withResourceTimeout(ctx, resourceType, timeoutRead, state)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	defer cancel()
+	readResp.Diagnostics = resp.Diagnostics
+	resource.Read(readCtx, readReq, &readResp)
+	if diag := timeoutDiagnostic(readCtx, req.TypeName, timeoutRead, timeout); diag != nil {
+		readResp.Diagnostics = append(readResp.Diagnostics, diag)
+	}
 	resp.Diagnostics = readResp.Diagnostics
 	// don't return even if we have error diagnostics, we need to set the
 	// state on the response, first
 
-	newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), readResp.State.Raw)
+	if normalizer, ok := resource.(ResourceWithReadNormalizers); ok && !readResp.State.Raw.IsNull() {
+		var diags []*tfprotov6.Diagnostic
+		readResp.State.Raw, diags = normalizeReadResult(ctx, resourceSchema, normalizer.ReadNormalizers(ctx), state, readResp.State.Raw)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(diags) {
+			// the read result can't be returned half-processed, so
+			// keep the current state
+			resp.NewState = req.CurrentState
+			return resp, nil
+		}
+	}
+
+	readResp.State.Raw, err = normalizeEmptyObjects(ctx, resourceSchema, readResp.State.Raw, false)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error normalizing read response",
+			Detail:   "An unexpected error was encountered when normalizing the empty objects in the read response. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+		})
+		resp.NewState = req.CurrentState
+		return resp, nil
+	}
+	readResp.State.Raw, err = preserveListOrder(ctx, resourceSchema, state, readResp.State.Raw)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error comparing read response lists",
+			Detail:   "An unexpected error was encountered when comparing the lists in the read response to the lists in the current state. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+		})
+		resp.NewState = req.CurrentState
+		return resp, nil
+	}
+	readResp.State.Raw, err = preserveSemanticEquals(ctx, resourceSchema, state, readResp.State.Raw)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error comparing read response values",
+			Detail:   "An unexpected error was encountered when comparing the values in the read response to the values in the current state. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+		})
+		resp.NewState = req.CurrentState
+		return resp, nil
+	}
+	readResp.State.Raw, diags = s.encodeState(ctx, req.TypeName, resourceSchema, readResp.State.Raw)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(diags) {
+		resp.NewState = req.CurrentState
+		return resp, nil
+	}
+	newState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), readResp.State.Raw)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
 			Severity: tfprotov6.DiagnosticSeverityError,
@@ -390,9 +814,143 @@ func markComputedNilsAsUnknown(ctx context.Context, resourceSchema schema.Schema
 	}
 }
 
+// runAttributePlanModifiers returns a tftypes.Transform callback that runs the
+// plan modifiers defined on each attribute in `resourceSchema`, replacing the
+// planned value of the attribute with the result. `config`, `state`, and
+// `plan` are used to populate the modifiers' requests. Diagnostics returned
+// by the modifiers are appended to `diags`, and the paths of attributes a
+// modifier said require replacement are appended to `requiresReplace`.
+func runAttributePlanModifiers(ctx context.Context, resourceSchema schema.Schema, config, state, plan tftypes.Value, diags *[]*tfprotov6.Diagnostic, requiresReplace *[]*tftypes.AttributePath, changes planModifierChanges) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+		modifiers, err := planModifiersAtPath(resourceSchema, path)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		if len(modifiers) < 1 {
+			return val, nil
+		}
+		attrType, err := resourceSchema.AttributeTypeAtPath(path)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute type in resource schema: %w", err)
+		}
+		configVal, err := attributeValueAtPath(ctx, attrType, config, path)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("error retrieving attribute config: %w", err)
+		}
+		stateVal, err := attributeValueAtPath(ctx, attrType, state, path)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("error retrieving attribute state: %w", err)
+		}
+		planVal, err := attrType.ValueFromTerraform(ctx, val)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("error retrieving attribute plan: %w", err)
+		}
+		var replaces bool
+		for _, modifier := range modifiers {
+			req := schema.ModifyAttributePlanRequest{
+				AttributePath:   path,
+				AttributeConfig: configVal,
+				AttributeState:  stateVal,
+				AttributePlan:   planVal,
+				Schema:          resourceSchema,
+				Config:          config,
+				State:           state,
+				Plan:            plan,
+			}
+			resp := &schema.ModifyAttributePlanResponse{
+				AttributePlan: planVal,
+			}
+			modifier.Modify(ctx, req, resp)
+			for _, diag := range resp.Diagnostics {
+				if diag != nil && diag.Attribute == nil {
+					// copy the diagnostic rather than changing
+					// it, the modifier may reuse it
+					withPath := *diag
+					withPath.Attribute = path
+					diag = &withPath
+				}
+				*diags = append(*diags, diag)
+			}
+			if diagsHasErrors(resp.Diagnostics) {
+				return val, nil
+			}
+			if changes != nil && resp.AttributePlan != nil && !resp.AttributePlan.Equal(planVal) {
+				changes[path.String()] = append(changes[path.String()], modifier.Description(ctx))
+			}
+			planVal = resp.AttributePlan
+			if resp.RequiresReplace && !replaces {
+				replaces = true
+				*requiresReplace = append(*requiresReplace, path)
+			}
+		}
+		if planVal == nil {
+			return tftypes.Value{}, fmt.Errorf("plan modifier set a nil plan for %s", path)
+		}
+		rawPlan, err := planVal.ToTerraformValue(ctx)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("error converting modified plan: %w", err)
+		}
+		newVal, err := reflect.NewTerraformValue(attrType.TerraformType(ctx), rawPlan)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("plan modifier set an invalid plan: %w", err)
+		}
+		return newVal, nil
+	}
+}
+
+// planModifiersAtPath returns the plan modifiers to run for the value at
+// `path` in a plan for `resourceSchema`: the PlanModifiers of the attribute at
+// `path`, or the ElementPlanModifiers of its parent if `path` is an element of
+// a collection. Nested attribute groups have no plan modifiers of their own.
+func planModifiersAtPath(resourceSchema schema.Schema, path *tftypes.AttributePath) ([]schema.AttributePlanModifier, error) {
+	rawAttribute, _, err := tftypes.WalkAttributePath(resourceSchema, path)
+	if err != nil {
+		if _, err := resourceSchema.AttributeAtPath(path); errors.Is(err, schema.ErrPathInsideAtomicAttribute) {
+			// values nested inside a dynamic attribute aren't
+			// described by the schema, so they have no modifiers
+			return nil, nil
+		}
+		return nil, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+	}
+	if attribute, ok := rawAttribute.(schema.Attribute); ok {
+		return attribute.PlanModifiers, nil
+	}
+	steps := path.Steps()
+	if _, ok := steps[len(steps)-1].(tftypes.AttributeName); ok || len(steps) < 2 {
+		return nil, nil
+	}
+	rawParent, _, err := tftypes.WalkAttributePath(resourceSchema, path.WithoutLastStep())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+	}
+	if parent, ok := rawParent.(schema.Attribute); ok {
+		return parent.ElementPlanModifiers, nil
+	}
+	return nil, nil
+}
+
+// attributeValueAtPath returns the value at `path` in `val` as an attr.Value
+// of type `attrType`. If `val` has no value at `path`, because `val` or one
+// of the values containing `path` is null, a null value is returned.
+func attributeValueAtPath(ctx context.Context, attrType attr.Type, val tftypes.Value, path *tftypes.AttributePath) (attr.Value, error) {
+	raw, _, err := tftypes.WalkAttributePath(val, path)
+	if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
+		return nil, err
+	}
+	tfVal, ok := raw.(tftypes.Value)
+	if err != nil || !ok {
+		tfVal = tftypes.NewValue(attrType.TerraformType(ctx), nil)
+	}
+	return attrType.ValueFromTerraform(ctx, tfVal)
+}
+
 func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	resp := &tfprotov6.PlanResourceChangeResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
 
 	// get the type of resource, so we can get its schema and create an
 	// instance
@@ -404,7 +962,7 @@ func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 
 	// get the schema from the resource type, so we can embed it in the
 	// config and plan
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
 	resp.Diagnostics = append(resp.Diagnostics, diags...)
 	if diagsHasErrors(resp.Diagnostics) {
 		return resp, nil
@@ -419,12 +977,73 @@ func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		})
 		return resp, nil
 	}
+	plan, diags = s.decodeState(ctx, req.TypeName, resourceSchema, plan)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	config, err := req.Config.Unmarshal(resourceSchema.TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error parsing config",
+			Detail:   "There was an unexpected error parsing the config. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+	priorState, err := req.PriorState.Unmarshal(resourceSchema.TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error parsing prior state",
+			Detail:   "There was an unexpected error parsing the prior state. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+	priorState, diags = s.decodeState(ctx, req.TypeName, resourceSchema, priorState)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
 
 	if plan.IsNull() || !plan.IsKnown() {
+		if plan.IsNull() && skipsDelete(ctx, resourceType) {
+			resp.Diagnostics = append(resp.Diagnostics, skipDeleteDiagnostic(req.TypeName, "will only be removed"))
+		}
+		if plan.IsNull() {
+			// resources can still check a destroy plan, even
+			// though they can't change it
+			_, _, diags := s.modifyResourcePlan(ctx, req, resourceType, resourceSchema, config, priorState, plan, nil)
+			resp.Diagnostics = append(resp.Diagnostics, diags...)
+			if diagsHasErrors(resp.Diagnostics) {
+				return resp, nil
+			}
+		}
 		// on null or unknown plans, just bail, we can't do anything
 		resp.PlannedState = req.ProposedNewState
 		return resp, nil
 	}
+	plan, err = copySupersededValues(resourceSchema, config, plan)
+	if err == nil {
+		config, err = copySupersededValues(resourceSchema, config, config)
+	}
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   "There was an unexpected error copying the values of renamed attributes. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+	plan, err = normalizeEmptyObjects(ctx, resourceSchema, plan, true)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   "There was an unexpected error normalizing the empty objects in the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
 	modifiedPlan, err := tftypes.Transform(plan, markComputedNilsAsUnknown(ctx, resourceSchema))
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
@@ -435,7 +1054,55 @@ func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		return resp, nil
 	}
 
-	plannedState, err := tfprotov6.NewDynamicValue(modifiedPlan.Type(), modifiedPlan)
+	var requiresReplace []*tftypes.AttributePath
+	var changes planModifierChanges
+	if s.explainPlans {
+		changes = planModifierChanges{}
+	}
+	modifiedPlan, err = tftypes.Transform(modifiedPlan, runAttributePlanModifiers(ctx, resourceSchema, config, priorState, modifiedPlan, &resp.Diagnostics, &requiresReplace, changes))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error modifying plan",
+			Detail:   "There was an unexpected error running plan modifiers. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+
+	if createOnly, ok := resourceType.(ResourceTypeWithCreateOnly); ok && createOnly.CreateOnly(ctx) && !priorState.IsNull() {
+		resp.RequiresReplace, err = changedConfiguredAttributes(resourceSchema, config, priorState, modifiedPlan)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error modifying plan",
+				Detail:   "There was an unexpected error finding the attributes that require replacement. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+	}
+	if !priorState.IsNull() {
+		resp.RequiresReplace = appendMissingPaths(resp.RequiresReplace, requiresReplace...)
+	}
+
+	attributesPlan := modifiedPlan
+	modifiedPlan, resp.RequiresReplace, diags = s.modifyResourcePlan(ctx, req, resourceType, resourceSchema, config, priorState, modifiedPlan, resp.RequiresReplace)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	if s.explainPlans {
+		logPlanExplanation(ctx, req.TypeName, resourceSchema, config, attributesPlan, modifiedPlan, changes)
+	}
+
+	modifiedPlan, diags = s.encodeState(ctx, req.TypeName, resourceSchema, modifiedPlan)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	plannedState, err := s.newDynamicValue(modifiedPlan.Type(), modifiedPlan)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
 			Severity: tfprotov6.DiagnosticSeverityError,
@@ -445,12 +1112,92 @@ func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		return resp, nil
 	}
 	resp.PlannedState = &plannedState
-
-	// TODO: implement customizable plan modifications later
-	// TODO: implement RequiresReplace behavior later
 	return resp, nil
 }
 
+// modifyResourcePlan calls the ModifyPlan method of the resource, if it
+// implements ResourceWithModifyPlan, returning the plan and the paths that
+// require replacement it chose. Changes to a null plan, which destroys the
+// resource, are ignored.
+func (s *server) modifyResourcePlan(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest, resourceType ResourceType, resourceSchema schema.Schema, config, priorState, plan tftypes.Value, requiresReplace []*tftypes.AttributePath) (tftypes.Value, []*tftypes.AttributePath, []*tfprotov6.Diagnostic) {
+	resource, diags := resourceType.NewResource(ctx, s.p)
+	if diagsHasErrors(diags) {
+		return plan, requiresReplace, diags
+	}
+	modifier, ok := resource.(ResourceWithModifyPlan)
+	if !ok {
+		return plan, requiresReplace, diags
+	}
+
+	modifyReq := ModifyResourcePlanRequest{
+		Config: Config{
+			Schema: resourceSchema,
+			Raw:    config,
+		},
+		State: State{
+			Schema: resourceSchema,
+			Raw:    priorState,
+		},
+		Plan: Plan{
+			Schema: resourceSchema,
+			Raw:    plan,
+		},
+		Action: planAction(priorState, plan, requiresReplace),
+		Raw: RawRequest{
+			Config:       req.Config,
+			State:        req.PriorState,
+			Plan:         req.ProposedNewState,
+			ProviderMeta: req.ProviderMeta,
+		},
+	}
+	if pm, ok := s.p.(ProviderWithProviderMeta); ok {
+		pmSchema, pmDiags := pm.GetMetaSchema(ctx)
+		if pmDiags != nil {
+			diags = append(diags, pmDiags...)
+			if diagsHasErrors(diags) {
+				return plan, requiresReplace, diags
+			}
+		}
+		modifyReq.ProviderMeta = Config{
+			Schema: pmSchema,
+			Raw:    tftypes.NewValue(pmSchema.TerraformType(ctx), nil),
+		}
+
+		if req.ProviderMeta != nil {
+			pmValue, err := req.ProviderMeta.Unmarshal(pmSchema.TerraformType(ctx))
+			if err != nil {
+				return plan, requiresReplace, append(diags, &tfprotov6.Diagnostic{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error parsing provider_meta",
+					Detail:   "There was an error parsing the provider_meta block. Please report this to the provider developer:\n\n" + err.Error(),
+				})
+			}
+			modifyReq.ProviderMeta.Raw = pmValue
+		}
+	}
+	modifyResp := ModifyResourcePlanResponse{
+		Plan:            modifyReq.Plan,
+		RequiresReplace: requiresReplace,
+		Diagnostics:     diags,
+	}
+	modifier.ModifyPlan(ctx, modifyReq, &modifyResp)
+	if modifyReq.Action == PlanActionDestroy || diagsHasErrors(modifyResp.Diagnostics) {
+		return plan, requiresReplace, modifyResp.Diagnostics
+	}
+	if modifyResp.Plan.Raw.Type() == nil || !modifyResp.Plan.Raw.Type().Is(plan.Type()) || modifyResp.Plan.Raw.IsNull() {
+		return plan, requiresReplace, append(modifyResp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Invalid plan modification",
+			Detail:   "The resource set a plan that isn't a value of the resource's type, or that is null. This is always a problem with the provider. Please report this to the provider developer.",
+		})
+	}
+	if priorState.IsNull() {
+		// there's nothing to replace when creating a resource
+		return modifyResp.Plan.Raw, nil, modifyResp.Diagnostics
+	}
+	return modifyResp.Plan.Raw, modifyResp.RequiresReplace, modifyResp.Diagnostics
+}
+
 func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	resp := &tfprotov6.ApplyResourceChangeResponse{
@@ -458,6 +1205,14 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		// we choose to change it
 		NewState: req.PriorState,
 	}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
+
+	release, diags := s.acquireOperation(ctx, limitedType{name: req.TypeName})
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	defer release()
 
 	// get the type of resource, so we can get its scheman and create an
 	// instance
@@ -469,7 +1224,7 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 
 	// get the schema from the resource type, so we can embed it in the
 	// config and plan
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
 	resp.Diagnostics = append(resp.Diagnostics, diags...)
 	if diagsHasErrors(resp.Diagnostics) {
 		return resp, nil
@@ -492,6 +1247,15 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		})
 		return resp, nil
 	}
+	config, err = copySupersededValues(resourceSchema, config, config)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error parsing configuration",
+			Detail:   "An unexpected error was encountered copying the values of renamed attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
 
 	plan, err := req.PlannedState.Unmarshal(resourceSchema.TerraformType(ctx))
 	if err != nil {
@@ -502,6 +1266,11 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		})
 		return resp, nil
 	}
+	plan, diags = s.decodeState(ctx, req.TypeName, resourceSchema, plan)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
 
 	priorState, err := req.PriorState.Unmarshal(resourceSchema.TerraformType(ctx))
 	if err != nil {
@@ -512,6 +1281,11 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		})
 		return resp, nil
 	}
+	priorState, diags = s.decodeState(ctx, req.TypeName, resourceSchema, priorState)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
 
 	// figure out what kind of request we're serving
 	create, err := proto6.IsCreate(ctx, req, resourceSchema.TerraformType(ctx))
@@ -542,6 +1316,14 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		return resp, nil
 	}
 
+	if !destroy {
+		config, diags = handleConfigUnknowns(ctx, req.TypeName, resourceType, config)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(resp.Diagnostics) {
+			return resp, nil
+		}
+	}
+
 	switch {
 	case create && !update && !destroy:
 		createReq := CreateResourceRequest{
@@ -553,6 +1335,11 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 				Schema: resourceSchema,
 				Raw:    plan,
 			},
+			Raw: RawRequest{
+				Config:       req.Config,
+				Plan:         req.PlannedState,
+				ProviderMeta: req.ProviderMeta,
+			},
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -583,16 +1370,66 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		createResp := CreateResourceResponse{
 			State: State{
 				Schema: resourceSchema,
+				Raw:    plan,
 			},
 			Diagnostics: resp.Diagnostics,
 		}
-		resource.Create(ctx, createReq, &createResp)
+		createCtx, cancel, timeout, diags := withResourceTimeout(ctx, resourceType, timeoutCreate, config)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(resp.Diagnostics) {
+			return resp, nil
+		}
+		defer cancel()
+		createResp.Diagnostics = resp.Diagnostics
+		resource.Create(createCtx, createReq, &createResp)
+		if diag := timeoutDiagnostic(createCtx, req.TypeName, timeoutCreate, timeout); diag != nil {
+			createResp.Diagnostics = append(createResp.Diagnostics, diag)
+		}
 		resp.Diagnostics = createResp.Diagnostics
-		// TODO: set partial state before returning error
 		if diagsHasErrors(resp.Diagnostics) {
+			partial, diags := s.partialState(ctx, req.TypeName, resourceSchema, createResp.checkpoint)
+			resp.Diagnostics = append(resp.Diagnostics, diags...)
+			if partial != nil {
+				resp.NewState = partial
+			}
+			return resp, nil
+		}
+		createResp.State.Raw, err = normalizeEmptyObjects(ctx, resourceSchema, createResp.State.Raw, false)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error normalizing create response",
+				Detail:   "An unexpected error was encountered when normalizing the empty objects in the create response. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		createResp.State.Raw, err = preserveListOrder(ctx, resourceSchema, plan, createResp.State.Raw)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error comparing create response lists",
+				Detail:   "An unexpected error was encountered when comparing the lists in the create response to the lists in the plan. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		createResp.State.Raw, err = preserveSemanticEquals(ctx, resourceSchema, plan, createResp.State.Raw)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error comparing create response values",
+				Detail:   "An unexpected error was encountered when comparing the values in the create response to the values in the plan. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		if s.checkApplyConsistency {
+			resp.Diagnostics = append(resp.Diagnostics, checkApplyConsistency(req.TypeName, plan, createResp.State.Raw)...)
+		}
+		createResp.State.Raw, diags = s.encodeState(ctx, req.TypeName, resourceSchema, createResp.State.Raw)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(diags) {
 			return resp, nil
 		}
-		newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), createResp.State.Raw)
+		newState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), createResp.State.Raw)
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
 				Severity: tfprotov6.DiagnosticSeverityError,
@@ -604,6 +1441,10 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		resp.NewState = &newState
 		return resp, nil
 	case !create && update && !destroy:
+		if createOnly, ok := resourceType.(ResourceTypeWithCreateOnly); ok && createOnly.CreateOnly(ctx) {
+			resp.Diagnostics = append(resp.Diagnostics, createOnlyUpdateDiagnostic(req.TypeName))
+			return resp, nil
+		}
 		updateReq := UpdateResourceRequest{
 			Config: Config{
 				Schema: resourceSchema,
@@ -617,6 +1458,12 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 				Schema: resourceSchema,
 				Raw:    priorState,
 			},
+			Raw: RawRequest{
+				Config:       req.Config,
+				State:        req.PriorState,
+				Plan:         req.PlannedState,
+				ProviderMeta: req.ProviderMeta,
+			},
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -647,16 +1494,66 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		updateResp := UpdateResourceResponse{
 			State: State{
 				Schema: resourceSchema,
+				Raw:    plan,
 			},
 			Diagnostics: resp.Diagnostics,
 		}
-		resource.Update(ctx, updateReq, &updateResp)
+		updateCtx, cancel, timeout, diags := withResourceTimeout(ctx, resourceType, timeoutUpdate, config)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(resp.Diagnostics) {
+			return resp, nil
+		}
+		defer cancel()
+		updateResp.Diagnostics = resp.Diagnostics
+		resource.Update(updateCtx, updateReq, &updateResp)
+		if diag := timeoutDiagnostic(updateCtx, req.TypeName, timeoutUpdate, timeout); diag != nil {
+			updateResp.Diagnostics = append(updateResp.Diagnostics, diag)
+		}
 		resp.Diagnostics = updateResp.Diagnostics
-		// TODO: set partial state before returning error
 		if diagsHasErrors(resp.Diagnostics) {
+			partial, diags := s.partialState(ctx, req.TypeName, resourceSchema, updateResp.checkpoint)
+			resp.Diagnostics = append(resp.Diagnostics, diags...)
+			if partial != nil {
+				resp.NewState = partial
+			}
+			return resp, nil
+		}
+		updateResp.State.Raw, err = normalizeEmptyObjects(ctx, resourceSchema, updateResp.State.Raw, false)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error normalizing update response",
+				Detail:   "An unexpected error was encountered when normalizing the empty objects in the update response. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		updateResp.State.Raw, err = preserveListOrder(ctx, resourceSchema, plan, updateResp.State.Raw)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error comparing update response lists",
+				Detail:   "An unexpected error was encountered when comparing the lists in the update response to the lists in the plan. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		updateResp.State.Raw, err = preserveSemanticEquals(ctx, resourceSchema, plan, updateResp.State.Raw)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error comparing update response values",
+				Detail:   "An unexpected error was encountered when comparing the values in the update response to the values in the plan. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+			})
+			return resp, nil
+		}
+		if s.checkApplyConsistency {
+			resp.Diagnostics = append(resp.Diagnostics, checkApplyConsistency(req.TypeName, plan, updateResp.State.Raw)...)
+		}
+		updateResp.State.Raw, diags = s.encodeState(ctx, req.TypeName, resourceSchema, updateResp.State.Raw)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(diags) {
 			return resp, nil
 		}
-		newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), updateResp.State.Raw)
+		newState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), updateResp.State.Raw)
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
 				Severity: tfprotov6.DiagnosticSeverityError,
@@ -667,11 +1564,29 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		}
 		resp.NewState = &newState
 	case !create && !update && destroy:
+		if skipsDelete(ctx, resourceType) {
+			resp.Diagnostics = append(resp.Diagnostics, skipDeleteDiagnostic(req.TypeName, "was only removed"))
+			newState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), tftypes.NewValue(resourceSchema.TerraformType(ctx), nil))
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error converting delete response",
+					Detail:   "An unexpected error was encountered when converting the delete response to a usable type. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+				})
+				return resp, nil
+			}
+			resp.NewState = &newState
+			return resp, nil
+		}
 		destroyReq := DeleteResourceRequest{
 			State: State{
 				Schema: resourceSchema,
 				Raw:    priorState,
 			},
+			Raw: RawRequest{
+				State:        req.PriorState,
+				ProviderMeta: req.ProviderMeta,
+			},
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -705,13 +1620,23 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 			},
 			Diagnostics: resp.Diagnostics,
 		}
-		resource.Delete(ctx, destroyReq, &destroyResp)
+		deleteCtx, cancel, timeout, diags := withResourceTimeout(ctx, resourceType, timeoutDelete, priorState)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+		if diagsHasErrors(resp.Diagnostics) {
+			return resp, nil
+		}
+		defer cancel()
+		destroyResp.Diagnostics = resp.Diagnostics
+		resource.Delete(deleteCtx, destroyReq, &destroyResp)
+		if diag := timeoutDiagnostic(deleteCtx, req.TypeName, timeoutDelete, timeout); diag != nil {
+			destroyResp.Diagnostics = append(destroyResp.Diagnostics, diag)
+		}
 		resp.Diagnostics = destroyResp.Diagnostics
 		// TODO: set partial state before returning error
 		if diagsHasErrors(resp.Diagnostics) {
 			return resp, nil
 		}
-		newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), destroyResp.State.Raw)
+		newState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), destroyResp.State.Raw)
 		if err != nil {
 			resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
 				Severity: tfprotov6.DiagnosticSeverityError,
@@ -732,25 +1657,160 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 	return resp, nil
 }
 
-func (s *server) ImportResourceState(ctx context.Context, _ *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
-	// uncomment when we implement this function
-	//ctx = s.registerContext(ctx)
+func (s *server) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx = s.registerContext(ctx)
+	resp := &tfprotov6.ImportResourceStateResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
+
+	release, diags := s.acquireOperation(ctx, limitedType{name: req.TypeName})
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	defer release()
+
+	resourceType, diags := s.getResourceType(ctx, req.TypeName)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	resourceSchema, diags := resourceTypeSchema(ctx, resourceType)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	resource, diags := resourceType.NewResource(ctx, s.p)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	importer, ok := resource.(ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Resource import not implemented",
+			Detail:   fmt.Sprintf("The %q resource doesn't support import. Please contact the provider developer for more information.", req.TypeName),
+		})
+		return resp, nil
+	}
+
+	// start from an object with every attribute null, rather than a null
+	// object, so the resource can set individual attributes
+	emptyState := nullAttributesValue(resourceSchema.TerraformType(ctx))
+	importReq := ImportResourceStateRequest{
+		ID: req.ID,
+	}
+	importResp := ImportResourceStateResponse{
+		State: State{
+			Raw:    emptyState,
+			Schema: resourceSchema,
+		},
+		Diagnostics: resp.Diagnostics,
+	}
+	importer.ImportState(ctx, importReq, &importResp)
+	resp.Diagnostics = importResp.Diagnostics
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	if importResp.State.Raw.IsNull() || importResp.State.Raw.Equal(emptyState) {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Missing resource import state",
+			Detail:   "The resource's import didn't set any state, so Terraform has nothing to import. This is always a problem with the provider. Please report this to the provider developer.",
+		})
+		return resp, nil
+	}
 
-	// TODO: support resource importing
-	return &tfprotov6.ImportResourceStateResponse{}, nil
+	importResp.State.Raw, diags = s.encodeState(ctx, req.TypeName, resourceSchema, importResp.State.Raw)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	importedState, err := s.newDynamicValue(resourceSchema.TerraformType(ctx), importResp.State.Raw)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error converting imported state",
+			Detail:   "An unexpected error was encountered when converting the imported state to a usable type. This is always a problem with the provider. Please give the following information to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
+	resp.ImportedResources = []*tfprotov6.ImportedResource{
+		{
+			TypeName: req.TypeName,
+			State:    &importedState,
+		},
+	}
+	return resp, nil
 }
 
-func (s *server) ValidateDataResourceConfig(ctx context.Context, _ *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
-	// uncomment when we implement this function
-	// ctx = s.registerContext(ctx)
+// nullAttributesValue returns a known value of the object type `typ`, with
+// every attribute set to null.
+func nullAttributesValue(typ tftypes.Type) tftypes.Value {
+	obj := typ.(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(obj.AttributeTypes))
+	for name, attrType := range obj.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tftypes.NewValue(typ, attrs)
+}
+
+func (s *server) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	ctx = s.registerContext(ctx)
+	resp := &tfprotov6.ValidateDataResourceConfigResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
+
+	dataSourceType, diags := s.getDataSourceType(ctx, req.TypeName)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	dataSourceSchema, diags := dataSourceType.GetSchema(ctx)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	if req.Config == nil {
+		return resp, nil
+	}
+	config, err := req.Config.Unmarshal(dataSourceSchema.TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error parsing config",
+			Detail:   "There was an unexpected error parsing the config. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+		return resp, nil
+	}
 
-	// TODO: support validation
-	return &tfprotov6.ValidateDataResourceConfigResponse{}, nil
+	// TODO: support validation beyond attr.TypeWithValidate
+	diags, err = validateAttributeTypes(ctx, dataSourceSchema, config)
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if err == nil {
+		diags, err = validateReadOnlyAttributes(dataSourceSchema, config)
+		resp.Diagnostics = append(resp.Diagnostics, diags...)
+	}
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error validating config",
+			Detail:   "There was an unexpected error validating the config. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+		})
+	}
+	return resp, nil
 }
 
 func (s *server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	resp := &tfprotov6.ReadDataSourceResponse{}
+	defer s.addRequestIDToDiagnostics(ctx, &resp.Diagnostics)
+
+	release, diags := s.acquireOperation(ctx, limitedType{dataSource: true, name: req.TypeName})
+	resp.Diagnostics = append(resp.Diagnostics, diags...)
+	if diagsHasErrors(resp.Diagnostics) {
+		return resp, nil
+	}
+	defer release()
 
 	dataSourceType, diags := s.getDataSourceType(ctx, req.TypeName)
 	resp.Diagnostics = append(resp.Diagnostics, diags...)
@@ -781,6 +1841,10 @@ func (s *server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSour
 			Raw:    config,
 			Schema: dataSourceSchema,
 		},
+		Raw: RawRequest{
+			Config:       req.Config,
+			ProviderMeta: req.ProviderMeta,
+		},
 	}
 	if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 		pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -808,18 +1872,40 @@ func (s *server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSour
 			readReq.ProviderMeta.Raw = pmValue
 		}
 	}
+
+	var cacheTTL time.Duration
+	var cacheKey dataSourceReadCacheKey
+	if cached, ok := dataSource.(DataSourceWithReadCache); ok {
+		cacheTTL = cached.ReadCacheTTL(ctx)
+	}
+	if cacheTTL > 0 {
+		cacheKey, err = readCacheKey(ctx, req.TypeName, dataSourceSchema, config)
+		if err != nil {
+			// the data source can still be read, its result just
+			// can't be cached
+			logf(ctx, "WARN", "couldn't cache the result of reading data source %q: %s", req.TypeName, err)
+			cacheTTL = 0
+		} else if state, diags, ok := s.readCache.get(cacheKey, config, readReq.ProviderMeta.Raw); ok {
+			logf(ctx, "DEBUG", "using the cached result of reading data source %q", req.TypeName)
+			resp.Diagnostics = append(resp.Diagnostics, diags...)
+			resp.State = state
+			return resp, nil
+		}
+	}
+
 	readResp := ReadDataSourceResponse{
 		State: State{
 			Schema: dataSourceSchema,
 		},
 		Diagnostics: resp.Diagnostics,
 	}
+	priorDiags := len(resp.Diagnostics)
 	dataSource.Read(ctx, readReq, &readResp)
 	resp.Diagnostics = readResp.Diagnostics
 	// don't return even if we have error diagnostics, we need to set the
 	// state on the response, first
 
-	state, err := tfprotov6.NewDynamicValue(dataSourceSchema.TerraformType(ctx), readResp.State.Raw)
+	state, err := s.newDynamicValue(dataSourceSchema.TerraformType(ctx), readResp.State.Raw)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
 			Severity: tfprotov6.DiagnosticSeverityError,
@@ -829,5 +1915,9 @@ func (s *server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSour
 		return resp, nil
 	}
 	resp.State = &state
+	if cacheTTL > 0 && !diagsHasErrors(resp.Diagnostics) {
+		diags := append([]*tfprotov6.Diagnostic(nil), resp.Diagnostics[priorDiags:]...)
+		s.readCache.put(cacheKey, config, readReq.ProviderMeta.Raw, &state, diags, cacheTTL)
+	}
 	return resp, nil
 }
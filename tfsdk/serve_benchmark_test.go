@@ -0,0 +1,89 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// benchmarkLargeState returns a state for resource two with `disks` disks.
+func benchmarkLargeState(disks int) tftypes.Value {
+	diskType := testServeResourceTypeTwoType.AttributeTypes["disks"].(tftypes.List).ElementType
+	elems := make([]tftypes.Value, 0, disks)
+	for i := 0; i < disks; i++ {
+		elems = append(elems, tftypes.NewValue(diskType, map[string]tftypes.Value{
+			"boot":    tftypes.NewValue(tftypes.Bool, i == 0),
+			"name":    tftypes.NewValue(tftypes.String, fmt.Sprintf("disk-%d", i)),
+			"size_gb": tftypes.NewValue(tftypes.Number, i),
+		}))
+	}
+	return tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.String, "abc123"),
+		"disks": tftypes.NewValue(testServeResourceTypeTwoType.AttributeTypes["disks"], elems),
+	})
+}
+
+// BenchmarkDynamicValueRoundTrip measures decoding and re-encoding a large
+// state, the least work any RPC handling that state has to do. It is the
+// baseline for BenchmarkServerReadResourceLargeState.
+func BenchmarkDynamicValueRoundTrip(b *testing.B) {
+	for _, disks := range []int{1000, 10000} {
+		disks := disks
+		b.Run(fmt.Sprintf("disks-%d", disks), func(b *testing.B) {
+			dv, err := tfprotov6.NewDynamicValue(testServeResourceTypeTwoType, benchmarkLargeState(disks))
+			if err != nil {
+				b.Fatalf("Unexpected error: %s", err)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				state, err := dv.Unmarshal(testServeResourceTypeTwoType)
+				if err != nil {
+					b.Fatalf("Unexpected error: %s", err)
+				}
+				_, err = tfprotov6.NewDynamicValue(testServeResourceTypeTwoType, state)
+				if err != nil {
+					b.Fatalf("Unexpected error: %s", err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkServerReadResourceLargeState measures the server handling a
+// ReadResource request for a large state, with a Read that leaves the state
+// unchanged.
+func BenchmarkServerReadResourceLargeState(b *testing.B) {
+	for _, disks := range []int{1000, 10000} {
+		disks := disks
+		b.Run(fmt.Sprintf("disks-%d", disks), func(b *testing.B) {
+			testServer := &server{
+				p: &testServeProvider{
+					readResourceImpl: func(_ context.Context, _ ReadResourceRequest, _ *ReadResourceResponse) {},
+				},
+			}
+			dv, err := tfprotov6.NewDynamicValue(testServeResourceTypeTwoType, benchmarkLargeState(disks))
+			if err != nil {
+				b.Fatalf("Unexpected error: %s", err)
+			}
+			req := &tfprotov6.ReadResourceRequest{
+				TypeName:     "test_two",
+				CurrentState: &dv,
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				resp, err := testServer.ReadResource(context.Background(), req)
+				if err != nil {
+					b.Fatalf("Unexpected error: %s", err)
+				}
+				if len(resp.Diagnostics) > 0 {
+					b.Fatalf("Unexpected diagnostics: %+v", resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
@@ -86,5 +86,6 @@ func (r testServeDataSourceOne) Read(ctx context.Context, req ReadDataSourceRequ
 	r.provider.readDataSourceProviderMetaValue = req.ProviderMeta.Raw
 	r.provider.readDataSourceProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.readDataSourceCalledDataSourceType = "test_one"
+	r.provider.readDataSourceRaw = req.Raw
 	r.provider.readDataSourceImpl(ctx, req, resp)
 }
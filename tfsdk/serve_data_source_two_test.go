@@ -90,5 +90,6 @@ func (r testServeDataSourceTwo) Read(ctx context.Context, req ReadDataSourceRequ
 	r.provider.readDataSourceProviderMetaValue = req.ProviderMeta.Raw
 	r.provider.readDataSourceProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.readDataSourceCalledDataSourceType = "test_two"
+	r.provider.readDataSourceRaw = req.Raw
 	r.provider.readDataSourceImpl(ctx, req, resp)
 }
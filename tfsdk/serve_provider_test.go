@@ -16,15 +16,21 @@ type testServeProvider struct {
 	configuredVal       tftypes.Value
 	configuredSchema    schema.Schema
 	configuredTFVersion string
+	configuredRaw       RawRequest
 
 	// read resource request
 	readResourceCurrentStateValue  tftypes.Value
 	readResourceCurrentStateSchema schema.Schema
 	readResourceProviderMetaValue  tftypes.Value
 	readResourceProviderMetaSchema schema.Schema
+	readResourceRaw                RawRequest
 	readResourceImpl               func(context.Context, ReadResourceRequest, *ReadResourceResponse)
 	readResourceCalledResourceType string
 
+	// plan resource change
+	modifyPlanFunc        func(context.Context, ModifyResourcePlanRequest, *ModifyResourcePlanResponse)
+	planResourceChangeRaw RawRequest
+
 	// apply resource change
 	applyResourceChangeCalledResourceType string
 	applyResourceChangeCalledAction       string
@@ -39,6 +45,11 @@ type testServeProvider struct {
 	createFunc                            func(context.Context, CreateResourceRequest, *CreateResourceResponse)
 	updateFunc                            func(context.Context, UpdateResourceRequest, *UpdateResourceResponse)
 	deleteFunc                            func(context.Context, DeleteResourceRequest, *DeleteResourceResponse)
+	applyResourceChangeRaw                RawRequest
+
+	// import resource state
+	importStateCalledResourceType string
+	importStateFunc               func(context.Context, ImportResourceStateRequest, *ImportResourceStateResponse)
 
 	// read data source request
 	readDataSourceConfigValue          tftypes.Value
@@ -47,6 +58,7 @@ type testServeProvider struct {
 	readDataSourceProviderMetaSchema   schema.Schema
 	readDataSourceImpl                 func(context.Context, ReadDataSourceRequest, *ReadDataSourceResponse)
 	readDataSourceCalledDataSourceType string
+	readDataSourceRaw                  RawRequest
 }
 
 func (t *testServeProvider) GetSchema(_ context.Context) (schema.Schema, []*tfprotov6.Diagnostic) {
@@ -359,8 +371,9 @@ var testServeProviderProviderType = tftypes.Object{
 
 func (t *testServeProvider) GetResources(_ context.Context) (map[string]ResourceType, []*tfprotov6.Diagnostic) {
 	return map[string]ResourceType{
-		"test_one": testServeResourceTypeOne{},
-		"test_two": testServeResourceTypeTwo{},
+		"test_one":   testServeResourceTypeOne{},
+		"test_two":   testServeResourceTypeTwo{},
+		"test_three": testServeResourceTypeThree{},
 	}, nil
 }
 
@@ -375,6 +388,7 @@ func (t *testServeProvider) Configure(_ context.Context, req ConfigureProviderRe
 	t.configuredVal = req.Config.Raw
 	t.configuredSchema = req.Config.Schema
 	t.configuredTFVersion = req.TerraformVersion
+	t.configuredRaw = req.Raw
 }
 
 type testServeProviderWithMetaSchema struct {
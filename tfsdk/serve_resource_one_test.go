@@ -47,6 +47,10 @@ func (rt testServeResourceTypeOne) NewResource(_ context.Context, p Provider) (R
 	}, nil
 }
 
+func (rt testServeResourceTypeOne) AdditiveStateUpgrades(_ context.Context) bool {
+	return true
+}
+
 var testServeResourceTypeOneSchema = &tfprotov6.Schema{
 	Version: 1,
 	Block: &tfprotov6.SchemaBlock{
@@ -91,6 +95,7 @@ func (r testServeResourceOne) Create(ctx context.Context, req CreateResourceRequ
 	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.applyResourceChangeCalledResourceType = "test_one"
 	r.provider.applyResourceChangeCalledAction = "create"
+	r.provider.applyResourceChangeRaw = req.Raw
 	r.provider.createFunc(ctx, req, resp)
 }
 
@@ -99,6 +104,7 @@ func (r testServeResourceOne) Read(ctx context.Context, req ReadResourceRequest,
 	r.provider.readResourceCurrentStateSchema = req.State.Schema
 	r.provider.readResourceProviderMetaValue = req.ProviderMeta.Raw
 	r.provider.readResourceProviderMetaSchema = req.ProviderMeta.Schema
+	r.provider.readResourceRaw = req.Raw
 	r.provider.readResourceCalledResourceType = "test_one"
 	r.provider.readResourceImpl(ctx, req, resp)
 }
@@ -114,6 +120,7 @@ func (r testServeResourceOne) Update(ctx context.Context, req UpdateResourceRequ
 	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.applyResourceChangeCalledResourceType = "test_one"
 	r.provider.applyResourceChangeCalledAction = "update"
+	r.provider.applyResourceChangeRaw = req.Raw
 	r.provider.updateFunc(ctx, req, resp)
 }
 
@@ -124,5 +131,13 @@ func (r testServeResourceOne) Delete(ctx context.Context, req DeleteResourceRequ
 	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.applyResourceChangeCalledResourceType = "test_one"
 	r.provider.applyResourceChangeCalledAction = "delete"
+	r.provider.applyResourceChangeRaw = req.Raw
 	r.provider.deleteFunc(ctx, req, resp)
 }
+
+func (r testServeResourceOne) ModifyPlan(ctx context.Context, req ModifyResourcePlanRequest, resp *ModifyResourcePlanResponse) {
+	r.provider.planResourceChangeRaw = req.Raw
+	if r.provider.modifyPlanFunc != nil {
+		r.provider.modifyPlanFunc(ctx, req, resp)
+	}
+}
@@ -0,0 +1,135 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testServeResourceTypeThree struct{}
+
+func (rt testServeResourceTypeThree) GetSchema(_ context.Context) (schema.Schema, []*tfprotov6.Diagnostic) {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}.WithIDAttribute(), nil
+}
+
+func (rt testServeResourceTypeThree) NewResource(_ context.Context, p Provider) (Resource, []*tfprotov6.Diagnostic) {
+	provider, ok := p.(*testServeProvider)
+	if !ok {
+		prov, ok := p.(*testServeProviderWithMetaSchema)
+		if !ok {
+			panic(fmt.Sprintf("unexpected provider type %T", p))
+		}
+		provider = prov.testServeProvider
+	}
+	return testServeResourceThree{
+		provider: provider,
+	}, nil
+}
+
+func (rt testServeResourceTypeThree) SkipRead(_ context.Context) bool {
+	return true
+}
+
+func (rt testServeResourceTypeThree) CreateOnly(_ context.Context) bool {
+	return true
+}
+
+func (rt testServeResourceTypeThree) SkipDelete(_ context.Context) bool {
+	return true
+}
+
+var testServeResourceTypeThreeSchema = &tfprotov6.Schema{
+	Block: &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{
+				Name:            "id",
+				Computed:        true,
+				Type:            tftypes.String,
+				Description:     "The unique identifier of the resource.",
+				DescriptionKind: tfprotov6.StringKindPlain,
+			},
+			{
+				Name:     "name",
+				Required: true,
+				Type:     tftypes.String,
+			},
+		},
+	},
+}
+
+var testServeResourceTypeThreeType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"id":   tftypes.String,
+		"name": tftypes.String,
+	},
+}
+
+type testServeResourceThree struct {
+	provider *testServeProvider
+}
+
+func (r testServeResourceThree) Create(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+	r.provider.applyResourceChangePlannedStateValue = req.Plan.Raw
+	r.provider.applyResourceChangePlannedStateSchema = req.Plan.Schema
+	r.provider.applyResourceChangeConfigValue = req.Config.Raw
+	r.provider.applyResourceChangeConfigSchema = req.Config.Schema
+	r.provider.applyResourceChangeProviderMetaValue = req.ProviderMeta.Raw
+	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
+	r.provider.applyResourceChangeCalledResourceType = "test_three"
+	r.provider.applyResourceChangeCalledAction = "create"
+	r.provider.applyResourceChangeRaw = req.Raw
+	r.provider.createFunc(ctx, req, resp)
+}
+
+func (r testServeResourceThree) Read(ctx context.Context, req ReadResourceRequest, resp *ReadResourceResponse) {
+	r.provider.readResourceCurrentStateValue = req.State.Raw
+	r.provider.readResourceCurrentStateSchema = req.State.Schema
+	r.provider.readResourceProviderMetaValue = req.ProviderMeta.Raw
+	r.provider.readResourceProviderMetaSchema = req.ProviderMeta.Schema
+	r.provider.readResourceRaw = req.Raw
+	r.provider.readResourceCalledResourceType = "test_three"
+	r.provider.readResourceImpl(ctx, req, resp)
+}
+
+func (r testServeResourceThree) Update(ctx context.Context, req UpdateResourceRequest, resp *UpdateResourceResponse) {
+	r.provider.applyResourceChangePriorStateValue = req.State.Raw
+	r.provider.applyResourceChangePriorStateSchema = req.State.Schema
+	r.provider.applyResourceChangePlannedStateValue = req.Plan.Raw
+	r.provider.applyResourceChangePlannedStateSchema = req.Plan.Schema
+	r.provider.applyResourceChangeConfigValue = req.Config.Raw
+	r.provider.applyResourceChangeConfigSchema = req.Config.Schema
+	r.provider.applyResourceChangeProviderMetaValue = req.ProviderMeta.Raw
+	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
+	r.provider.applyResourceChangeCalledResourceType = "test_three"
+	r.provider.applyResourceChangeCalledAction = "update"
+	r.provider.applyResourceChangeRaw = req.Raw
+	r.provider.updateFunc(ctx, req, resp)
+}
+
+func (r testServeResourceThree) Delete(ctx context.Context, req DeleteResourceRequest, resp *DeleteResourceResponse) {
+	r.provider.applyResourceChangePriorStateValue = req.State.Raw
+	r.provider.applyResourceChangePriorStateSchema = req.State.Schema
+	r.provider.applyResourceChangeProviderMetaValue = req.ProviderMeta.Raw
+	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
+	r.provider.applyResourceChangeCalledResourceType = "test_three"
+	r.provider.applyResourceChangeCalledAction = "delete"
+	r.provider.applyResourceChangeRaw = req.Raw
+	r.provider.deleteFunc(ctx, req, resp)
+}
+
+func (r testServeResourceThree) ImportState(ctx context.Context, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	r.provider.importStateCalledResourceType = "test_three"
+	r.provider.importStateFunc(ctx, req, resp)
+}
@@ -57,6 +57,10 @@ func (rt testServeResourceTypeTwo) NewResource(_ context.Context, p Provider) (R
 	}, nil
 }
 
+func (rt testServeResourceTypeTwo) StrictState(_ context.Context) bool {
+	return true
+}
+
 var testServeResourceTypeTwoSchema = &tfprotov6.Schema{
 	Block: &tfprotov6.SchemaBlock{
 		Attributes: []*tfprotov6.SchemaAttribute{
@@ -121,6 +125,7 @@ func (r testServeResourceTwo) Create(ctx context.Context, req CreateResourceRequ
 	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.applyResourceChangeCalledResourceType = "test_two"
 	r.provider.applyResourceChangeCalledAction = "create"
+	r.provider.applyResourceChangeRaw = req.Raw
 	r.provider.createFunc(ctx, req, resp)
 }
 
@@ -129,6 +134,7 @@ func (r testServeResourceTwo) Read(ctx context.Context, req ReadResourceRequest,
 	r.provider.readResourceCurrentStateSchema = req.State.Schema
 	r.provider.readResourceProviderMetaValue = req.ProviderMeta.Raw
 	r.provider.readResourceProviderMetaSchema = req.ProviderMeta.Schema
+	r.provider.readResourceRaw = req.Raw
 	r.provider.readResourceCalledResourceType = "test_two"
 	r.provider.readResourceImpl(ctx, req, resp)
 }
@@ -144,6 +150,7 @@ func (r testServeResourceTwo) Update(ctx context.Context, req UpdateResourceRequ
 	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.applyResourceChangeCalledResourceType = "test_two"
 	r.provider.applyResourceChangeCalledAction = "update"
+	r.provider.applyResourceChangeRaw = req.Raw
 	r.provider.updateFunc(ctx, req, resp)
 }
 
@@ -154,5 +161,6 @@ func (r testServeResourceTwo) Delete(ctx context.Context, req DeleteResourceRequ
 	r.provider.applyResourceChangeProviderMetaSchema = req.ProviderMeta.Schema
 	r.provider.applyResourceChangeCalledResourceType = "test_two"
 	r.provider.applyResourceChangeCalledAction = "delete"
+	r.provider.applyResourceChangeRaw = req.Raw
 	r.provider.deleteFunc(ctx, req, resp)
 }
@@ -201,6 +201,172 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 	}
 }
 
+type testValidatingStringType struct {
+	attr.Type
+}
+
+func (t testValidatingStringType) Validate(_ context.Context, val tftypes.Value) []*tfprotov6.Diagnostic {
+	var str string
+	if err := val.As(&str); err != nil {
+		return nil
+	}
+	switch str {
+	case "invalid":
+		return []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Invalid string",
+				Detail:   "The string is invalid.",
+			},
+		}
+	case "invalid-with-path":
+		return []*tfprotov6.Diagnostic{
+			{
+				Severity:  tfprotov6.DiagnosticSeverityError,
+				Summary:   "Invalid string",
+				Detail:    "The string is invalid.",
+				Attribute: tftypes.NewAttributePath().WithAttributeName("other"),
+			},
+		}
+	}
+	return nil
+}
+
+type testSharedDiagnosticType struct {
+	attr.Type
+	diag *tfprotov6.Diagnostic
+}
+
+func (t testSharedDiagnosticType) Validate(_ context.Context, _ tftypes.Value) []*tfprotov6.Diagnostic {
+	return []*tfprotov6.Diagnostic{t.diag}
+}
+
+func TestValidateAttributeTypes_sharedDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	shared := &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Invalid string",
+	}
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"string": {
+				Type:     testSharedDiagnosticType{Type: types.StringType, diag: shared},
+				Required: true,
+			},
+		},
+	}
+	config := tftypes.NewValue(s.TerraformType(context.Background()), map[string]tftypes.Value{
+		"string": tftypes.NewValue(tftypes.String, "hello"),
+	})
+
+	got, err := validateAttributeTypes(context.Background(), s, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := []*tfprotov6.Diagnostic{
+		{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Invalid string",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("string"),
+		},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+	if shared.Attribute != nil {
+		t.Errorf("Expected the type's diagnostic to be unchanged, got attribute %s", shared.Attribute)
+	}
+}
+
+func TestValidateAttributeTypes(t *testing.T) {
+	t.Parallel()
+
+	validating := testValidatingStringType{types.StringType}
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"string": {
+				Type:     validating,
+				Required: true,
+			},
+			"string-with-path": {
+				Type:     validating,
+				Required: true,
+			},
+			"list": {
+				Type:     types.ListType{ElemType: validating},
+				Optional: true,
+			},
+			"nested": {
+				Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+					"string": {
+						Type:     validating,
+						Optional: true,
+					},
+				}, schema.ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+	nestedType := s.Attributes["nested"].Attributes.AttributeType().TerraformType(context.Background())
+	nestedElemType := nestedType.(tftypes.List).ElementType
+	config := tftypes.NewValue(s.TerraformType(context.Background()), map[string]tftypes.Value{
+		"string":           tftypes.NewValue(tftypes.String, "invalid"),
+		"string-with-path": tftypes.NewValue(tftypes.String, "invalid-with-path"),
+		"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "valid"),
+			tftypes.NewValue(tftypes.String, "invalid"),
+		}),
+		"nested": tftypes.NewValue(nestedType, []tftypes.Value{
+			tftypes.NewValue(nestedElemType, map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "invalid"),
+			}),
+		}),
+	})
+
+	got, err := validateAttributeTypes(context.Background(), s, config)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+
+	expected := map[string]*tfprotov6.Diagnostic{
+		tftypes.NewAttributePath().WithAttributeName("string").String(): {
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Invalid string",
+			Detail:    "The string is invalid.",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("string"),
+		},
+		tftypes.NewAttributePath().WithAttributeName("other").String(): {
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Invalid string",
+			Detail:    "The string is invalid.",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("other"),
+		},
+		tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).String(): {
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Invalid string",
+			Detail:    "The string is invalid.",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+		},
+		tftypes.NewAttributePath().WithAttributeName("nested").WithElementKeyInt(0).WithAttributeName("string").String(): {
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Invalid string",
+			Detail:    "The string is invalid.",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("nested").WithElementKeyInt(0).WithAttributeName("string"),
+		},
+	}
+	// the order diagnostics are returned in depends on the order
+	// attributes are walked in, so compare them keyed by path
+	gotByPath := map[string]*tfprotov6.Diagnostic{}
+	for _, diag := range got {
+		gotByPath[diag.Attribute.String()] = diag
+	}
+	if diff := cmp.Diff(gotByPath, expected); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
 func TestServerGetProviderSchema(t *testing.T) {
 	t.Parallel()
 
@@ -216,8 +382,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 	expected := &tfprotov6.GetProviderSchemaResponse{
 		Provider: testServeProviderProviderSchema,
 		ResourceSchemas: map[string]*tfprotov6.Schema{
-			"test_one": testServeResourceTypeOneSchema,
-			"test_two": testServeResourceTypeTwoSchema,
+			"test_one":   testServeResourceTypeOneSchema,
+			"test_two":   testServeResourceTypeTwoSchema,
+			"test_three": testServeResourceTypeThreeSchema,
 		},
 		DataSourceSchemas: map[string]*tfprotov6.Schema{
 			"test_one": testServeDataSourceTypeOneSchema,
@@ -244,8 +411,9 @@ func TestServerGetProviderSchemaWithProviderMeta(t *testing.T) {
 	expected := &tfprotov6.GetProviderSchemaResponse{
 		Provider: testServeProviderProviderSchema,
 		ResourceSchemas: map[string]*tfprotov6.Schema{
-			"test_one": testServeResourceTypeOneSchema,
-			"test_two": testServeResourceTypeTwoSchema,
+			"test_one":   testServeResourceTypeOneSchema,
+			"test_two":   testServeResourceTypeTwoSchema,
+			"test_three": testServeResourceTypeThreeSchema,
 		},
 		DataSourceSchemas: map[string]*tfprotov6.Schema{
 			"test_one": testServeDataSourceTypeOneSchema,
@@ -469,6 +637,163 @@ func TestServerConfigureProvider(t *testing.T) {
 				t.Errorf("Unexpected diff in schema (+wanted, -got): %s", diff)
 				return
 			}
+			if diff := cmp.Diff(s.configuredRaw, RawRequest{Config: &dv}); diff != "" {
+				t.Errorf("Unexpected diff in raw request (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerUpgradeResourceState(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		// request input
+		rawState []byte
+		version  int64
+		resource string
+
+		// response expectations
+		expectedUpgradedState     tftypes.Value
+		expectedUpgradedStateJSON []byte
+		expectedDiags             []*tfprotov6.Diagnostic
+	}
+
+	tests := map[string]testCase{
+		"one_unchanged": {
+			rawState: []byte(`{"name":"foo","favorite_colors":["red"],"created_timestamp":"now"}`),
+			version:  1,
+			resource: "test_one",
+
+			expectedUpgradedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
+			}),
+		},
+		"one_added_attributes": {
+			rawState: []byte(`{"name":"foo"}`),
+			version:  0,
+			resource: "test_one",
+
+			expectedUpgradedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"one_added_required_attribute": {
+			rawState: []byte(`{"favorite_colors":["red"]}`),
+			version:  0,
+			resource: "test_one",
+
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Error upgrading state",
+					Detail:    "The prior state, written with schema version 0, has no value for a required attribute in schema version 1. Automatic upgrades only support adding optional or computed attributes. This is always a problem with the provider. Please report this to the provider developer.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("name"),
+				},
+			},
+		},
+		"one_removed_attribute": {
+			rawState: []byte(`{"name":"foo","favorite_colors":null,"created_timestamp":null,"removed":"bar"}`),
+			version:  0,
+			resource: "test_one",
+
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error upgrading state",
+					Detail:   "The prior state, written with schema version 0, couldn't be automatically upgraded to schema version 1. Automatic upgrades only support adding optional or computed attributes. This is always a problem with the provider. Please report the following to the provider developer:\n\nElementKeyValue(tftypes.String<unknown>): unsupported attribute \"removed\"",
+				},
+			},
+		},
+		"two_passthrough": {
+			rawState: []byte(`{"id":"abc"}`),
+			version:  0,
+			resource: "test_two",
+
+			expectedUpgradedStateJSON: []byte(`{"id":"abc"}`),
+		},
+		"two_strict_unexpected_attributes": {
+			rawState: []byte(`{"id":"abc","removed":"bar","disks":[{"name":"root","size_gb":10,"boot":true,"encrypted":false}]}`),
+			version:  0,
+			resource: "test_two",
+
+			expectedUpgradedStateJSON: []byte(`{"disks":[{"boot":true,"name":"root","size_gb":10}],"id":"abc"}`),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Unexpected attribute in state",
+					Detail:    "The prior state has a value for an attribute that isn't in the resource's schema, so it was removed. This can happen when state is edited by hand. If it wasn't, this is a problem with the provider. Please report this to the provider developer.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("encrypted"),
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Unexpected attribute in state",
+					Detail:    "The prior state has a value for an attribute that isn't in the resource's schema, so it was removed. This can happen when state is edited by hand. If it wasn't, this is a problem with the provider. Please report this to the provider developer.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("removed"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &testServeProvider{}
+			testServer := &server{
+				p: s,
+			}
+
+			got, err := testServer.UpgradeResourceState(context.Background(), &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: tc.resource,
+				Version:  tc.version,
+				RawState: &tfprotov6.RawState{
+					JSON: tc.rawState,
+				},
+			})
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if diff := cmp.Diff(got.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+			if tc.expectedUpgradedStateJSON != nil {
+				if got.UpgradedState == nil {
+					t.Errorf("Expected upgraded state, got nil")
+					return
+				}
+				if string(got.UpgradedState.JSON) != string(tc.expectedUpgradedStateJSON) {
+					t.Errorf("Expected upgraded state to be %q, got %q", tc.expectedUpgradedStateJSON, got.UpgradedState.JSON)
+				}
+				return
+			}
+			if tc.expectedUpgradedState.Type() == nil {
+				if got.UpgradedState != nil {
+					t.Errorf("Expected no upgraded state, got %+v", got.UpgradedState)
+				}
+				return
+			}
+			if got.UpgradedState == nil {
+				t.Errorf("Expected upgraded state, got nil")
+				return
+			}
+			gotUpgradedState, err := got.UpgradedState.Unmarshal(testServeResourceTypeOneType)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if diff := cmp.Diff(gotUpgradedState, tc.expectedUpgradedState); diff != "" {
+				t.Errorf("Unexpected diff in upgraded state (+wanted, -got): %s", diff)
+			}
 		})
 	}
 }
@@ -814,6 +1139,10 @@ func TestServerReadResource(t *testing.T) {
 				t.Errorf("Unexpected diff in state schema (+wanted, -got): %s", diff)
 				return
 			}
+			if diff := cmp.Diff(s.readResourceRaw, RawRequest{State: req.CurrentState, ProviderMeta: req.ProviderMeta}); diff != "" {
+				t.Errorf("Unexpected diff in raw request (+wanted, -got): %s", diff)
+				return
+			}
 			if tc.providerMeta.Type() != nil {
 				if diff := cmp.Diff(s.readResourceProviderMetaValue, tc.providerMeta); diff != "" {
 					t.Errorf("Unexpected diff in provider meta (+wanted, -got): %s", diff)
@@ -841,6 +1170,51 @@ func TestServerReadResource(t *testing.T) {
 	}
 }
 
+func TestServerReadResourceSkipRead(t *testing.T) {
+	t.Parallel()
+
+	s := &testServeProvider{
+		readResourceImpl: func(_ context.Context, _ ReadResourceRequest, resp *ReadResourceResponse) {
+			resp.AddError("Read called", "Read should not have been called for a resource that skips reads.")
+		},
+	}
+	testServer := &server{
+		p: s,
+	}
+
+	currentState := tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "foo"),
+	})
+	dv, err := tfprotov6.NewDynamicValue(testServeResourceTypeThreeType, currentState)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+	got, err := testServer.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     "test_three",
+		CurrentState: &dv,
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+	if s.readResourceCalledResourceType != "" {
+		t.Errorf("Expected Read not to be called, called it for %q", s.readResourceCalledResourceType)
+	}
+	if len(got.Diagnostics) > 0 {
+		t.Errorf("Unexpected diags: %+v", got.Diagnostics)
+	}
+	gotNewState, err := got.NewState.Unmarshal(testServeResourceTypeThreeType)
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+		return
+	}
+	if diff := cmp.Diff(gotNewState, currentState); diff != "" {
+		t.Errorf("Unexpected diff in new state (+wanted, -got): %s", diff)
+	}
+}
+
 func TestServerPlanResourceChange(t *testing.T) {
 	t.Parallel()
 
@@ -899,6 +1273,84 @@ func TestServerPlanResourceChange(t *testing.T) {
 				"created_timestamp": tftypes.NewValue(tftypes.String, "when the earth was young"),
 			}),
 		},
+		"three_create": {
+			priorState: tftypes.NewValue(testServeResourceTypeThreeType, nil),
+			proposedNewState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			resource:     "test_three",
+			resourceType: testServeResourceTypeThreeType,
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+		},
+		"three_update_use_state_for_unknown": {
+			priorState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123456"),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			proposedNewState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "goodnight, moon"),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "goodnight, moon"),
+			}),
+			resource:     "test_three",
+			resourceType: testServeResourceTypeThreeType,
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123456"),
+				"name": tftypes.NewValue(tftypes.String, "goodnight, moon"),
+			}),
+			expectedRequiresReplace: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("name"),
+			},
+		},
+		"three_update_unchanged": {
+			priorState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123456"),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			proposedNewState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123456"),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			resource:     "test_three",
+			resourceType: testServeResourceTypeThreeType,
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123456"),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+		},
+		"three_delete": {
+			priorState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123456"),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			proposedNewState:     tftypes.NewValue(testServeResourceTypeThreeType, nil),
+			config:               tftypes.NewValue(testServeResourceTypeThreeType, nil),
+			resource:             "test_three",
+			resourceType:         testServeResourceTypeThreeType,
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeThreeType, nil),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Resource not deleted",
+					Detail:   "The \"test_three\" resource can't be deleted by Terraform, so it will only be removed from the Terraform state. It will continue to exist, and must be deleted outside of Terraform if it's no longer needed.",
+				},
+			},
+		},
 		"two_delete": {
 			priorState: tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
 				"id": tftypes.NewValue(tftypes.String, "123456"),
@@ -1016,6 +1468,278 @@ func TestServerPlanResourceChange(t *testing.T) {
 	}
 }
 
+func TestServerPlanResourceChangeModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	state := tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+		"name":              tftypes.NewValue(tftypes.String, "hello, world"),
+		"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"created_timestamp": tftypes.NewValue(tftypes.String, "when the earth was young"),
+	})
+	config := tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+		"name":              tftypes.NewValue(tftypes.String, "goodbye, world"),
+		"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+	})
+	null := tftypes.NewValue(testServeResourceTypeOneType, nil)
+
+	type testCase struct {
+		priorState tftypes.Value
+		config     tftypes.Value
+		plan       tftypes.Value
+
+		expectedAction          PlanAction
+		expectedPlannedState    tftypes.Value
+		expectedRequiresReplace []*tftypes.AttributePath
+		expectedDiags           []*tfprotov6.Diagnostic
+	}
+
+	tests := map[string]testCase{
+		"create": {
+			priorState: null,
+			config:     config,
+			plan:       config,
+
+			expectedAction: PlanActionCreate,
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "goodbye, world"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "modified"),
+			}),
+		},
+		"update": {
+			priorState: state,
+			config:     config,
+			plan:       config,
+
+			expectedAction: PlanActionUpdate,
+			expectedPlannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "goodbye, world"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "modified"),
+			}),
+			expectedRequiresReplace: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("name"),
+			},
+		},
+		"destroy": {
+			priorState: state,
+			config:     null,
+			plan:       null,
+
+			expectedAction:       PlanActionDestroy,
+			expectedPlannedState: null,
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Destroying",
+					Detail:   "The resource is being destroyed.",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotAction PlanAction
+			s := &testServeProvider{
+				modifyPlanFunc: func(ctx context.Context, req ModifyResourcePlanRequest, resp *ModifyResourcePlanResponse) {
+					gotAction = req.Action
+					if req.IsDestroy() {
+						resp.AddWarning("Destroying", "The resource is being destroyed.")
+						// changes to destroy plans are ignored
+						resp.Plan.Raw = state
+						return
+					}
+					err := resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("created_timestamp"), "modified")
+					if err != nil {
+						resp.AddError("Error setting attribute", err.Error())
+					}
+					resp.RequiresReplace = append(resp.RequiresReplace, tftypes.NewAttributePath().WithAttributeName("name"))
+				},
+			}
+			testServer := &server{
+				p: s,
+			}
+
+			priorStateDV, err := tfprotov6.NewDynamicValue(testServeResourceTypeOneType, tc.priorState)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			configDV, err := tfprotov6.NewDynamicValue(testServeResourceTypeOneType, tc.config)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			planDV, err := tfprotov6.NewDynamicValue(testServeResourceTypeOneType, tc.plan)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			got, err := testServer.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "test_one",
+				PriorState:       &priorStateDV,
+				ProposedNewState: &planDV,
+				Config:           &configDV,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if gotAction != tc.expectedAction {
+				t.Errorf("Expected action %s, got %s", tc.expectedAction, gotAction)
+			}
+			expectedRaw := RawRequest{Config: &configDV, State: &priorStateDV, Plan: &planDV}
+			if diff := cmp.Diff(s.planResourceChangeRaw, expectedRaw); diff != "" {
+				t.Errorf("Unexpected diff in raw request (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+			gotPlannedState, err := got.PlannedState.Unmarshal(testServeResourceTypeOneType)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(gotPlannedState, tc.expectedPlannedState); diff != "" {
+				t.Errorf("Unexpected diff in planned state (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got.RequiresReplace, tc.expectedRequiresReplace); diff != "" {
+				t.Errorf("Unexpected diff in requires replace (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanAction(t *testing.T) {
+	t.Parallel()
+
+	state := tftypes.NewValue(tftypes.String, "state")
+	plan := tftypes.NewValue(tftypes.String, "plan")
+	null := tftypes.NewValue(tftypes.String, nil)
+	replace := []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("name")}
+
+	if got := planAction(null, plan, nil); got != PlanActionCreate {
+		t.Errorf("Expected %s, got %s", PlanActionCreate, got)
+	}
+	if got := planAction(state, plan, nil); got != PlanActionUpdate {
+		t.Errorf("Expected %s, got %s", PlanActionUpdate, got)
+	}
+	if got := planAction(state, plan, replace); got != PlanActionReplace {
+		t.Errorf("Expected %s, got %s", PlanActionReplace, got)
+	}
+	if got := planAction(state, null, nil); got != PlanActionDestroy {
+		t.Errorf("Expected %s, got %s", PlanActionDestroy, got)
+	}
+}
+
+func TestServerApplyResourceChangeSkipDelete(t *testing.T) {
+	t.Parallel()
+
+	s := &testServeProvider{
+		deleteFunc: func(_ context.Context, _ DeleteResourceRequest, resp *DeleteResourceResponse) {
+			resp.AddError("Delete called", "Delete should not have been called for a resource that skips deletes.")
+		},
+	}
+	testServer := &server{
+		p: s,
+	}
+
+	priorState, err := tfprotov6.NewDynamicValue(testServeResourceTypeThreeType, tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "foo"),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	null, err := tfprotov6.NewDynamicValue(testServeResourceTypeThreeType, tftypes.NewValue(testServeResourceTypeThreeType, nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	got, err := testServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "test_three",
+		PriorState:   &priorState,
+		PlannedState: &null,
+		Config:       &null,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if s.applyResourceChangeCalledAction != "" {
+		t.Errorf("Expected Delete not to be called, called %q", s.applyResourceChangeCalledAction)
+	}
+	expectedDiags := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  "Resource not deleted",
+			Detail:   "The \"test_three\" resource can't be deleted by Terraform, so it was only removed from the Terraform state. It will continue to exist, and must be deleted outside of Terraform if it's no longer needed.",
+		},
+	}
+	if diff := cmp.Diff(got.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+	gotNewState, err := got.NewState.Unmarshal(testServeResourceTypeThreeType)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !gotNewState.IsNull() {
+		t.Errorf("Expected new state to be null, got %s", gotNewState)
+	}
+}
+
+func TestServerApplyResourceChangeCreateOnly(t *testing.T) {
+	t.Parallel()
+
+	s := &testServeProvider{
+		updateFunc: func(_ context.Context, _ UpdateResourceRequest, resp *UpdateResourceResponse) {
+			resp.AddError("Update called", "Update should not have been called for a resource that is create-only.")
+		},
+	}
+	testServer := &server{
+		p: s,
+	}
+
+	priorState, err := tfprotov6.NewDynamicValue(testServeResourceTypeThreeType, tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "foo"),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	plan, err := tfprotov6.NewDynamicValue(testServeResourceTypeThreeType, tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "bar"),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	got, err := testServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "test_three",
+		PriorState:   &priorState,
+		PlannedState: &plan,
+		Config:       &plan,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if s.applyResourceChangeCalledAction != "" {
+		t.Errorf("Expected Update not to be called, called %q", s.applyResourceChangeCalledAction)
+	}
+	expectedDiags := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Resource can't be updated",
+			Detail:   "The \"test_three\" resource can't be updated in place, but Terraform planned an update for it. This is always a problem with the provider. Please report this to the provider developer.",
+		},
+	}
+	if diff := cmp.Diff(got.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(got.NewState, &priorState); diff != "" {
+		t.Errorf("Expected the prior state to be kept (+wanted, -got): %s", diff)
+	}
+}
+
 func TestServerApplyResourceChange(t *testing.T) {
 	t.Parallel()
 
@@ -1125,6 +1849,96 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 		},
+		"one_create_checkpoint": {
+			plannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			resource:     "test_one",
+			action:       "create",
+			resourceType: testServeResourceTypeOneType,
+			create: func(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+				resp.State.Raw = req.Plan.Raw
+				resp.Checkpoint()
+				resp.State.Raw = tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "hello, world"),
+					"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "red"),
+					}),
+					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+				})
+				resp.AddError("Error setting colors", "The colors couldn't be set.")
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Error setting colors",
+					Detail:   "The colors couldn't be set.",
+				},
+			},
+		},
+		"three_create_set_id": {
+			plannedState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			resource:     "test_three",
+			action:       "create",
+			resourceType: testServeResourceTypeThreeType,
+			create: func(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+				if err := resp.State.SetID(ctx, "abc123"); err != nil {
+					resp.AddError("Error setting ID", err.Error())
+				}
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "abc123"),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+		},
+		"three_create_set_from_api": {
+			plannedState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+			resource:     "test_three",
+			action:       "create",
+			resourceType: testServeResourceTypeThreeType,
+			create: func(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+				apiObj := testAPIResponse{ID: "abc123"}
+				if err := resp.State.SetFromAPI(ctx, apiObj, FieldMap{{APIField: "ID", Attribute: "id"}}); err != nil {
+					resp.AddError("Error setting state", err.Error())
+				}
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "abc123"),
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+		},
 		"one_update": {
 			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "hello, world"),
@@ -1301,6 +2115,72 @@ func TestServerApplyResourceChange(t *testing.T) {
 				},
 			},
 		},
+		"one_update_checkpoint": {
+			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+			}),
+			plannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+					tftypes.NewValue(tftypes.String, "yellow"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+					tftypes.NewValue(tftypes.String, "yellow"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			resource:     "test_one",
+			action:       "update",
+			resourceType: testServeResourceTypeOneType,
+			update: func(ctx context.Context, req UpdateResourceRequest, resp *UpdateResourceResponse) {
+				resp.State.Raw = req.Plan.Raw
+				resp.Checkpoint()
+				resp.State.Raw = tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "hello, world"),
+					"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "red"),
+						tftypes.NewValue(tftypes.String, "orange"),
+						tftypes.NewValue(tftypes.String, "yellow"),
+					}),
+					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+				})
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Oops!",
+					Detail:    "This is an error! Don't update the state!",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("name"),
+				})
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "red"),
+					tftypes.NewValue(tftypes.String, "orange"),
+					tftypes.NewValue(tftypes.String, "yellow"),
+				}),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Oops!",
+					Detail:    "This is an error! Don't update the state!",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("name"),
+				},
+			},
+		},
 		"one_delete": {
 			priorState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "hello, world"),
@@ -2132,6 +3012,20 @@ func TestServerApplyResourceChange(t *testing.T) {
 				t.Errorf("Called wrong action. Expected to call %q, actually called %q", tc.action, s.applyResourceChangeCalledAction)
 				return
 			}
+			// each action only gets the values it has
+			var expectedRaw RawRequest
+			switch tc.action {
+			case "create":
+				expectedRaw = RawRequest{Config: req.Config, Plan: req.PlannedState, ProviderMeta: req.ProviderMeta}
+			case "update":
+				expectedRaw = RawRequest{Config: req.Config, State: req.PriorState, Plan: req.PlannedState, ProviderMeta: req.ProviderMeta}
+			case "delete":
+				expectedRaw = RawRequest{State: req.PriorState, ProviderMeta: req.ProviderMeta}
+			}
+			if diff := cmp.Diff(s.applyResourceChangeRaw, expectedRaw); diff != "" {
+				t.Errorf("Unexpected diff in raw request (+wanted, -got): %s", diff)
+				return
+			}
 			if tc.priorState.Type() != nil {
 				if diff := cmp.Diff(s.applyResourceChangePriorStateValue, tc.priorState); diff != "" {
 					t.Errorf("Unexpected diff in prior state (+wanted, -got): %s", diff)
@@ -2189,6 +3083,132 @@ func TestServerApplyResourceChange(t *testing.T) {
 	}
 }
 
+func TestServerImportResourceState(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		// request input
+		id       string
+		resource string
+
+		impl func(context.Context, ImportResourceStateRequest, *ImportResourceStateResponse)
+
+		// response expectations
+		expectedState tftypes.Value
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+
+	tests := map[string]testCase{
+		"three_set_id": {
+			id:       "abc123",
+			resource: "test_three",
+			impl: func(ctx context.Context, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+				err := resp.State.SetID(ctx, req.ID)
+				if err != nil {
+					resp.AddError("Error setting ID", err.Error())
+				}
+			},
+			expectedState: tftypes.NewValue(testServeResourceTypeThreeType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "abc123"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"three_diags": {
+			id:       "abc123",
+			resource: "test_three",
+			impl: func(ctx context.Context, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+				resp.AddAttributeWarning(tftypes.NewAttributePath().WithAttributeName("name"), "I'm warning you", "You have been warned.")
+				resp.AddError("Invalid ID", "The ID "+req.ID+" isn't valid.")
+			},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "I'm warning you",
+					Detail:    "You have been warned.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("name"),
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Invalid ID",
+					Detail:   "The ID abc123 isn't valid.",
+				},
+			},
+		},
+		"three_no_state": {
+			id:       "abc123",
+			resource: "test_three",
+			impl:     func(context.Context, ImportResourceStateRequest, *ImportResourceStateResponse) {},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Missing resource import state",
+					Detail:   "The resource's import didn't set any state, so Terraform has nothing to import. This is always a problem with the provider. Please report this to the provider developer.",
+				},
+			},
+		},
+		"one_not_implemented": {
+			id:       "abc123",
+			resource: "test_one",
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Resource import not implemented",
+					Detail:   "The \"test_one\" resource doesn't support import. Please contact the provider developer for more information.",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &testServeProvider{
+				importStateFunc: tc.impl,
+			}
+			testServer := &server{
+				p: s,
+			}
+
+			got, err := testServer.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+				TypeName: tc.resource,
+				ID:       tc.id,
+			})
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if diff := cmp.Diff(got.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+			if tc.impl != nil && s.importStateCalledResourceType != tc.resource {
+				t.Errorf("Called wrong resource. Expected to call %q, actually called %q", tc.resource, s.importStateCalledResourceType)
+			}
+			if tc.expectedState.Type() == nil {
+				if len(got.ImportedResources) != 0 {
+					t.Errorf("Expected no imported resources, got %+v", got.ImportedResources)
+				}
+				return
+			}
+			if len(got.ImportedResources) != 1 {
+				t.Fatalf("Expected one imported resource, got %+v", got.ImportedResources)
+			}
+			if got.ImportedResources[0].TypeName != tc.resource {
+				t.Errorf("Expected imported resource type %q, got %q", tc.resource, got.ImportedResources[0].TypeName)
+			}
+			gotState, err := got.ImportedResources[0].State.Unmarshal(testServeResourceTypeThreeType)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(gotState, tc.expectedState); diff != "" {
+				t.Errorf("Unexpected diff in imported state (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestServerReadDataSource(t *testing.T) {
 	t.Parallel()
 
@@ -2419,6 +3439,10 @@ func TestServerReadDataSource(t *testing.T) {
 				t.Errorf("Unexpected diff in config schema (+wanted, -got): %s", diff)
 				return
 			}
+			if diff := cmp.Diff(s.readDataSourceRaw, RawRequest{Config: req.Config, ProviderMeta: req.ProviderMeta}); diff != "" {
+				t.Errorf("Unexpected diff in raw request (+wanted, -got): %s", diff)
+				return
+			}
 			if tc.providerMeta.Type() != nil {
 				if diff := cmp.Diff(s.readDataSourceProviderMetaValue, tc.providerMeta); diff != "" {
 					t.Errorf("Unexpected diff in provider meta (+wanted, -got): %s", diff)
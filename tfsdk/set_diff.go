@@ -0,0 +1,126 @@
+package tfsdk
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SetElementChanges are the changes between the elements of two sets of
+// objects, like a resource's prior state and plan for an attribute using
+// schema.SetNestedAttributes, grouped by what the provider has to do to make
+// them. Elements are matched up by the values of their identity attributes.
+// Each group is sorted by the values of the identity attributes.
+type SetElementChanges struct {
+	// Create are the planned elements with no prior element with the
+	// same identity.
+	Create []tftypes.Value
+
+	// Update are the elements with the same identity in both sets that
+	// have changed.
+	Update []SetElementUpdate
+
+	// Delete are the prior elements with no planned element with the
+	// same identity.
+	Delete []tftypes.Value
+}
+
+// SetElementUpdate is an element of a set of objects that has changed.
+type SetElementUpdate struct {
+	Prior   tftypes.Value
+	Planned tftypes.Value
+}
+
+// DiffSetElements returns the changes between `prior` and `planned`, sets or
+// lists of objects, with elements identified by the values of their
+// `keyAttributes`, like the "name" of a rule or the "user_id" of a member.
+// Elements whose key attributes are equal but whose other attributes differ
+// are updates. Null sets are treated as empty. It returns an error if either
+// set is unknown, if an element's key attributes are unknown, or if two
+// elements of the same set have the same key.
+func DiffSetElements(prior, planned tftypes.Value, keyAttributes ...string) (SetElementChanges, error) {
+	var changes SetElementChanges
+	if len(keyAttributes) == 0 {
+		return changes, fmt.Errorf("at least one key attribute is required")
+	}
+	priorElems, priorKeys, err := setElementsByKey(prior, keyAttributes)
+	if err != nil {
+		return changes, fmt.Errorf("error reading prior set: %w", err)
+	}
+	plannedElems, plannedKeys, err := setElementsByKey(planned, keyAttributes)
+	if err != nil {
+		return changes, fmt.Errorf("error reading planned set: %w", err)
+	}
+	for _, key := range plannedKeys {
+		plannedElem := plannedElems[key]
+		priorElem, ok := priorElems[key]
+		switch {
+		case !ok:
+			changes.Create = append(changes.Create, plannedElem)
+		case !priorElem.Equal(plannedElem):
+			changes.Update = append(changes.Update, SetElementUpdate{
+				Prior:   priorElem,
+				Planned: plannedElem,
+			})
+		}
+	}
+	for _, key := range priorKeys {
+		if _, ok := plannedElems[key]; !ok {
+			changes.Delete = append(changes.Delete, priorElems[key])
+		}
+	}
+	return changes, nil
+}
+
+// setElementsByKey returns the elements of `set` mapped to their keys, and
+// the keys in order.
+func setElementsByKey(set tftypes.Value, keyAttributes []string) (map[string]tftypes.Value, []string, error) {
+	if !set.IsKnown() {
+		return nil, nil, fmt.Errorf("the set is unknown")
+	}
+	if set.IsNull() {
+		return nil, nil, nil
+	}
+	var elems []tftypes.Value
+	if err := set.As(&elems); err != nil {
+		return nil, nil, err
+	}
+	byKey := make(map[string]tftypes.Value, len(elems))
+	keys := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		key, err := setElementKey(elem, keyAttributes)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := byKey[key]; ok {
+			return nil, nil, fmt.Errorf("more than one element has the key %s", key)
+		}
+		byKey[key] = elem
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return byKey, keys, nil
+}
+
+// setElementKey returns a string identifying `elem`, an object, by the values
+// of its `keyAttributes`.
+func setElementKey(elem tftypes.Value, keyAttributes []string) (string, error) {
+	var attrs map[string]tftypes.Value
+	if err := elem.As(&attrs); err != nil {
+		return "", fmt.Errorf("elements must be objects: %w", err)
+	}
+	parts := make([]string, 0, len(keyAttributes))
+	for _, name := range keyAttributes {
+		val, ok := attrs[name]
+		if !ok {
+			return "", fmt.Errorf("elements have no %q attribute", name)
+		}
+		if !val.IsFullyKnown() {
+			return "", tftypes.NewAttributePath().WithAttributeName(name).NewErrorf("key attributes must be known")
+		}
+		parts = append(parts, val.String())
+	}
+	return strings.Join(parts, ", "), nil
+}
@@ -0,0 +1,89 @@
+package tfsdk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiffSetElements(t *testing.T) {
+	t.Parallel()
+
+	ruleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+		"port": tftypes.Number,
+	}}
+	setType := tftypes.Set{ElementType: ruleType}
+	rule := func(name interface{}, port int) tftypes.Value {
+		return tftypes.NewValue(ruleType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+			"port": tftypes.NewValue(tftypes.Number, port),
+		})
+	}
+	set := func(elems ...tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(setType, elems)
+	}
+
+	type testCase struct {
+		prior         tftypes.Value
+		planned       tftypes.Value
+		expected      SetElementChanges
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"changes": {
+			prior:   set(rule("ssh", 22), rule("http", 80), rule("dns", 53)),
+			planned: set(rule("http", 8080), rule("https", 443), rule("dns", 53), rule("db", 5432)),
+			expected: SetElementChanges{
+				Create: []tftypes.Value{rule("db", 5432), rule("https", 443)},
+				Update: []SetElementUpdate{
+					{Prior: rule("http", 80), Planned: rule("http", 8080)},
+				},
+				Delete: []tftypes.Value{rule("ssh", 22)},
+			},
+		},
+		"create-from-null": {
+			prior:   tftypes.NewValue(setType, nil),
+			planned: set(rule("ssh", 22)),
+			expected: SetElementChanges{
+				Create: []tftypes.Value{rule("ssh", 22)},
+			},
+		},
+		"unchanged": {
+			prior:   set(rule("ssh", 22)),
+			planned: set(rule("ssh", 22)),
+		},
+		"unknown-key": {
+			prior:         set(rule("ssh", 22)),
+			planned:       set(rule(tftypes.UnknownValue, 22)),
+			expectedError: `error reading planned set: AttributeName("name"): key attributes must be known`,
+		},
+		"duplicate-key": {
+			prior:         tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{rule("ssh", 22), rule("ssh", 2222)}),
+			planned:       set(),
+			expectedError: `error reading prior set: more than one element has the key tftypes.String<"ssh">`,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DiffSetElements(tc.prior, tc.planned, "name")
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Errorf("Expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.expected, got, cmp.Comparer(func(a, b tftypes.Value) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -2,11 +2,16 @@ package tfsdk
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -63,6 +68,9 @@ func (s *State) Set(ctx context.Context, val interface{}) error {
 
 // SetAttribute sets the attribute at `path` using the supplied Go value.
 func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, val interface{}) error {
+	if s.Raw.Type() == nil {
+		return errNoStateValue
+	}
 	attrType, err := s.Schema.AttributeTypeAtPath(path)
 	if err != nil {
 		return fmt.Errorf("error getting attribute type at path %s in schema: %w", path, err)
@@ -80,7 +88,7 @@ func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, v
 
 	transformFunc := func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
 		if p.Equal(path) {
-			return tftypes.NewValue(attrType.TerraformType(ctx), newTfVal), nil
+			return reflect.NewTerraformValue(attrType.TerraformType(ctx), newTfVal)
 		}
 		return v, nil
 	}
@@ -93,11 +101,161 @@ func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, v
 	return nil
 }
 
+// SetAttributes sets the attribute at each path in `values` to the
+// corresponding value, replacing all of them in a single pass over the state
+// rather than re-encoding the entire state once per attribute. Every value is
+// validated against the schema before any of them are set, so either all of
+// the attributes are set or, if an error is returned, none of them are. The
+// paths must all exist in the state, and no path may contain another.
+func (s *State) SetAttributes(ctx context.Context, values map[*tftypes.AttributePath]attr.Value) error {
+	if s.Raw.Type() == nil {
+		return errNoStateValue
+	}
+	newVals := make(map[string]tftypes.Value, len(values))
+	paths := make([]*tftypes.AttributePath, 0, len(values))
+	for path := range values {
+		paths = append(paths, path)
+	}
+	// check the paths in order, so the same error is always returned
+	sort.Slice(paths, func(i, j int) bool {
+		return order.PathLess(paths[i], paths[j])
+	})
+	for _, path := range paths {
+		val := values[path]
+		if val == nil {
+			return fmt.Errorf("can't set nil value at path %s", path)
+		}
+		attrType, err := s.Schema.AttributeTypeAtPath(path)
+		if err != nil {
+			return fmt.Errorf("error getting attribute type at path %s in schema: %w", path, err)
+		}
+		tfType := attrType.TerraformType(ctx)
+		newTfVal, err := val.ToTerraformValue(ctx)
+		if err != nil {
+			return fmt.Errorf("error running ToTerraformValue on new state value at path %s: %w", path, err)
+		}
+		newVal, err := reflect.NewTerraformValue(tfType, newTfVal)
+		if err != nil {
+			return fmt.Errorf("invalid new state value at path %s: %w", path, err)
+		}
+		if _, ok := newVals[path.String()]; ok {
+			return fmt.Errorf("path %s set more than once", path)
+		}
+		newVals[path.String()] = newVal
+	}
+	for _, path := range paths {
+		for _, other := range paths {
+			if path != other && pathHasPrefix(other, path) {
+				return fmt.Errorf("can't set both %s and %s, one contains the other", path, other)
+			}
+		}
+	}
+
+	applied := make(map[string]struct{}, len(newVals))
+	transformFunc := func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		newVal, ok := newVals[p.String()]
+		if !ok {
+			return v, nil
+		}
+		applied[p.String()] = struct{}{}
+		return newVal, nil
+	}
+
+	newState, err := tftypes.Transform(s.Raw, transformFunc)
+	if err != nil {
+		return fmt.Errorf("error setting attributes in state: %w", err)
+	}
+	for _, path := range paths {
+		if _, ok := applied[path.String()]; !ok {
+			return fmt.Errorf("error setting attributes in state: path %s not found in state", path)
+		}
+	}
+
+	s.Raw = newState
+	return nil
+}
+
+// pathHasPrefix returns true if `path` starts with all the steps in `prefix`.
+func pathHasPrefix(path, prefix *tftypes.AttributePath) bool {
+	pathSteps, prefixSteps := path.Steps(), prefix.Steps()
+	if len(prefixSteps) > len(pathSteps) {
+		return false
+	}
+	return tftypes.NewAttributePathWithSteps(pathSteps[:len(prefixSteps)]).Equal(prefix)
+}
+
+// errNoStateValue is returned when setting attributes in a State whose Raw
+// value was never set, as there is nothing to set them in.
+var errNoStateValue = errors.New("can't set an attribute in a state with no value, use Set to set the whole state first")
+
+// SetID sets the attribute added by schema.Schema.WithIDAttribute to `id`.
+// It is typically called on the response's State at the end of Create.
+func (s *State) SetID(ctx context.Context, id string) error {
+	return s.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName(schema.IDAttributeName), types.String{Value: id})
+}
+
+// ConvertUnknownsToNulls replaces any unknown values remaining in the state
+// with null values. It should be called at the end of Create or Update when
+// the resource legitimately can't determine some values that were unknown in
+// the plan, as Terraform doesn't accept unknown values in state after apply.
+// A warning diagnostic is returned for each attribute that was converted, so
+// practitioners know which values were left unset.
+func (s *State) ConvertUnknownsToNulls(ctx context.Context) ([]*tfprotov6.Diagnostic, error) {
+	newState, paths, err := UnknownsToNulls(s.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("error converting unknown values in state: %w", err)
+	}
+	s.Raw = newState
+
+	diags := make([]*tfprotov6.Diagnostic, 0, len(paths))
+	for _, path := range paths {
+		diags = append(diags, &tfprotov6.Diagnostic{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Unknown value set to null",
+			Detail:    "The provider couldn't determine a value for this attribute, so it was set to null in the state.",
+			Attribute: path,
+		})
+	}
+	return diags, nil
+}
+
+// UnknownsToNulls returns a copy of `val` with every unknown value, at any
+// depth, replaced by a null value of the same type, along with the paths of
+// the values that were replaced, sorted by path.
+func UnknownsToNulls(val tftypes.Value) (tftypes.Value, []*tftypes.AttributePath, error) {
+	var paths []*tftypes.AttributePath
+	newVal, err := tftypes.Transform(val, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if v.IsKnown() {
+			return v, nil
+		}
+		paths = append(paths, path)
+		return tftypes.NewValue(v.Type(), nil), nil
+	})
+	if err != nil {
+		return val, nil, err
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return order.PathLess(paths[i], paths[j])
+	})
+	return newVal, paths, nil
+}
+
 // RemoveResource removes the entire resource from state.
 func (s *State) RemoveResource(ctx context.Context) {
 	s.Raw = tftypes.NewValue(s.Schema.TerraformType(ctx), nil)
 }
 
+// PathMatches returns every path in the state matching `expr`, along with the
+// value at that path. Consumers should assert the type of each value with the
+// desired attr.Type.
+func (s State) PathMatches(ctx context.Context, expr PathExpression) ([]PathMatch, error) {
+	matches, err := pathMatches(ctx, s.Schema, s.Raw, expr)
+	if err != nil {
+		return nil, fmt.Errorf("error matching paths in state: %w", err)
+	}
+	return matches, nil
+}
+
 func (s State) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
 	rawValue, remaining, err := tftypes.WalkAttributePath(s.Raw, path)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -647,3 +648,370 @@ func TestStateSetAttribute(t *testing.T) {
 		t.Fatalf("unexpected diff (+wanted, -got): %s", diff)
 	}
 }
+
+func TestStateDynamicAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := tftypes.NewAttributePath().WithAttributeName("settings")
+	state := State{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"settings": {
+					Type:     types.DynamicType,
+					Optional: true,
+				},
+			},
+		},
+	}
+	state.Raw = tftypes.NewValue(state.Schema.TerraformType(ctx), map[string]tftypes.Value{
+		"settings": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+	})
+
+	settingsType := tftypes.Map{AttributeType: tftypes.Number}
+	settings := tftypes.NewValue(settingsType, map[string]tftypes.Value{
+		"retries": tftypes.NewValue(tftypes.Number, 3),
+	})
+	err := state.SetAttribute(ctx, path, types.NewDynamic(settings))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, err := state.GetAttribute(ctx, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := types.Dynamic{Value: settings}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestStateConvertUnknownsToNulls(t *testing.T) {
+	testState := makeTestState()
+
+	err := testState.SetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("machine_type"), types.String{Unknown: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testState.SetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1).WithAttributeName("id"), types.String{Unknown: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags, err := testState.ConvertUnknownsToNulls(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedDiags := []*tfprotov6.Diagnostic{
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Unknown value set to null",
+			Detail:    "The provider couldn't determine a value for this attribute, so it was set to null in the state.",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1).WithAttributeName("id"),
+		},
+		{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Unknown value set to null",
+			Detail:    "The provider couldn't determine a value for this attribute, so it was set to null in the state.",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("machine_type"),
+		},
+	}
+	if diff := cmp.Diff(expectedDiags, diags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+
+	machineType, err := testState.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("machine_type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (types.String{Null: true}); !machineType.Equal(expected) {
+		t.Errorf("expected machine_type to be %v, got %v", expected, machineType)
+	}
+
+	diskID, err := testState.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1).WithAttributeName("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (types.String{Null: true}); !diskID.Equal(expected) {
+		t.Errorf("expected disk id to be %v, got %v", expected, diskID)
+	}
+}
+
+func TestUnknownsToNulls_longList(t *testing.T) {
+	t.Parallel()
+
+	elems := make([]tftypes.Value, 0, 11)
+	for i := 0; i < 11; i++ {
+		elems = append(elems, tftypes.NewValue(tftypes.String, "hello"))
+	}
+	elems[2] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	elems[10] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	val := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elems)
+
+	_, paths, err := UnknownsToNulls(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []*tftypes.AttributePath{
+		tftypes.NewAttributePath().WithElementKeyInt(2),
+		tftypes.NewAttributePath().WithElementKeyInt(10),
+	}
+	if diff := cmp.Diff(expected, paths); diff != "" {
+		t.Errorf("unexpected paths (+wanted, -got): %s", diff)
+	}
+}
+
+func TestStateSetID(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}.WithIDAttribute()
+	stateType := s.TerraformType(context.Background())
+	testState := State{
+		Schema: s,
+		Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name": tftypes.NewValue(tftypes.String, "hello, world"),
+		}),
+	}
+
+	err := testState.SetID(context.Background(), "abc123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "hello, world"),
+	})
+	if diff := cmp.Diff(expected, testState.Raw); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestStateSetIDNoValue(t *testing.T) {
+	t.Parallel()
+
+	testState := State{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"name": {Type: types.StringType, Required: true},
+			},
+		}.WithIDAttribute(),
+	}
+	err := testState.SetID(context.Background(), "abc123")
+	if err == nil || err.Error() != errNoStateValue.Error() {
+		t.Errorf("Expected error %q, got %v", errNoStateValue, err)
+	}
+}
+
+func TestStateSetAttributes(t *testing.T) {
+	testState := makeTestState()
+
+	err := testState.SetAttributes(context.Background(), map[*tftypes.AttributePath]attr.Value{
+		tftypes.NewAttributePath().WithAttributeName("name"): types.String{Value: "newname"},
+		tftypes.NewAttributePath().WithAttributeName("tags"): types.List{
+			ElemType: types.StringType,
+			Elems: []attr.Value{
+				types.String{Value: "one"},
+			},
+		},
+		tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1).WithAttributeName("id"): types.String{Value: "mynewdisk"},
+		tftypes.NewAttributePath().WithAttributeName("scratch_disk").WithAttributeName("interface"):        types.String{Value: "NVME"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := makeTestState()
+	for path, val := range map[*tftypes.AttributePath]interface{}{
+		tftypes.NewAttributePath().WithAttributeName("name"):                                               "newname",
+		tftypes.NewAttributePath().WithAttributeName("tags"):                                               []string{"one"},
+		tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1).WithAttributeName("id"): "mynewdisk",
+		tftypes.NewAttributePath().WithAttributeName("scratch_disk").WithAttributeName("interface"):        "NVME",
+	} {
+		err := expected.SetAttribute(context.Background(), path, val)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if diff := cmp.Diff(expected.Raw, testState.Raw); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestStateSetAttributes_errors(t *testing.T) {
+	tests := map[string]struct {
+		values   map[*tftypes.AttributePath]attr.Value
+		expected string
+	}{
+		"overlapping": {
+			values: map[*tftypes.AttributePath]attr.Value{
+				tftypes.NewAttributePath().WithAttributeName("scratch_disk"): types.Object{
+					AttrTypes: map[string]attr.Type{"interface": types.StringType},
+					Attrs:     map[string]attr.Value{"interface": types.String{Value: "SCSI"}},
+				},
+				tftypes.NewAttributePath().WithAttributeName("scratch_disk").WithAttributeName("interface"): types.String{Value: "NVME"},
+			},
+			expected: `can't set both AttributeName("scratch_disk") and AttributeName("scratch_disk").AttributeName("interface"), one contains the other`,
+		},
+		"missing": {
+			values: map[*tftypes.AttributePath]attr.Value{
+				tftypes.NewAttributePath().WithAttributeName("name"):                                               types.String{Value: "newname"},
+				tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(5).WithAttributeName("id"): types.String{Value: "mynewdisk"},
+			},
+			expected: `error setting attributes in state: path AttributeName("disks").ElementKeyInt(5).AttributeName("id") not found in state`,
+		},
+		"wrong-type": {
+			values: map[*tftypes.AttributePath]attr.Value{
+				tftypes.NewAttributePath().WithAttributeName("name"): types.Bool{Value: true},
+			},
+			expected: `invalid new state value at path AttributeName("name"): tftypes.NewValue can't use bool as a tftypes.String; expected types are: string or *string`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			testState := makeTestState()
+			original := testState.Raw
+
+			err := testState.SetAttributes(context.Background(), test.values)
+			if err == nil {
+				t.Fatalf("expected error %q, got nil", test.expected)
+			}
+			if err.Error() != test.expected {
+				t.Errorf("expected error %q, got %q", test.expected, err.Error())
+			}
+			if diff := cmp.Diff(original, testState.Raw); diff != "" {
+				t.Errorf("expected state to be unchanged, got diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateGetSetMapNested(t *testing.T) {
+	t.Parallel()
+
+	ruleAttrs := map[string]schema.Attribute{
+		"port": {
+			Type:     types.NumberType,
+			Required: true,
+		},
+		"protocol": {
+			Type:     types.StringType,
+			Optional: true,
+		},
+	}
+	mapSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rules": {
+				Attributes: schema.MapNestedAttributes(ruleAttrs, schema.MapNestedAttributesOptions{}),
+				Optional:   true,
+			},
+		},
+	}
+	typ := mapSchema.TerraformType(context.Background())
+	rulesType := typ.(tftypes.Object).AttributeTypes["rules"]
+	ruleType := rulesType.(tftypes.Map).AttributeType
+	rule := func(port int64, protocol interface{}) tftypes.Value {
+		return tftypes.NewValue(ruleType, map[string]tftypes.Value{
+			"port":     tftypes.NewValue(tftypes.Number, port),
+			"protocol": tftypes.NewValue(tftypes.String, protocol),
+		})
+	}
+	value := func(rules interface{}) tftypes.Value {
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"rules": tftypes.NewValue(rulesType, rules),
+		})
+	}
+
+	type ruleModel struct {
+		Port     int64   `tfsdk:"port"`
+		Protocol *string `tfsdk:"protocol"`
+	}
+	type model struct {
+		Rules map[string]*ruleModel `tfsdk:"rules"`
+	}
+	tcp := "tcp"
+
+	type testCase struct {
+		raw           tftypes.Value
+		expected      model
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"entries": {
+			raw: value(map[string]tftypes.Value{
+				"web": rule(443, "tcp"),
+				"dns": rule(53, nil),
+			}),
+			expected: model{
+				Rules: map[string]*ruleModel{
+					"web": {Port: 443, Protocol: &tcp},
+					"dns": {Port: 53},
+				},
+			},
+		},
+		"null-map": {
+			raw:      value(nil),
+			expected: model{},
+		},
+		"null-entry": {
+			raw: value(map[string]tftypes.Value{
+				"web": tftypes.NewValue(ruleType, nil),
+			}),
+			expected: model{
+				Rules: map[string]*ruleModel{
+					"web": nil,
+				},
+			},
+		},
+		"unknown-entry": {
+			raw: value(map[string]tftypes.Value{
+				"web": tftypes.NewValue(ruleType, tftypes.UnknownValue),
+			}),
+			expectedError: `AttributeName("rules").ElementKeyString("web"): unhandled unknown value`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := State{Schema: mapSchema, Raw: test.raw}
+			var got model
+			err := state.Get(context.Background(), &got)
+			if test.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error %q, got nil", test.expectedError)
+				}
+				if err.Error() != test.expectedError {
+					t.Errorf("Expected error %q, got %q", test.expectedError, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Fatalf("Unexpected diff from Get (+wanted, -got): %s", diff)
+			}
+
+			newState := State{Schema: mapSchema}
+			err = newState.Set(context.Background(), got)
+			if err != nil {
+				t.Fatalf("Unexpected error from Set: %s", err)
+			}
+			if !newState.Raw.Equal(test.raw) {
+				t.Errorf("Expected Set to return the original value (+wanted, -got): %s", cmp.Diff(newState.Raw, test.raw))
+			}
+		})
+	}
+}
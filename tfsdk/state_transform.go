@@ -0,0 +1,82 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// decodeState returns `val`, a resource state, plan, or proposed new state
+// received from Terraform, with its computed-only attributes decoded by the
+// provider's DecodeState method if it implements ProviderWithStateTransform.
+func (s *server) decodeState(ctx context.Context, typeName string, resourceSchema schema.Schema, val tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	transformer, ok := s.p.(ProviderWithStateTransform)
+	if !ok || val.IsNull() {
+		return val, nil
+	}
+	decoded, diags := transformer.DecodeState(ctx, typeName, val)
+	if diagsHasErrors(diags) {
+		return val, diags
+	}
+	return checkTransformedState("decoding", resourceSchema, val, decoded, diags)
+}
+
+// encodeState returns `val`, a resource state or plan about to be returned
+// to Terraform, with its computed-only attributes encoded by the provider's
+// EncodeState method if it implements ProviderWithStateTransform.
+func (s *server) encodeState(ctx context.Context, typeName string, resourceSchema schema.Schema, val tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	transformer, ok := s.p.(ProviderWithStateTransform)
+	if !ok || val.IsNull() {
+		return val, nil
+	}
+	encoded, diags := transformer.EncodeState(ctx, typeName, val)
+	if diagsHasErrors(diags) {
+		return val, diags
+	}
+	return checkTransformedState("encoding", resourceSchema, val, encoded, diags)
+}
+
+// checkTransformedState returns `original` with the values of its
+// computed-only attributes taken from `transformed`, or `original` and an
+// error diagnostic if `transformed` isn't of the same type as `original`.
+func checkTransformedState(operation string, resourceSchema schema.Schema, original, transformed tftypes.Value, diags []*tfprotov6.Diagnostic) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	if transformed.Type() == nil || !transformed.Type().Is(original.Type()) {
+		return original, append(diags, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error transforming state",
+			Detail:   fmt.Sprintf("An unexpected error was encountered %s the state: expected a value of type %s, got %v. This is always a problem with the provider. Please report this to the provider developer.", operation, original.Type(), transformed.Type()),
+		})
+	}
+	val, err := tftypes.Transform(original, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if len(path.Steps()) < 1 {
+			return v, nil
+		}
+		attribute, err := resourceSchema.AttributeAtPath(path)
+		if errors.Is(err, schema.ErrPathInsideAtomicAttribute) {
+			return v, nil
+		}
+		if err != nil {
+			return v, err
+		}
+		if !attribute.Computed || attribute.Optional || attribute.Required {
+			return v, nil
+		}
+		raw, _, err := tftypes.WalkAttributePath(transformed, path)
+		if err != nil {
+			return v, err
+		}
+		return raw.(tftypes.Value), nil
+	})
+	if err != nil {
+		return original, append(diags, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error transforming state",
+			Detail:   fmt.Sprintf("An unexpected error was encountered %s the state. This is always a problem with the provider. Please report the following to the provider developer:\n\n%s", operation, err),
+		})
+	}
+	return val, diags
+}
@@ -0,0 +1,269 @@
+package tfsdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testStateTransformResourceType struct {
+	readSecrets *[]string
+}
+
+func (rt testStateTransformResourceType) GetSchema(_ context.Context) (schema.Schema, []*tfprotov6.Diagnostic) {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"secret": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func (rt testStateTransformResourceType) NewResource(_ context.Context, _ Provider) (Resource, []*tfprotov6.Diagnostic) {
+	return testStateTransformResource{readSecrets: rt.readSecrets}, nil
+}
+
+// testStateTransformResource records the secret in the state it's asked to
+// read, and returns that state unchanged.
+type testStateTransformResource struct {
+	readSecrets *[]string
+}
+
+func (r testStateTransformResource) Create(_ context.Context, _ CreateResourceRequest, _ *CreateResourceResponse) {
+}
+
+func (r testStateTransformResource) Read(ctx context.Context, req ReadResourceRequest, resp *ReadResourceResponse) {
+	secret, err := req.State.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("secret"))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error reading secret",
+			Detail:   err.Error(),
+		})
+		return
+	}
+	*r.readSecrets = append(*r.readSecrets, secret.(types.String).Value)
+	resp.State = req.State
+}
+
+func (r testStateTransformResource) Update(_ context.Context, _ UpdateResourceRequest, _ *UpdateResourceResponse) {
+}
+
+func (r testStateTransformResource) Delete(_ context.Context, _ DeleteResourceRequest, _ *DeleteResourceResponse) {
+}
+
+// testStateTransformProvider prefixes secrets with "enc:" in the state
+// Terraform stores. If allStrings is true, it prefixes every string
+// attribute instead. If encodeError is true, encoding always fails.
+type testStateTransformProvider struct {
+	*testServeProvider
+	readSecrets *[]string
+	allStrings  bool
+	encodeError bool
+}
+
+func (p testStateTransformProvider) GetResources(_ context.Context) (map[string]ResourceType, []*tfprotov6.Diagnostic) {
+	return map[string]ResourceType{
+		"test_transform": testStateTransformResourceType{readSecrets: p.readSecrets},
+	}, nil
+}
+
+func (p testStateTransformProvider) EncodeState(_ context.Context, _ string, state tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	if p.encodeError {
+		return state, []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error encoding state",
+			},
+		}
+	}
+	return transformTestSecret(state, p.allStrings, func(secret string) string {
+		return "enc:" + secret
+	})
+}
+
+func (p testStateTransformProvider) DecodeState(_ context.Context, _ string, state tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	return transformTestSecret(state, p.allStrings, func(secret string) string {
+		return strings.TrimPrefix(secret, "enc:")
+	})
+}
+
+func transformTestSecret(state tftypes.Value, allStrings bool, transform func(string) string) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	transformed, err := tftypes.Transform(state, func(path *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) {
+			return v, nil
+		}
+		if !allStrings && !path.Equal(tftypes.NewAttributePath().WithAttributeName("secret")) {
+			return v, nil
+		}
+		var secret string
+		if err := v.As(&secret); err != nil {
+			return v, err
+		}
+		return tftypes.NewValue(tftypes.String, transform(secret)), nil
+	})
+	if err != nil {
+		return state, []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error transforming state",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	return transformed, nil
+}
+
+func TestServerReadResourceStateTransform(t *testing.T) {
+	t.Parallel()
+
+	var readSecrets []string
+	testServer := &server{
+		p: testStateTransformProvider{
+			testServeProvider: &testServeProvider{},
+			readSecrets:       &readSecrets,
+		},
+	}
+	resourceSchema, _ := testStateTransformResourceType{}.GetSchema(context.Background())
+	typ := resourceSchema.TerraformType(context.Background())
+	state := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"name":   tftypes.NewValue(tftypes.String, "example"),
+		"secret": tftypes.NewValue(tftypes.String, "enc:hunter2"),
+	})
+	stateDV, err := tfprotov6.NewDynamicValue(typ, state)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, err := testServer.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     "test_transform",
+		CurrentState: &stateDV,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(got.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", got.Diagnostics)
+	}
+	if diff := cmp.Diff(readSecrets, []string{"hunter2"}); diff != "" {
+		t.Errorf("Expected Read to be given the decoded state (+wanted, -got): %s", diff)
+	}
+	newState, err := got.NewState.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(newState, state); diff != "" {
+		t.Errorf("Expected the encoded state to be returned (+wanted, -got): %s", diff)
+	}
+}
+
+func TestServerReadResourceStateTransform_encodeError(t *testing.T) {
+	t.Parallel()
+
+	var readSecrets []string
+	testServer := &server{
+		p: testStateTransformProvider{
+			testServeProvider: &testServeProvider{},
+			readSecrets:       &readSecrets,
+			encodeError:       true,
+		},
+	}
+	resourceSchema, _ := testStateTransformResourceType{}.GetSchema(context.Background())
+	typ := resourceSchema.TerraformType(context.Background())
+	stateDV, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"name":   tftypes.NewValue(tftypes.String, "example"),
+		"secret": tftypes.NewValue(tftypes.String, "enc:hunter2"),
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, err := testServer.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     "test_transform",
+		CurrentState: &stateDV,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !diagsHasErrors(got.Diagnostics) {
+		t.Errorf("Expected an error diagnostic, got %+v", got.Diagnostics)
+	}
+	if diff := cmp.Diff(got.NewState, &stateDV); diff != "" {
+		t.Errorf("Expected the current state to be kept (+wanted, -got): %s", diff)
+	}
+}
+
+func TestServerPlanResourceChangeStateTransform(t *testing.T) {
+	t.Parallel()
+
+	testServer := &server{
+		p: testStateTransformProvider{
+			testServeProvider: &testServeProvider{},
+			allStrings:        true,
+		},
+	}
+	resourceSchema, _ := testStateTransformResourceType{}.GetSchema(context.Background())
+	typ := resourceSchema.TerraformType(context.Background())
+	value := func(name, secret interface{}) tfprotov6.DynamicValue {
+		dv, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+			"name":   tftypes.NewValue(tftypes.String, name),
+			"secret": tftypes.NewValue(tftypes.String, secret),
+		}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return dv
+	}
+	priorState := value("example", "enc:hunter2")
+	config := value("example", nil)
+	proposedNewState := value("example", "enc:hunter2")
+
+	got, err := testServer.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         "test_transform",
+		PriorState:       &priorState,
+		Config:           &config,
+		ProposedNewState: &proposedNewState,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(got.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", got.Diagnostics)
+	}
+	plannedState, err := got.PlannedState.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected, err := proposedNewState.Unmarshal(typ)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(plannedState, expected); diff != "" {
+		t.Errorf("Expected the configured name to be planned untransformed (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCheckTransformedState(t *testing.T) {
+	t.Parallel()
+
+	original := tftypes.NewValue(tftypes.String, "example")
+	got, diags := checkTransformedState("encoding", schema.Schema{}, original, tftypes.NewValue(tftypes.Number, 1), nil)
+	if !diagsHasErrors(diags) {
+		t.Errorf("Expected an error for a value of the wrong type, got %+v", diags)
+	}
+	if !got.Equal(original) {
+		t.Errorf("Expected the original value to be returned, got %s", got)
+	}
+}
@@ -0,0 +1,162 @@
+package tfsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// removeUnexpectedAttributes removes any object attributes in the JSON state
+// `raw` that `typ` doesn't have, returning the state without them and the
+// paths of the attributes it removed. If nothing was removed, `raw` is
+// returned unchanged.
+func removeUnexpectedAttributes(typ tftypes.Type, raw []byte) ([]byte, []*tftypes.AttributePath, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var state interface{}
+	if err := dec.Decode(&state); err != nil {
+		return nil, nil, fmt.Errorf("error decoding state: %w", err)
+	}
+	var removed []*tftypes.AttributePath
+	state = removeUnexpectedJSONAttributes(typ, state, tftypes.NewAttributePath(), &removed)
+	if len(removed) == 0 {
+		return raw, nil, nil
+	}
+	pruned, err := json.Marshal(state)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error encoding state: %w", err)
+	}
+	return pruned, removed, nil
+}
+
+// removeUnexpectedJSONAttributes removes the object attributes that `typ`
+// doesn't have from the decoded JSON value `val`, appending their paths to
+// `removed`, and returns the pruned value.
+func removeUnexpectedJSONAttributes(typ tftypes.Type, val interface{}, path *tftypes.AttributePath, removed *[]*tftypes.AttributePath) interface{} {
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return val
+		}
+		for _, name := range sortedKeys(obj) {
+			attrType, ok := typ.AttributeTypes[name]
+			if !ok {
+				*removed = append(*removed, path.WithAttributeName(name))
+				delete(obj, name)
+				continue
+			}
+			obj[name] = removeUnexpectedJSONAttributes(attrType, obj[name], path.WithAttributeName(name), removed)
+		}
+	case tftypes.List:
+		elems, ok := val.([]interface{})
+		if !ok {
+			return val
+		}
+		for i, elem := range elems {
+			elems[i] = removeUnexpectedJSONAttributes(typ.ElementType, elem, path.WithElementKeyInt(int64(i)), removed)
+		}
+	case tftypes.Set:
+		// set elements are identified by their values, which are the
+		// thing being changed, so report anything removed from them at
+		// the set itself
+		elems, ok := val.([]interface{})
+		if !ok {
+			return val
+		}
+		var elemsRemoved []*tftypes.AttributePath
+		for i, elem := range elems {
+			elems[i] = removeUnexpectedJSONAttributes(typ.ElementType, elem, path, &elemsRemoved)
+		}
+		if len(elemsRemoved) == 0 {
+			return val
+		}
+		// every element reports at the same paths, so only report
+		// each of them once
+		for _, elemRemoved := range elemsRemoved {
+			if !containsPath(*removed, elemRemoved) {
+				*removed = append(*removed, elemRemoved)
+			}
+		}
+		// elements that only differed in the removed attributes are
+		// now the same, and a set can't hold them twice
+		return uniqueJSONElements(elems)
+	case tftypes.Tuple:
+		elems, ok := val.([]interface{})
+		if !ok {
+			return val
+		}
+		for i, elem := range elems {
+			if i >= len(typ.ElementTypes) {
+				break
+			}
+			elems[i] = removeUnexpectedJSONAttributes(typ.ElementTypes[i], elem, path.WithElementKeyInt(int64(i)), removed)
+		}
+	case tftypes.Map:
+		elems, ok := val.(map[string]interface{})
+		if !ok {
+			return val
+		}
+		for _, key := range sortedKeys(elems) {
+			elems[key] = removeUnexpectedJSONAttributes(typ.AttributeType, elems[key], path.WithElementKeyString(key), removed)
+		}
+	}
+	return val
+}
+
+// uniqueJSONElements returns `elems` without the elements equal to an earlier
+// one.
+func uniqueJSONElements(elems []interface{}) []interface{} {
+	unique := make([]interface{}, 0, len(elems))
+	for _, elem := range elems {
+		duplicate := false
+		for _, seen := range unique {
+			if reflect.DeepEqual(elem, seen) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			unique = append(unique, elem)
+		}
+	}
+	return unique
+}
+
+// containsPath returns true if `paths` has a path equal to `path`.
+func containsPath(paths []*tftypes.AttributePath, path *tftypes.AttributePath) bool {
+	for _, p := range paths {
+		if p.Equal(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of `m` in order, so attributes are reported in
+// the same order every time.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// unexpectedAttributeDiagnostic returns a warning that the attribute at
+// `path` was removed from the prior state because the schema doesn't have
+// it.
+func unexpectedAttributeDiagnostic(path *tftypes.AttributePath) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity:  tfprotov6.DiagnosticSeverityWarning,
+		Summary:   "Unexpected attribute in state",
+		Detail:    "The prior state has a value for an attribute that isn't in the resource's schema, so it was removed. This can happen when state is edited by hand. If it wasn't, this is a problem with the provider. Please report this to the provider developer.",
+		Attribute: path,
+	}
+}
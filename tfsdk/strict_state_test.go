@@ -0,0 +1,101 @@
+package tfsdk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRemoveUnexpectedAttributes(t *testing.T) {
+	t.Parallel()
+
+	elemType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+	}}
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"rules":  tftypes.Set{ElementType: elemType},
+		"labels": tftypes.Map{AttributeType: elemType},
+		"pair":   tftypes.Tuple{ElementTypes: []tftypes.Type{elemType, tftypes.String}},
+	}}
+
+	type testCase struct {
+		raw             string
+		expected        string
+		expectedRemoved []*tftypes.AttributePath
+		expectedErr     string
+	}
+	tests := map[string]testCase{
+		"unchanged": {
+			raw:      `{"rules":[{"name":"a"}],"labels":{"x":{"name":"b"}},"pair":[{"name":"c"},"d"]}`,
+			expected: `{"rules":[{"name":"a"}],"labels":{"x":{"name":"b"}},"pair":[{"name":"c"},"d"]}`,
+		},
+		"set": {
+			raw:      `{"rules":[{"name":"a","port":1},{"name":"b"}]}`,
+			expected: `{"rules":[{"name":"a"},{"name":"b"}]}`,
+			expectedRemoved: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("rules").WithAttributeName("port"),
+			},
+		},
+		"set-duplicates": {
+			raw:      `{"rules":[{"name":"a","port":1},{"name":"a","port":2},{"name":"b"}]}`,
+			expected: `{"rules":[{"name":"a"},{"name":"b"}]}`,
+			expectedRemoved: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("rules").WithAttributeName("port"),
+			},
+		},
+		"map": {
+			raw:      `{"labels":{"x":{"name":"a","color":"red"},"y":{"name":"b"}}}`,
+			expected: `{"labels":{"x":{"name":"a"},"y":{"name":"b"}}}`,
+			expectedRemoved: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("labels").WithElementKeyString("x").WithAttributeName("color"),
+			},
+		},
+		"tuple": {
+			raw:      `{"pair":[{"name":"a","extra":true},"b","c"]}`,
+			expected: `{"pair":[{"name":"a"},"b","c"]}`,
+			expectedRemoved: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("pair").WithElementKeyInt(0).WithAttributeName("extra"),
+			},
+		},
+		"non-object": {
+			raw:      `"hello"`,
+			expected: `"hello"`,
+		},
+		"mismatched-values": {
+			raw:      `{"rules":"a","labels":["x"],"pair":{"name":"a"}}`,
+			expected: `{"rules":"a","labels":["x"],"pair":{"name":"a"}}`,
+		},
+		"invalid": {
+			raw:         `{"rules":`,
+			expectedErr: "error decoding state: unexpected EOF",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, removed, err := removeUnexpectedAttributes(typ, []byte(tc.raw))
+			if err != nil {
+				if tc.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if err.Error() != tc.expectedErr {
+					t.Errorf("Expected error %q, got %q", tc.expectedErr, err.Error())
+				}
+				return
+			}
+			if tc.expectedErr != "" {
+				t.Fatalf("Expected error %q, got nil", tc.expectedErr)
+			}
+			if string(got) != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+			if diff := cmp.Diff(tc.expectedRemoved, removed); diff != "" {
+				t.Errorf("Unexpected diff in removed paths (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package tfsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// supersededAttribute is a top-level attribute that was renamed, as declared
+// by schema.Attribute.Supersedes.
+type supersededAttribute struct {
+	oldName, newName string
+}
+
+// supersededAttributes returns the renamed attributes of `s`, sorted by their
+// old names. It returns an error if an attribute supersedes one that isn't in
+// the schema, or, as values are copied from the old attributes to the new
+// ones, one of a different type.
+func supersededAttributes(s schema.Schema) ([]supersededAttribute, error) {
+	var renamed []supersededAttribute
+	for name, attribute := range s.Attributes {
+		if attribute.Supersedes == "" {
+			continue
+		}
+		if _, ok := s.Attributes[attribute.Supersedes]; !ok {
+			return nil, fmt.Errorf("%q supersedes %q, which isn't an attribute of the schema", name, attribute.Supersedes)
+		}
+		oldType, err := s.AttributeTypeAtPath(tftypes.NewAttributePath().WithAttributeName(attribute.Supersedes))
+		if err != nil {
+			return nil, err
+		}
+		newType, err := s.AttributeTypeAtPath(tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			return nil, err
+		}
+		if !newType.Equal(oldType) {
+			return nil, fmt.Errorf("%q supersedes %q, but they have different types", name, attribute.Supersedes)
+		}
+		renamed = append(renamed, supersededAttribute{oldName: attribute.Supersedes, newName: name})
+	}
+	sort.Slice(renamed, func(i, j int) bool {
+		return renamed[i].oldName < renamed[j].oldName
+	})
+	return renamed, nil
+}
+
+// validateSupersededAttributes returns a warning for each renamed attribute
+// configured using its old name, and an error for each configured using both
+// its old and new names.
+func validateSupersededAttributes(s schema.Schema, config tftypes.Value) ([]*tfprotov6.Diagnostic, error) {
+	renamed, err := supersededAttributes(s)
+	if err != nil || len(renamed) == 0 || config.IsNull() || !config.IsKnown() {
+		return nil, err
+	}
+	var attrs map[string]tftypes.Value
+	if err := config.As(&attrs); err != nil {
+		return nil, err
+	}
+	var diags []*tfprotov6.Diagnostic
+	for _, r := range renamed {
+		if attrs[r.oldName].IsNull() {
+			continue
+		}
+		path := tftypes.NewAttributePath().WithAttributeName(r.oldName)
+		if !attrs[r.newName].IsNull() {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity:  tfprotov6.DiagnosticSeverityError,
+				Summary:   "Conflicting attributes",
+				Detail:    fmt.Sprintf("%q has been renamed to %q, and only one of them can be set. Remove %q from the configuration.", r.oldName, r.newName, r.oldName),
+				Attribute: path,
+			})
+			continue
+		}
+		diags = append(diags, &tfprotov6.Diagnostic{
+			Severity:  tfprotov6.DiagnosticSeverityWarning,
+			Summary:   "Attribute renamed",
+			Detail:    fmt.Sprintf("%q has been renamed to %q. Use %q instead, as %q will be removed in a future version.", r.oldName, r.newName, r.newName, r.oldName),
+			Attribute: path,
+		})
+	}
+	return diags, nil
+}
+
+// copySupersededValues returns `target`, a config or plan of a resource with
+// the schema `s`, with each renamed attribute set to the value of its old
+// attribute in `config`, when only the old attribute is set there.
+func copySupersededValues(s schema.Schema, config, target tftypes.Value) (tftypes.Value, error) {
+	renamed, err := supersededAttributes(s)
+	if err != nil || len(renamed) == 0 || config.IsNull() || !config.IsKnown() || target.IsNull() || !target.IsKnown() {
+		return target, err
+	}
+	var configAttrs, targetAttrs map[string]tftypes.Value
+	if err := config.As(&configAttrs); err != nil {
+		return target, err
+	}
+	if err := target.As(&targetAttrs); err != nil {
+		return target, err
+	}
+	changed := false
+	for _, r := range renamed {
+		if !configAttrs[r.newName].IsNull() || configAttrs[r.oldName].IsNull() {
+			continue
+		}
+		targetAttrs[r.newName] = configAttrs[r.oldName]
+		changed = true
+	}
+	if !changed {
+		return target, nil
+	}
+	return tftypes.NewValue(target.Type(), targetAttrs), nil
+}
+
+// upgradeSupersededState returns the JSON state `raw` with each renamed
+// attribute that is null or missing set to the value of its old attribute.
+// If nothing was changed, `raw` is returned unchanged.
+func upgradeSupersededState(s schema.Schema, raw []byte) ([]byte, error) {
+	renamed, err := supersededAttributes(s)
+	if err != nil || len(renamed) == 0 || raw == nil {
+		return raw, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var state map[string]interface{}
+	if err := dec.Decode(&state); err != nil {
+		return nil, fmt.Errorf("error decoding state: %w", err)
+	}
+	changed := false
+	for _, r := range renamed {
+		if state[r.newName] != nil || state[r.oldName] == nil {
+			continue
+		}
+		state[r.newName] = state[r.oldName]
+		changed = true
+	}
+	if !changed {
+		return raw, nil
+	}
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding state: %w", err)
+	}
+	return upgraded, nil
+}
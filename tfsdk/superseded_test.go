@@ -0,0 +1,224 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testSupersededSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": {
+			Type:               types.StringType,
+			Optional:           true,
+			DeprecationMessage: `Use "display_name" instead.`,
+		},
+		"display_name": {
+			Type:       types.StringType,
+			Optional:   true,
+			Computed:   true,
+			Supersedes: "name",
+		},
+	},
+}
+
+func testSupersededValue(name, displayName interface{}) tftypes.Value {
+	return tftypes.NewValue(testSupersededSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, name),
+		"display_name": tftypes.NewValue(tftypes.String, displayName),
+	})
+}
+
+func TestValidateSupersededAttributes(t *testing.T) {
+	t.Parallel()
+
+	path := tftypes.NewAttributePath().WithAttributeName("name")
+	type testCase struct {
+		config        tftypes.Value
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+	tests := map[string]testCase{
+		"new": {
+			config: testSupersededValue(nil, "example"),
+		},
+		"old": {
+			config: testSupersededValue("example", nil),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Attribute renamed",
+					Detail:    `"name" has been renamed to "display_name". Use "display_name" instead, as "name" will be removed in a future version.`,
+					Attribute: path,
+				},
+			},
+		},
+		"both": {
+			config: testSupersededValue("example", "example"),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Conflicting attributes",
+					Detail:    `"name" has been renamed to "display_name", and only one of them can be set. Remove "name" from the configuration.`,
+					Attribute: path,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := validateSupersededAttributes(testSupersededSchema, tc.config)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCopySupersededValues(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		config   tftypes.Value
+		plan     tftypes.Value
+		expected tftypes.Value
+	}
+	tests := map[string]testCase{
+		"old": {
+			config:   testSupersededValue("example", nil),
+			plan:     testSupersededValue("example", "stale"),
+			expected: testSupersededValue("example", "example"),
+		},
+		"new": {
+			config:   testSupersededValue(nil, "example"),
+			plan:     testSupersededValue(nil, "example"),
+			expected: testSupersededValue(nil, "example"),
+		},
+		"neither": {
+			config:   testSupersededValue(nil, nil),
+			plan:     testSupersededValue(nil, tftypes.UnknownValue),
+			expected: testSupersededValue(nil, tftypes.UnknownValue),
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := copySupersededValues(testSupersededSchema, tc.config, tc.plan)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCopySupersededValues_mismatchedTypes(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"size": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"size_gb": {
+				Type:       types.NumberType,
+				Optional:   true,
+				Computed:   true,
+				Supersedes: "size",
+			},
+		},
+	}
+	typ := s.TerraformType(context.Background())
+	config := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"size":    tftypes.NewValue(tftypes.String, "10"),
+		"size_gb": tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	got, err := copySupersededValues(s, config, config)
+	expected := `"size_gb" supersedes "size", but they have different types`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+	if !got.Equal(config) {
+		t.Errorf("Expected %s, got %s", config, got)
+	}
+}
+
+func TestCopySupersededValues_missingAttribute(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"display_name": {
+				Type:       types.StringType,
+				Optional:   true,
+				Computed:   true,
+				Supersedes: "nmae",
+			},
+		},
+	}
+	config := tftypes.NewValue(s.TerraformType(context.Background()), map[string]tftypes.Value{
+		"display_name": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	_, err := copySupersededValues(s, config, config)
+	expected := `"display_name" supersedes "nmae", which isn't an attribute of the schema`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
+
+func TestUpgradeSupersededState(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		raw      string
+		expected string
+	}
+	tests := map[string]testCase{
+		"old-only": {
+			raw:      `{"name":"example"}`,
+			expected: `{"display_name":"example","name":"example"}`,
+		},
+		"old-and-null-new": {
+			raw:      `{"display_name":null,"name":"example"}`,
+			expected: `{"display_name":"example","name":"example"}`,
+		},
+		"new": {
+			raw:      `{"display_name":"example","name":null}`,
+			expected: `{"display_name":"example","name":null}`,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := upgradeSupersededState(testSupersededSchema, []byte(tc.raw))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if string(got) != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
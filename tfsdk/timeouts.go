@@ -0,0 +1,185 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TimeoutsAttributeName is the name of the attribute the framework adds to
+// the schemas of resource types implementing ResourceTypeWithTimeouts.
+const TimeoutsAttributeName = "timeouts"
+
+const (
+	timeoutCreate = "create"
+	timeoutRead   = "read"
+	timeoutUpdate = "update"
+	timeoutDelete = "delete"
+)
+
+// Timeouts are the default amounts of time the framework gives each of a
+// resource's operations before canceling its context. A zero duration means
+// the operation has no timeout, and practitioners can't set one.
+type Timeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// operations returns the names of the operations with a default timeout,
+// mapped to their defaults.
+func (t Timeouts) operations() map[string]time.Duration {
+	ops := map[string]time.Duration{}
+	for op, d := range map[string]time.Duration{
+		timeoutCreate: t.Create,
+		timeoutRead:   t.Read,
+		timeoutUpdate: t.Update,
+		timeoutDelete: t.Delete,
+	} {
+		if d > 0 {
+			ops[op] = d
+		}
+	}
+	return ops
+}
+
+// timeoutsAttribute returns the attribute holding practitioner-supplied
+// overrides of `timeouts`, or false if there's nothing to override.
+func timeoutsAttribute(timeouts Timeouts) (schema.Attribute, bool) {
+	ops := timeouts.operations()
+	if len(ops) == 0 {
+		return schema.Attribute{}, false
+	}
+	attrs := make(map[string]schema.Attribute, len(ops))
+	for op, d := range ops {
+		attrs[op] = schema.Attribute{
+			Type:        types.StringType,
+			Optional:    true,
+			Description: fmt.Sprintf("How long to wait for the %s operation to finish, as a duration like \"30s\" or \"10m\". Defaults to %s.", op, d),
+		}
+	}
+	return schema.Attribute{
+		Attributes:  schema.SingleNestedAttributes(attrs),
+		Optional:    true,
+		Description: "Overrides for how long the resource's operations can take before they're canceled.",
+	}, true
+}
+
+// resourceTypeSchema returns the schema of `resourceType`, adding the
+// TimeoutsAttributeName attribute if it implements ResourceTypeWithTimeouts.
+func resourceTypeSchema(ctx context.Context, resourceType ResourceType) (schema.Schema, []*tfprotov6.Diagnostic) {
+	resourceSchema, diags := resourceType.GetSchema(ctx)
+	if diagsHasErrors(diags) {
+		return resourceSchema, diags
+	}
+	withTimeouts, ok := resourceType.(ResourceTypeWithTimeouts)
+	if !ok {
+		return resourceSchema, diags
+	}
+	timeouts, ok := timeoutsAttribute(withTimeouts.Timeouts(ctx))
+	if !ok {
+		return resourceSchema, diags
+	}
+	if _, ok := resourceSchema.Attributes[TimeoutsAttributeName]; ok {
+		return resourceSchema, append(diags, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Duplicate timeouts attribute",
+			Detail:   fmt.Sprintf("The resource's schema already has a %q attribute, so the framework can't add one for its timeouts. This is always a problem with the provider. Please report this to the provider developer.", TimeoutsAttributeName),
+		})
+	}
+	attrs := make(map[string]schema.Attribute, len(resourceSchema.Attributes)+1)
+	for name, attr := range resourceSchema.Attributes {
+		attrs[name] = attr
+	}
+	attrs[TimeoutsAttributeName] = timeouts
+	resourceSchema.Attributes = attrs
+	return resourceSchema, diags
+}
+
+// resourceTimeout returns the timeout for the operation `op` of
+// `resourceType`, using the practitioner's override in `val` if there is one.
+// It returns zero if the operation has no timeout.
+func resourceTimeout(ctx context.Context, resourceType ResourceType, op string, val tftypes.Value) (time.Duration, []*tfprotov6.Diagnostic) {
+	withTimeouts, ok := resourceType.(ResourceTypeWithTimeouts)
+	if !ok {
+		return 0, nil
+	}
+	d, ok := withTimeouts.Timeouts(ctx).operations()[op]
+	if !ok {
+		return 0, nil
+	}
+	path := tftypes.NewAttributePath().WithAttributeName(TimeoutsAttributeName).WithAttributeName(op)
+	raw, _, err := tftypes.WalkAttributePath(val, path)
+	if err != nil {
+		// the value or the timeouts attribute is null or unknown, so
+		// there's no override
+		return d, nil
+	}
+	override, ok := raw.(tftypes.Value)
+	if !ok || !override.IsKnown() || override.IsNull() {
+		return d, nil
+	}
+	var s string
+	if err := override.As(&s); err != nil {
+		return d, []*tfprotov6.Diagnostic{{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Error reading timeout",
+			Detail:    "An unexpected error was encountered reading the timeout. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			Attribute: path,
+		}}
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil || parsed <= 0 {
+		return d, []*tfprotov6.Diagnostic{{
+			Severity:  tfprotov6.DiagnosticSeverityError,
+			Summary:   "Invalid timeout",
+			Detail:    fmt.Sprintf("The %s timeout must be a positive duration like \"30s\" or \"10m\", not %q.", op, s),
+			Attribute: path,
+		}}
+	}
+	return parsed, nil
+}
+
+// validateTimeouts returns diagnostics for any invalid timeout overrides in
+// `config`.
+func validateTimeouts(ctx context.Context, resourceType ResourceType, config tftypes.Value) []*tfprotov6.Diagnostic {
+	var diags []*tfprotov6.Diagnostic
+	for _, op := range []string{timeoutCreate, timeoutRead, timeoutUpdate, timeoutDelete} {
+		_, opDiags := resourceTimeout(ctx, resourceType, op, config)
+		diags = append(diags, opDiags...)
+	}
+	return diags
+}
+
+// withResourceTimeout returns a context that is canceled after the timeout
+// for the operation `op` of `resourceType`, along with the timeout. If the
+// operation has no timeout, `ctx` is returned unchanged.
+func withResourceTimeout(ctx context.Context, resourceType ResourceType, op string, val tftypes.Value) (context.Context, context.CancelFunc, time.Duration, []*tfprotov6.Diagnostic) {
+	d, diags := resourceTimeout(ctx, resourceType, op, val)
+	if diagsHasErrors(diags) || d == 0 {
+		return ctx, func() {}, 0, diags
+	}
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, cancel, d, diags
+}
+
+// timeoutDiagnostic returns a diagnostic explaining that the operation `op`
+// of the resource type `typeName` didn't finish within `d`, if `ctx` was
+// canceled because its deadline passed.
+func timeoutDiagnostic(ctx context.Context, typeName, op string, d time.Duration) *tfprotov6.Diagnostic {
+	if d == 0 || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Operation timed out",
+		Detail:   fmt.Sprintf("The %s operation for the %q resource didn't finish within %s. To give it more time, set %s.%s in the resource's configuration to a longer duration.", op, typeName, d, TimeoutsAttributeName, op),
+	}
+}
@@ -0,0 +1,234 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testTimeoutsResourceType struct {
+	attributes map[string]schema.Attribute
+	timeouts   Timeouts
+}
+
+func (rt testTimeoutsResourceType) GetSchema(_ context.Context) (schema.Schema, []*tfprotov6.Diagnostic) {
+	attrs := map[string]schema.Attribute{
+		"name": {
+			Type:     types.StringType,
+			Required: true,
+		},
+	}
+	for name, attr := range rt.attributes {
+		attrs[name] = attr
+	}
+	return schema.Schema{Attributes: attrs}, nil
+}
+
+func (rt testTimeoutsResourceType) NewResource(_ context.Context, _ Provider) (Resource, []*tfprotov6.Diagnostic) {
+	return testTimeoutsResource{}, nil
+}
+
+func (rt testTimeoutsResourceType) Timeouts(_ context.Context) Timeouts {
+	return rt.timeouts
+}
+
+// testTimeoutsResource blocks in Create until its context is canceled.
+type testTimeoutsResource struct{}
+
+func (r testTimeoutsResource) Create(ctx context.Context, _ CreateResourceRequest, _ *CreateResourceResponse) {
+	<-ctx.Done()
+}
+
+func (r testTimeoutsResource) Read(_ context.Context, _ ReadResourceRequest, _ *ReadResourceResponse) {
+}
+
+func (r testTimeoutsResource) Update(_ context.Context, _ UpdateResourceRequest, _ *UpdateResourceResponse) {
+}
+
+func (r testTimeoutsResource) Delete(_ context.Context, _ DeleteResourceRequest, _ *DeleteResourceResponse) {
+}
+
+type testTimeoutsProvider struct {
+	*testServeProvider
+}
+
+func (p testTimeoutsProvider) GetResources(_ context.Context) (map[string]ResourceType, []*tfprotov6.Diagnostic) {
+	return map[string]ResourceType{
+		"test_timeouts": testTimeoutsResourceType{
+			timeouts: Timeouts{Create: 10 * time.Millisecond},
+		},
+	}, nil
+}
+
+func TestResourceTypeSchemaTimeouts(t *testing.T) {
+	t.Parallel()
+
+	got, diags := resourceTypeSchema(context.Background(), testTimeoutsResourceType{
+		timeouts: Timeouts{Create: time.Minute, Delete: 2 * time.Minute},
+	})
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", diags)
+	}
+	timeouts, ok := got.Attributes[TimeoutsAttributeName]
+	if !ok {
+		t.Fatalf("Expected schema to have a %q attribute, got %+v", TimeoutsAttributeName, got.Attributes)
+	}
+	if !timeouts.Optional || timeouts.Attributes == nil {
+		t.Errorf("Expected an optional nested attribute, got %+v", timeouts)
+	}
+	var names []string
+	for name := range timeouts.Attributes.GetAttributes() {
+		names = append(names, name)
+	}
+	if diff := cmp.Diff(len(names), 2); diff != "" {
+		t.Errorf("Expected only create and delete timeouts, got %v", names)
+	}
+
+	got, diags = resourceTypeSchema(context.Background(), testTimeoutsResourceType{})
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %+v", diags)
+	}
+	if _, ok := got.Attributes[TimeoutsAttributeName]; ok {
+		t.Errorf("Expected no %q attribute when no operation has a timeout", TimeoutsAttributeName)
+	}
+
+	_, diags = resourceTypeSchema(context.Background(), testTimeoutsResourceType{
+		attributes: map[string]schema.Attribute{
+			TimeoutsAttributeName: {Type: types.StringType, Optional: true},
+		},
+		timeouts: Timeouts{Create: time.Minute},
+	})
+	if !diagsHasErrors(diags) || diags[0].Summary != "Duplicate timeouts attribute" {
+		t.Errorf("Expected a duplicate timeouts attribute error, got %+v", diags)
+	}
+}
+
+func TestResourceTimeout(t *testing.T) {
+	t.Parallel()
+
+	resourceType := testTimeoutsResourceType{
+		timeouts: Timeouts{Create: time.Minute},
+	}
+	resourceSchema, _ := resourceTypeSchema(context.Background(), resourceType)
+	timeoutsType := resourceSchema.Attributes[TimeoutsAttributeName].Attributes.AttributeType().TerraformType(context.Background())
+	valueWithCreate := func(create tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(resourceSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "example"),
+			TimeoutsAttributeName: tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": create,
+			}),
+		})
+	}
+
+	type testCase struct {
+		op            string
+		val           tftypes.Value
+		expected      time.Duration
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+
+	tests := map[string]testCase{
+		"default": {
+			op:       timeoutCreate,
+			val:      valueWithCreate(tftypes.NewValue(tftypes.String, nil)),
+			expected: time.Minute,
+		},
+		"null-timeouts": {
+			op: timeoutCreate,
+			val: tftypes.NewValue(resourceSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+				"name":                tftypes.NewValue(tftypes.String, "example"),
+				TimeoutsAttributeName: tftypes.NewValue(timeoutsType, nil),
+			}),
+			expected: time.Minute,
+		},
+		"override": {
+			op:       timeoutCreate,
+			val:      valueWithCreate(tftypes.NewValue(tftypes.String, "90s")),
+			expected: 90 * time.Second,
+		},
+		"no-timeout": {
+			op:       timeoutDelete,
+			val:      valueWithCreate(tftypes.NewValue(tftypes.String, "90s")),
+			expected: 0,
+		},
+		"invalid": {
+			op:       timeoutCreate,
+			val:      valueWithCreate(tftypes.NewValue(tftypes.String, "soon")),
+			expected: time.Minute,
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Invalid timeout",
+					Detail:    `The create timeout must be a positive duration like "30s" or "10m", not "soon".`,
+					Attribute: tftypes.NewAttributePath().WithAttributeName(TimeoutsAttributeName).WithAttributeName("create"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := resourceTimeout(context.Background(), resourceType, tc.op, tc.val)
+			if got != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerApplyResourceChangeTimeout(t *testing.T) {
+	t.Parallel()
+
+	testServer := &server{
+		p: testTimeoutsProvider{&testServeProvider{}},
+	}
+	resourceSchema, _ := resourceTypeSchema(context.Background(), testTimeoutsResourceType{
+		timeouts: Timeouts{Create: 10 * time.Millisecond},
+	})
+	typ := resourceSchema.TerraformType(context.Background())
+	timeoutsType := typ.(tftypes.Object).AttributeTypes[TimeoutsAttributeName]
+	config := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"name":                tftypes.NewValue(tftypes.String, "example"),
+		TimeoutsAttributeName: tftypes.NewValue(timeoutsType, nil),
+	})
+	configDV, err := tfprotov6.NewDynamicValue(typ, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	priorDV, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	got, err := testServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     "test_timeouts",
+		PriorState:   &priorDV,
+		PlannedState: &configDV,
+		Config:       &configDV,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedDiags := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Operation timed out",
+			Detail:   `The create operation for the "test_timeouts" resource didn't finish within 10ms. To give it more time, set timeouts.create in the resource's configuration to a longer duration.`,
+		},
+	}
+	if diff := cmp.Diff(got.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,97 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UnknownHandling is a policy for handling unknown values in the config
+// passed to a resource's Create and Update methods.
+type UnknownHandling int
+
+const (
+	// UnknownHandlingDefault passes unknown values in the config through
+	// to the resource, leaving each call to Get or GetAttribute to decide
+	// how to handle them.
+	UnknownHandlingDefault UnknownHandling = iota
+
+	// UnknownHandlingError returns an error diagnostic for each unknown
+	// value in the config, with the value's path, without calling the
+	// resource.
+	UnknownHandlingError
+
+	// UnknownHandlingNullWithWarning replaces each unknown value in the
+	// config with null, returning a warning diagnostic with the value's
+	// path, before calling the resource.
+	UnknownHandlingNullWithWarning
+)
+
+// String returns a human-readable name for the policy.
+func (u UnknownHandling) String() string {
+	switch u {
+	case UnknownHandlingDefault:
+		return "default"
+	case UnknownHandlingError:
+		return "error"
+	case UnknownHandlingNullWithWarning:
+		return "null with warning"
+	}
+	return fmt.Sprintf("UnknownHandling(%d)", int(u))
+}
+
+// handleConfigUnknowns applies the unknown handling policy of `resourceType`
+// to `config`, returning the config to pass to the resource.
+func handleConfigUnknowns(ctx context.Context, typeName string, resourceType ResourceType, config tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	policy := UnknownHandlingDefault
+	if handler, ok := resourceType.(ResourceTypeWithUnknownHandling); ok {
+		policy = handler.UnknownHandling(ctx)
+	} else if ExperimentEnabled(ctx, ExperimentStrictUnknownHandling) {
+		policy = UnknownHandlingError
+	}
+	if policy == UnknownHandlingDefault {
+		return config, nil
+	}
+	withNulls, paths, err := UnknownsToNulls(config)
+	if err != nil {
+		return config, []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error checking configuration for unknown values",
+				Detail:   "An unexpected error was encountered checking the configuration for unknown values. This is always an error in the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			},
+		}
+	}
+	var diags []*tfprotov6.Diagnostic
+	switch policy {
+	case UnknownHandlingError:
+		for _, path := range paths {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity:  tfprotov6.DiagnosticSeverityError,
+				Summary:   "Unknown configuration value",
+				Detail:    fmt.Sprintf("The %q resource requires this value to be known when it is applied. Make sure it doesn't depend on values that are only known after other resources are applied.", typeName),
+				Attribute: path,
+			})
+		}
+		return config, diags
+	case UnknownHandlingNullWithWarning:
+		for _, path := range paths {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity:  tfprotov6.DiagnosticSeverityWarning,
+				Summary:   "Unknown configuration value treated as null",
+				Detail:    fmt.Sprintf("This value was unknown when the %q resource was applied, so it was treated as if it wasn't set.", typeName),
+				Attribute: path,
+			})
+		}
+		return withNulls, diags
+	}
+	return config, []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Invalid unknown handling policy",
+			Detail:   fmt.Sprintf("The %q resource has an invalid unknown handling policy, %s. This is always an error in the provider. Please report this to the provider developer.", typeName, policy),
+		},
+	}
+}
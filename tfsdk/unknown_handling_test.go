@@ -0,0 +1,137 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testUnknownHandlingResourceType struct {
+	ResourceType
+	policy UnknownHandling
+}
+
+func (rt testUnknownHandlingResourceType) UnknownHandling(_ context.Context) UnknownHandling {
+	return rt.policy
+}
+
+func TestHandleConfigUnknowns(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+		"tags": tftypes.List{ElementType: tftypes.String},
+	}}
+	config := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+			tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+	})
+	withNulls := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, nil),
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+			tftypes.NewValue(tftypes.String, nil),
+		}),
+	})
+	namePath := tftypes.NewAttributePath().WithAttributeName("name")
+	tagPath := tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyInt(1)
+
+	type testCase struct {
+		experiments    []Experiment
+		resourceType   ResourceType
+		expectedConfig tftypes.Value
+		expectedDiags  []*tfprotov6.Diagnostic
+	}
+	tests := map[string]testCase{
+		"not-implemented": {
+			resourceType:   testServeResourceTypeOne{},
+			expectedConfig: config,
+		},
+		"default": {
+			resourceType:   testUnknownHandlingResourceType{policy: UnknownHandlingDefault},
+			expectedConfig: config,
+		},
+		"error": {
+			resourceType:   testUnknownHandlingResourceType{policy: UnknownHandlingError},
+			expectedConfig: config,
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Unknown configuration value",
+					Detail:    `The "test_unknown" resource requires this value to be known when it is applied. Make sure it doesn't depend on values that are only known after other resources are applied.`,
+					Attribute: namePath,
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Unknown configuration value",
+					Detail:    `The "test_unknown" resource requires this value to be known when it is applied. Make sure it doesn't depend on values that are only known after other resources are applied.`,
+					Attribute: tagPath,
+				},
+			},
+		},
+		"strict-experiment": {
+			experiments:    []Experiment{ExperimentStrictUnknownHandling},
+			resourceType:   testServeResourceTypeOne{},
+			expectedConfig: config,
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Unknown configuration value",
+					Detail:    `The "test_unknown" resource requires this value to be known when it is applied. Make sure it doesn't depend on values that are only known after other resources are applied.`,
+					Attribute: namePath,
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Unknown configuration value",
+					Detail:    `The "test_unknown" resource requires this value to be known when it is applied. Make sure it doesn't depend on values that are only known after other resources are applied.`,
+					Attribute: tagPath,
+				},
+			},
+		},
+		"strict-experiment-resource-policy": {
+			experiments:    []Experiment{ExperimentStrictUnknownHandling},
+			resourceType:   testUnknownHandlingResourceType{policy: UnknownHandlingDefault},
+			expectedConfig: config,
+		},
+		"null-with-warning": {
+			resourceType:   testUnknownHandlingResourceType{policy: UnknownHandlingNullWithWarning},
+			expectedConfig: withNulls,
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Unknown configuration value treated as null",
+					Detail:    `This value was unknown when the "test_unknown" resource was applied, so it was treated as if it wasn't set.`,
+					Attribute: namePath,
+				},
+				{
+					Severity:  tfprotov6.DiagnosticSeverityWarning,
+					Summary:   "Unknown configuration value treated as null",
+					Detail:    `This value was unknown when the "test_unknown" resource was applied, so it was treated as if it wasn't set.`,
+					Attribute: tagPath,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := withExperiments(context.Background(), experimentSet(tc.experiments))
+			got, diags := handleConfigUnknowns(ctx, "test_unknown", tc.resourceType, config)
+			if !got.Equal(tc.expectedConfig) {
+				t.Errorf("Expected config %s, got %s", tc.expectedConfig, got)
+			}
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
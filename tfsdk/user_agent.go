@@ -0,0 +1,138 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AppendUserAgentEnvVar is the environment variable practitioners can set to
+// add their own information to the end of User-Agent strings built by
+// UserAgent.
+const AppendUserAgentEnvVar = "TF_APPEND_USER_AGENT"
+
+// UserAgentProduct is a single product in a User-Agent string, rendered as
+// `Name/Version (Comment)`. Version and Comment are optional.
+type UserAgentProduct struct {
+	Name    string
+	Version string
+	Comment string
+}
+
+// String returns the product as it appears in a User-Agent string.
+func (p UserAgentProduct) String() string {
+	s := p.Name
+	if p.Version != "" {
+		s += "/" + p.Version
+	}
+	if p.Comment != "" {
+		s += " (" + p.Comment + ")"
+	}
+	return s
+}
+
+// UserAgent builds a standardized User-Agent string for providers that call
+// HTTP APIs, so every provider reports Terraform, the framework, the
+// protocol, and itself in the same way.
+type UserAgent struct {
+	// TerraformVersion is the version of Terraform executing requests,
+	// from ConfigureProviderRequest.
+	TerraformVersion string
+
+	// ProviderName is the name of the provider, like "example" for
+	// terraform-provider-example.
+	ProviderName string
+
+	// ProviderVersion is the version of the provider.
+	ProviderVersion string
+
+	// Products are extra products added after the provider, like the
+	// module information from ModuleUserAgentProduct.
+	Products []UserAgentProduct
+}
+
+// NewUserAgent returns a UserAgent for the provider `name` at `version`,
+// using the Terraform version from `req`.
+func NewUserAgent(req ConfigureProviderRequest, name, version string) UserAgent {
+	return UserAgent{
+		TerraformVersion: req.TerraformVersion,
+		ProviderName:     name,
+		ProviderVersion:  version,
+	}
+}
+
+// WithProducts returns a copy of the UserAgent with `products` added after
+// its existing Products.
+func (u UserAgent) WithProducts(products ...UserAgentProduct) UserAgent {
+	newProducts := make([]UserAgentProduct, 0, len(u.Products)+len(products))
+	newProducts = append(newProducts, u.Products...)
+	u.Products = append(newProducts, products...)
+	return u
+}
+
+// String returns the User-Agent string, like
+//
+//	Terraform/1.0.0 (+https://www.terraform.io) terraform-plugin-framework (protocol 6) terraform-provider-example/1.2.3
+//
+// followed by any Products and, if it is set, the value of the
+// AppendUserAgentEnvVar environment variable.
+func (u UserAgent) String() string {
+	tfVersion := u.TerraformVersion
+	if tfVersion == "" {
+		tfVersion = "unknown"
+	}
+	products := []UserAgentProduct{
+		{Name: "Terraform", Version: tfVersion, Comment: "+https://www.terraform.io"},
+		{Name: "terraform-plugin-framework", Comment: "protocol 6"},
+	}
+	if u.ProviderName != "" {
+		products = append(products, UserAgentProduct{
+			Name:    "terraform-provider-" + u.ProviderName,
+			Version: u.ProviderVersion,
+		})
+	}
+	products = append(products, u.Products...)
+
+	parts := make([]string, 0, len(products)+1)
+	for _, product := range products {
+		if product.Name == "" {
+			continue
+		}
+		parts = append(parts, product.String())
+	}
+	if extra := strings.TrimSpace(os.Getenv(AppendUserAgentEnvVar)); extra != "" {
+		parts = append(parts, extra)
+	}
+	return strings.Join(parts, " ")
+}
+
+// ModuleUserAgentProduct returns the product set by a module in the string
+// attribute `attrName` of its provider_meta block, like
+// `module_name = "example-module/1.0.0"`. The attribute is split on its last
+// "/" into the product's name and version. A nil product is returned if the
+// module didn't set the attribute.
+func ModuleUserAgentProduct(ctx context.Context, providerMeta Config, attrName string) (*UserAgentProduct, error) {
+	if providerMeta.Raw.Type() == nil || providerMeta.Raw.IsNull() {
+		return nil, nil
+	}
+	val, err := providerMeta.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName(attrName))
+	if err != nil {
+		return nil, err
+	}
+	s, ok := val.(types.String)
+	if !ok {
+		return nil, fmt.Errorf("can't use %T as module information, the attribute must be a string", val)
+	}
+	if s.Null || s.Unknown || s.Value == "" {
+		return nil, nil
+	}
+	product := &UserAgentProduct{Name: s.Value}
+	if i := strings.LastIndex(s.Value, "/"); i > 0 && i < len(s.Value)-1 {
+		product.Name, product.Version = s.Value[:i], s.Value[i+1:]
+	}
+	return product, nil
+}
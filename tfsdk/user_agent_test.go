@@ -0,0 +1,130 @@
+package tfsdk
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserAgentString(t *testing.T) {
+	if err := os.Unsetenv(AppendUserAgentEnvVar); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	ua := NewUserAgent(ConfigureProviderRequest{TerraformVersion: "1.0.0"}, "example", "1.2.3")
+	expected := "Terraform/1.0.0 (+https://www.terraform.io) terraform-plugin-framework (protocol 6) terraform-provider-example/1.2.3"
+	if got := ua.String(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	withModule := ua.WithProducts(UserAgentProduct{Name: "example-module", Version: "0.1.0"})
+	expected += " example-module/0.1.0"
+	if got := withModule.String(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+	if len(ua.Products) != 0 {
+		t.Errorf("Expected WithProducts not to modify the original UserAgent, got %v", ua.Products)
+	}
+
+	if err := os.Setenv(AppendUserAgentEnvVar, " custom/1.0 "); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.Unsetenv(AppendUserAgentEnvVar) //nolint:errcheck
+	expected += " custom/1.0"
+	if got := withModule.String(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	unknownVersion := UserAgent{}
+	expected = "Terraform/unknown (+https://www.terraform.io) terraform-plugin-framework (protocol 6) custom/1.0"
+	if got := unknownVersion.String(); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestModuleUserAgentProduct(t *testing.T) {
+	t.Parallel()
+
+	metaSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"module_name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+	metaType := metaSchema.TerraformType(context.Background())
+
+	type testCase struct {
+		meta          tftypes.Value
+		attrName      string
+		expected      *UserAgentProduct
+		expectedError string
+	}
+
+	tests := map[string]testCase{
+		"name-and-version": {
+			meta: tftypes.NewValue(metaType, map[string]tftypes.Value{
+				"module_name": tftypes.NewValue(tftypes.String, "blueprints/example/1.0.0"),
+			}),
+			attrName: "module_name",
+			expected: &UserAgentProduct{Name: "blueprints/example", Version: "1.0.0"},
+		},
+		"name-only": {
+			meta: tftypes.NewValue(metaType, map[string]tftypes.Value{
+				"module_name": tftypes.NewValue(tftypes.String, "example"),
+			}),
+			attrName: "module_name",
+			expected: &UserAgentProduct{Name: "example"},
+		},
+		"unset": {
+			meta: tftypes.NewValue(metaType, map[string]tftypes.Value{
+				"module_name": tftypes.NewValue(tftypes.String, nil),
+			}),
+			attrName: "module_name",
+		},
+		"no-provider-meta": {
+			meta:     tftypes.NewValue(metaType, nil),
+			attrName: "module_name",
+		},
+		"missing-attribute": {
+			meta: tftypes.NewValue(metaType, map[string]tftypes.Value{
+				"module_name": tftypes.NewValue(tftypes.String, "example"),
+			}),
+			attrName:      "module",
+			expectedError: `error walking schema: AttributeName("module") still remains in the path: could not find attribute "module" in schema`,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ModuleUserAgentProduct(context.Background(), Config{
+				Raw:    tc.meta,
+				Schema: metaSchema,
+			}, tc.attrName)
+			if tc.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error %q, got none", tc.expectedError)
+				}
+				if err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %q", tc.expectedError, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
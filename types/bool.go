@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -42,6 +43,21 @@ type Bool struct {
 	Value bool
 }
 
+// BoolValue returns a known Bool holding `value`.
+func BoolValue(value bool) Bool {
+	return Bool{Value: value}
+}
+
+// BoolNull returns a null Bool.
+func BoolNull() Bool {
+	return Bool{Null: true}
+}
+
+// BoolUnknown returns an unknown Bool.
+func BoolUnknown() Bool {
+	return Bool{Unknown: true}
+}
+
 // ToTerraformValue returns the data contained in the *Bool as a bool. If
 // Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
 // returns nil.
@@ -55,6 +71,11 @@ func (b Bool) ToTerraformValue(_ context.Context) (interface{}, error) {
 	return b.Value, nil
 }
 
+// ToBoolValue returns `b`, to implement BoolValuable.
+func (b Bool) ToBoolValue(_ context.Context) (Bool, error) {
+	return b, nil
+}
+
 // Equal returns true if `other` is a *Bool and has the same value as `b`.
 func (b Bool) Equal(other attr.Value) bool {
 	o, ok := other.(Bool)
@@ -69,3 +90,83 @@ func (b Bool) Equal(other attr.Value) bool {
 	}
 	return b.Value == o.Value
 }
+
+// IsNull returns true if the Bool is null.
+func (b Bool) IsNull() bool {
+	return b.Null
+}
+
+// IsUnknown returns true if the Bool is unknown.
+func (b Bool) IsUnknown() bool {
+	return b.Unknown
+}
+
+// String returns "true" or "false", or "<null>" or "<unknown>".
+func (b Bool) String() string {
+	switch {
+	case b.Null:
+		return "<null>"
+	case b.Unknown:
+		return "<unknown>"
+	}
+	return strconv.FormatBool(b.Value)
+}
+
+// ValueBoolPointer returns a pointer to the value of `b`, or nil if `b` is
+// null or unknown.
+func (b Bool) ValueBoolPointer() *bool {
+	if b.Null || b.Unknown {
+		return nil
+	}
+	v := b.Value
+	return &v
+}
+
+// Not returns the negation of `b`. The negation of an unknown or null Bool is
+// unknown or null.
+func (b Bool) Not() Bool {
+	if b.Null || b.Unknown {
+		return b
+	}
+	return Bool{Value: !b.Value}
+}
+
+// Or returns the logical OR of `b` and `other`. If either is known to be
+// true the result is true, no matter what the other is. Otherwise, the
+// result is unknown if either is unknown, null if either is null, and false
+// if both are false.
+func (b Bool) Or(other Bool) Bool {
+	if b.isKnown(true) || other.isKnown(true) {
+		return Bool{Value: true}
+	}
+	return b.combine(other, false)
+}
+
+// And returns the logical AND of `b` and `other`. If either is known to be
+// false the result is false, no matter what the other is. Otherwise, the
+// result is unknown if either is unknown, null if either is null, and true
+// if both are true.
+func (b Bool) And(other Bool) Bool {
+	if b.isKnown(false) || other.isKnown(false) {
+		return Bool{Value: false}
+	}
+	return b.combine(other, true)
+}
+
+// isKnown returns true if `b` is neither null nor unknown and has the value
+// `v`.
+func (b Bool) isKnown(v bool) bool {
+	return !b.Null && !b.Unknown && b.Value == v
+}
+
+// combine returns an unknown Bool if either `b` or `other` is unknown, a null
+// Bool if either is null, and `v` otherwise.
+func (b Bool) combine(other Bool, v bool) Bool {
+	if b.Unknown || other.Unknown {
+		return Bool{Unknown: true}
+	}
+	if b.Null || other.Null {
+		return Bool{Null: true}
+	}
+	return Bool{Value: v}
+}
@@ -264,3 +264,66 @@ func TestBoolEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestBoolValueBoolPointer(t *testing.T) {
+	t.Parallel()
+
+	if got := (Bool{Null: true}).ValueBoolPointer(); got != nil {
+		t.Errorf("Expected nil for null, got %v", *got)
+	}
+	if got := (Bool{Unknown: true}).ValueBoolPointer(); got != nil {
+		t.Errorf("Expected nil for unknown, got %v", *got)
+	}
+	if got := (Bool{Value: true}).ValueBoolPointer(); got == nil || !*got {
+		t.Errorf("Expected pointer to true, got %v", got)
+	}
+}
+
+func TestBoolLogic(t *testing.T) {
+	t.Parallel()
+
+	tru := Bool{Value: true}
+	fls := Bool{Value: false}
+	unknown := Bool{Unknown: true}
+	null := Bool{Null: true}
+
+	type testCase struct {
+		left, right Bool
+		expectedOr  Bool
+		expectedAnd Bool
+		expectedNot Bool
+	}
+	tests := map[string]testCase{
+		"true-true":       {left: tru, right: tru, expectedOr: tru, expectedAnd: tru, expectedNot: fls},
+		"true-false":      {left: tru, right: fls, expectedOr: tru, expectedAnd: fls, expectedNot: fls},
+		"false-false":     {left: fls, right: fls, expectedOr: fls, expectedAnd: fls, expectedNot: tru},
+		"unknown-true":    {left: unknown, right: tru, expectedOr: tru, expectedAnd: unknown, expectedNot: unknown},
+		"unknown-false":   {left: unknown, right: fls, expectedOr: unknown, expectedAnd: fls, expectedNot: unknown},
+		"unknown-null":    {left: unknown, right: null, expectedOr: unknown, expectedAnd: unknown, expectedNot: unknown},
+		"null-true":       {left: null, right: tru, expectedOr: tru, expectedAnd: null, expectedNot: null},
+		"null-false":      {left: null, right: fls, expectedOr: null, expectedAnd: fls, expectedNot: null},
+		"unknown-unknown": {left: unknown, right: unknown, expectedOr: unknown, expectedAnd: unknown, expectedNot: unknown},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.left.Or(test.right); !got.Equal(test.expectedOr) {
+				t.Errorf("Expected Or to return %+v, got %+v", test.expectedOr, got)
+			}
+			if got := test.right.Or(test.left); !got.Equal(test.expectedOr) {
+				t.Errorf("Expected reversed Or to return %+v, got %+v", test.expectedOr, got)
+			}
+			if got := test.left.And(test.right); !got.Equal(test.expectedAnd) {
+				t.Errorf("Expected And to return %+v, got %+v", test.expectedAnd, got)
+			}
+			if got := test.right.And(test.left); !got.Equal(test.expectedAnd) {
+				t.Errorf("Expected reversed And to return %+v, got %+v", test.expectedAnd, got)
+			}
+			if got := test.left.Not(); !got.Equal(test.expectedNot) {
+				t.Errorf("Expected Not to return %+v, got %+v", test.expectedNot, got)
+			}
+		})
+	}
+}
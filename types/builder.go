@@ -0,0 +1,164 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ElementValidator is a function that checks an element before it is added
+// to a value being built. `key` is the index of the element for lists, or the
+// attribute name for objects. Returning an error prevents the element from
+// being added.
+type ElementValidator func(ctx context.Context, key string, val attr.Value) error
+
+// ListBuilder accumulates elements for a List, checking that each one matches
+// the element type as it is added. It is meant for building large lists
+// without repeatedly growing the underlying slice.
+type ListBuilder struct {
+	elemType   attr.Type
+	elems      []attr.Value
+	validators []ElementValidator
+}
+
+// NewListBuilder returns a ListBuilder for a List with elements of
+// `elemType`, with room for `capacity` elements before the underlying slice
+// needs to grow.
+func NewListBuilder(elemType attr.Type, capacity int) *ListBuilder {
+	if capacity < 0 {
+		capacity = 0
+	}
+	return &ListBuilder{
+		elemType: elemType,
+		elems:    make([]attr.Value, 0, capacity),
+	}
+}
+
+// WithElementValidator adds `validator` to the validators run against every
+// element added to the builder after this call. It returns the builder, so
+// calls can be chained.
+func (b *ListBuilder) WithElementValidator(validator ElementValidator) *ListBuilder {
+	b.validators = append(b.validators, validator)
+	return b
+}
+
+// Append adds `val` to the end of the list being built. It returns an error,
+// and doesn't add `val`, if `val` isn't a value of the builder's element type
+// or fails any of the builder's element validators.
+func (b *ListBuilder) Append(ctx context.Context, val attr.Value) error {
+	key := fmt.Sprintf("%d", len(b.elems))
+	path := tftypes.NewAttributePath().WithElementKeyInt(int64(len(b.elems)))
+	if err := validateBuilderElement(ctx, b.elemType, key, val, b.validators); err != nil {
+		return path.NewError(err)
+	}
+	b.elems = append(b.elems, val)
+	return nil
+}
+
+// Len returns the number of elements added to the builder.
+func (b *ListBuilder) Len() int {
+	return len(b.elems)
+}
+
+// Build returns a List holding the elements added to the builder. The builder
+// is empty after Build is called, and can be reused to build another list.
+func (b *ListBuilder) Build() List {
+	list := List{
+		ElemType: b.elemType,
+		Elems:    b.elems,
+	}
+	b.elems = make([]attr.Value, 0, cap(b.elems))
+	return list
+}
+
+// ObjectBuilder accumulates attributes for an Object, checking that each one
+// is an attribute of the object and matches its type as it is set.
+type ObjectBuilder struct {
+	attrTypes  map[string]attr.Type
+	attrs      map[string]attr.Value
+	validators []ElementValidator
+}
+
+// NewObjectBuilder returns an ObjectBuilder for an Object with the attributes
+// described by `attrTypes`.
+func NewObjectBuilder(attrTypes map[string]attr.Type) *ObjectBuilder {
+	return &ObjectBuilder{
+		attrTypes: attrTypes,
+		attrs:     make(map[string]attr.Value, len(attrTypes)),
+	}
+}
+
+// WithElementValidator adds `validator` to the validators run against every
+// attribute set on the builder after this call. It returns the builder, so
+// calls can be chained.
+func (b *ObjectBuilder) WithElementValidator(validator ElementValidator) *ObjectBuilder {
+	b.validators = append(b.validators, validator)
+	return b
+}
+
+// Set sets the attribute `name` of the object being built to `val`. It
+// returns an error, and doesn't set the attribute, if `name` isn't an
+// attribute of the object, `val` isn't a value of the attribute's type, or
+// `val` fails any of the builder's element validators.
+func (b *ObjectBuilder) Set(ctx context.Context, name string, val attr.Value) error {
+	path := tftypes.NewAttributePath().WithAttributeName(name)
+	attrType, ok := b.attrTypes[name]
+	if !ok {
+		return path.NewErrorf("can't set attribute, it isn't an attribute of the object")
+	}
+	if err := validateBuilderElement(ctx, attrType, name, val, b.validators); err != nil {
+		return path.NewError(err)
+	}
+	b.attrs[name] = val
+	return nil
+}
+
+// Build returns an Object holding the attributes set on the builder. It
+// returns an error if any of the object's attributes haven't been set; use
+// null values for attributes that have no value. The builder is empty after
+// Build is called, and can be reused to build another object.
+func (b *ObjectBuilder) Build() (Object, error) {
+	var missing []string
+	for name := range b.attrTypes {
+		if _, ok := b.attrs[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return Object{}, fmt.Errorf("can't build object, attributes not set: %s", strings.Join(missing, ", "))
+	}
+	obj := Object{
+		AttrTypes: b.attrTypes,
+		Attrs:     b.attrs,
+	}
+	b.attrs = make(map[string]attr.Value, len(b.attrTypes))
+	return obj, nil
+}
+
+// validateBuilderElement checks that `val` is a value of `typ`, then runs
+// `validators` against it.
+func validateBuilderElement(ctx context.Context, typ attr.Type, key string, val attr.Value, validators []ElementValidator) error {
+	if val == nil {
+		return fmt.Errorf("can't use nil as a value of %s", typ.TerraformType(ctx))
+	}
+	raw, err := val.ToTerraformValue(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = reflect.NewTerraformValue(typ.TerraformType(ctx), raw)
+	if err != nil {
+		return err
+	}
+	for _, validator := range validators {
+		if err := validator(ctx, key, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
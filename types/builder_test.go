@@ -0,0 +1,198 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListBuilder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := NewListBuilder(StringType, 2)
+	for _, s := range []string{"hello", "world", "test"} {
+		if err := b.Append(ctx, String{Value: s}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	if b.Len() != 3 {
+		t.Errorf("Expected 3 elements, got %d", b.Len())
+	}
+
+	got := b.Build()
+	expected := List{
+		ElemType: StringType,
+		Elems: []attr.Value{
+			String{Value: "hello"},
+			String{Value: "world"},
+			String{Value: "test"},
+		},
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Expected builder to be empty after Build, got %d elements", b.Len())
+	}
+
+	// reusing the builder must not change the list already built
+	if err := b.Append(ctx, String{Value: "other"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected built list to be unchanged, got %+v", got)
+	}
+}
+
+func TestListBuilderAppend_errors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := NewListBuilder(StringType, 0).WithElementValidator(func(ctx context.Context, key string, val attr.Value) error {
+		if val.Equal(String{Value: "bad"}) {
+			return errors.New("bad value")
+		}
+		return nil
+	})
+
+	if err := b.Append(ctx, String{Value: "good"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	err := b.Append(ctx, Bool{Value: true})
+	expected := "ElementKeyInt(1): tftypes.NewValue can't use bool as a tftypes.String; expected types are: string or *string"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+
+	err = b.Append(ctx, String{Value: "bad"})
+	expected = "ElementKeyInt(1): bad value"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+
+	if b.Len() != 1 {
+		t.Errorf("Expected invalid elements not to be added, got %d elements", b.Len())
+	}
+}
+
+func TestListBuilder_dynamic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := NewListBuilder(DynamicType, 2)
+	elems := []attr.Value{
+		NewDynamic(tftypes.NewValue(tftypes.String, "hello")),
+		NewDynamic(tftypes.NewValue(tftypes.Number, 123)),
+	}
+	for _, elem := range elems {
+		if err := b.Append(ctx, elem); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+
+	got := b.Build()
+	expected := List{
+		ElemType: DynamicType,
+		Elems:    elems,
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestObjectBuilder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{
+		"name":    StringType,
+		"enabled": BoolType,
+	}
+	b := NewObjectBuilder(attrTypes)
+	if err := b.Set(ctx, "name", String{Value: "hello"}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	_, err := b.Build()
+	if err == nil || err.Error() != "can't build object, attributes not set: enabled" {
+		t.Errorf("Expected missing attribute error, got %v", err)
+	}
+
+	if err := b.Set(ctx, "enabled", Bool{Null: true}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	got, err := b.Build()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := Object{
+		AttrTypes: attrTypes,
+		Attrs: map[string]attr.Value{
+			"name":    String{Value: "hello"},
+			"enabled": Bool{Null: true},
+		},
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	// the builder is empty after Build
+	if _, err := b.Build(); err == nil {
+		t.Errorf("Expected builder to be empty after Build")
+	}
+}
+
+func TestObjectBuilderSet_errors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	b := NewObjectBuilder(map[string]attr.Type{
+		"name": StringType,
+	}).WithElementValidator(func(ctx context.Context, key string, val attr.Value) error {
+		if val.Equal(String{Value: ""}) {
+			return errors.New("must not be empty")
+		}
+		return nil
+	})
+
+	tests := map[string]struct {
+		name     string
+		val      attr.Value
+		expected string
+	}{
+		"unknown-attribute": {
+			name:     "nope",
+			val:      String{Value: "hello"},
+			expected: `AttributeName("nope"): can't set attribute, it isn't an attribute of the object`,
+		},
+		"wrong-type": {
+			name:     "name",
+			val:      Bool{Value: true},
+			expected: `AttributeName("name"): tftypes.NewValue can't use bool as a tftypes.String; expected types are: string or *string`,
+		},
+		"nil": {
+			name:     "name",
+			val:      nil,
+			expected: `AttributeName("name"): can't use nil as a value of tftypes.String`,
+		},
+		"validator": {
+			name:     "name",
+			val:      String{Value: ""},
+			expected: `AttributeName("name"): must not be empty`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			err := b.Set(ctx, test.name, test.val)
+			if err == nil || err.Error() != test.expected {
+				t.Errorf("Expected error %q, got %v", test.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+// Package decimal provides a fixed-point decimal number type, for attributes
+// like prices and quotas whose values must be exact and must not drift the way
+// binary floating point numbers do.
+package decimal
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// terraformPrecision is the precision Terraform parses numbers with.
+const terraformPrecision = 512
+
+var (
+	_ attr.TypeWithValidate             = Type{}
+	_ attr.TypeWithPlaintextDescription = Type{}
+	_ attr.ValueWithType                = Value{}
+)
+
+// Type is an attr.Type for decimal numbers with up to Scale digits after the
+// decimal point, like 2 for amounts of most currencies. They're numbers in
+// Terraform. Numbers with more digits after the decimal point are rejected,
+// rather than rounded.
+type Type struct {
+	Scale int32
+}
+
+// TerraformType returns tftypes.Number.
+func (t Type) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.Number
+}
+
+// ValueFromTerraform returns a Value with the type's scale holding the
+// number in `in`. It returns an error if the number has more digits after
+// the decimal point than the type's scale.
+func (t Type) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Value{Unknown: true, Scale: t.Scale}, nil
+	}
+	if in.IsNull() {
+		return Value{Null: true, Scale: t.Scale}, nil
+	}
+	f := new(big.Float)
+	if err := in.As(&f); err != nil {
+		return nil, err
+	}
+	return fromFloat(f, t.Scale)
+}
+
+// Equal returns true if `o` is a Type with the same scale.
+func (t Type) Equal(o attr.Type) bool {
+	other, ok := o.(Type)
+	return ok && other.Scale == t.Scale
+}
+
+// ApplyTerraform5AttributePathStep always returns an error, as decimals
+// have no attributes or elements.
+func (t Type) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t)
+}
+
+// String returns a human-readable representation of the type.
+func (t Type) String() string {
+	return fmt.Sprintf("decimal.Type{Scale: %d}", t.Scale)
+}
+
+// Description describes the numbers the type accepts.
+func (t Type) Description(_ context.Context) string {
+	return fmt.Sprintf("A decimal number with at most %d digits after the decimal point.", t.Scale)
+}
+
+// Validate returns an error if `in` is a number with more digits after the
+// decimal point than the type's scale.
+func (t Type) Validate(ctx context.Context, in tftypes.Value) []*tfprotov6.Diagnostic {
+	if !in.Type().Is(tftypes.Number) || !in.IsKnown() || in.IsNull() {
+		return nil
+	}
+	if _, err := t.ValueFromTerraform(ctx, in); err != nil {
+		return []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Invalid decimal number",
+				Detail:   fmt.Sprintf("The value must be a number with at most %d digits after the decimal point: %s.", t.Scale, err.Error()),
+			},
+		}
+	}
+	return nil
+}
+
+// Value is a decimal number, stored exactly as an integer number of units of
+// 10^-Scale.
+type Value struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Unscaled is the number multiplied by 10^Scale, as long as Unknown
+	// and Null are both false. A nil Unscaled is zero.
+	Unscaled *big.Int
+
+	// Scale is the number of digits after the decimal point.
+	Scale int32
+}
+
+// New returns the Value `unscaled` * 10^-`scale`, so New(150, 2) is 1.50.
+func New(unscaled int64, scale int32) Value {
+	return Value{Unscaled: big.NewInt(unscaled), Scale: scale}
+}
+
+// Parse returns the Value of the decimal number `s`, like "1.5", with the
+// scale `scale`. It returns an error if `s` isn't a decimal number or has
+// more digits after the decimal point than `scale`.
+func Parse(s string, scale int32) (Value, error) {
+	// big.Rat also parses fractions like "1/3", which aren't decimals
+	if strings.Contains(s, "/") {
+		return Value{}, fmt.Errorf("%q isn't a decimal number", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Value{}, fmt.Errorf("%q isn't a decimal number", s)
+	}
+	return fromRat(r, scale)
+}
+
+// fromFloat returns the Value of `f`, a number Terraform parsed from a
+// decimal number. Numbers like 0.1 can't be represented exactly in binary,
+// so `f` is converted using the shortest decimal number that parses to it,
+// which is the number Terraform was given.
+func fromFloat(f *big.Float, scale int32) (Value, error) {
+	if f.IsInf() {
+		return Value{}, fmt.Errorf("%s isn't a decimal number", f.Text('g', -1))
+	}
+	return Parse(f.Text('g', -1), scale)
+}
+
+func fromRat(r *big.Rat, scale int32) (Value, error) {
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(scale)))
+	if !scaled.IsInt() {
+		return Value{}, fmt.Errorf("%s has more than %d digits after the decimal point", r.FloatString(maxDigits(r)), scale)
+	}
+	return Value{Unscaled: new(big.Int).Set(scaled.Num()), Scale: scale}, nil
+}
+
+// ToTerraformValue returns the number as a *big.Float with the precision
+// Terraform uses. If Unknown is true, it returns a tftypes.UnknownValue. If
+// Null is true, it returns nil.
+func (v Value) ToTerraformValue(_ context.Context) (interface{}, error) {
+	if v.Null {
+		return nil, nil
+	}
+	if v.Unknown {
+		return tftypes.UnknownValue, nil
+	}
+	f, _, err := big.ParseFloat(v.String(), 10, terraformPrecision, big.ToNearestEven)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Equal returns true if `other` is a Value with the same number as `v`,
+// whatever their scales, so 1.50 is equal to 1.5.
+func (v Value) Equal(other attr.Value) bool {
+	o, ok := other.(Value)
+	if !ok {
+		return false
+	}
+	if v.Unknown != o.Unknown || v.Null != o.Null {
+		return false
+	}
+	if v.Unknown || v.Null {
+		return true
+	}
+	return v.Cmp(o) == 0
+}
+
+// IsNull returns true if the Value is null.
+func (v Value) IsNull() bool {
+	return v.Null
+}
+
+// IsUnknown returns true if the Value is unknown.
+func (v Value) IsUnknown() bool {
+	return v.Unknown
+}
+
+// Type returns a Type with the value's scale.
+func (v Value) Type(_ context.Context) attr.Type {
+	return Type{Scale: v.Scale}
+}
+
+// Rat returns the number as a *big.Rat. It returns nil if the value is null
+// or unknown.
+func (v Value) Rat() *big.Rat {
+	if v.Null || v.Unknown {
+		return nil
+	}
+	return new(big.Rat).SetFrac(v.unscaled(), pow10(v.Scale))
+}
+
+// String returns the number with exactly Scale digits after the decimal
+// point, like "1.50", or "<null>" or "<unknown>".
+func (v Value) String() string {
+	if v.Null {
+		return "<null>"
+	}
+	if v.Unknown {
+		return "<unknown>"
+	}
+	return v.Rat().FloatString(int(v.Scale))
+}
+
+// Cmp compares the numbers of two known, non-null values, returning -1 if
+// `v` is less than `o`, 0 if they're equal, and 1 if `v` is greater.
+func (v Value) Cmp(o Value) int {
+	a, b := v.unscaled(), o.unscaled()
+	switch {
+	case v.Scale < o.Scale:
+		a = new(big.Int).Mul(a, pow10(o.Scale-v.Scale))
+	case v.Scale > o.Scale:
+		b = new(big.Int).Mul(b, pow10(v.Scale-o.Scale))
+	}
+	return a.Cmp(b)
+}
+
+// Add returns `v` + `o`, with the larger of their scales. The result is
+// unknown if either is unknown, and otherwise null if either is null.
+func (v Value) Add(o Value) Value {
+	if result, ok := combineStates(v, o); !ok {
+		return result
+	}
+	scale, a, b := alignScales(v, o)
+	return Value{Unscaled: new(big.Int).Add(a, b), Scale: scale}
+}
+
+// Sub returns `v` - `o`, with the larger of their scales. The result is
+// unknown if either is unknown, and otherwise null if either is null.
+func (v Value) Sub(o Value) Value {
+	if result, ok := combineStates(v, o); !ok {
+		return result
+	}
+	scale, a, b := alignScales(v, o)
+	return Value{Unscaled: new(big.Int).Sub(a, b), Scale: scale}
+}
+
+// Mul returns `v` * `o`, exactly, with the sum of their scales. Use Round
+// to bring it back to the scale of an attribute. The result is unknown if
+// either is unknown, and otherwise null if either is null.
+func (v Value) Mul(o Value) Value {
+	if result, ok := combineStates(v, o); !ok {
+		return result
+	}
+	return Value{Unscaled: new(big.Int).Mul(v.unscaled(), o.unscaled()), Scale: v.Scale + o.Scale}
+}
+
+// Round returns `v` with the scale `scale`, rounding halves away from zero
+// if it has more digits after the decimal point than that. Null and unknown
+// values stay null and unknown.
+func (v Value) Round(scale int32) Value {
+	if v.Null || v.Unknown {
+		return Value{Null: v.Null, Unknown: v.Unknown, Scale: scale}
+	}
+	if scale >= v.Scale {
+		return Value{Unscaled: new(big.Int).Mul(v.unscaled(), pow10(scale-v.Scale)), Scale: scale}
+	}
+	divisor := pow10(v.Scale - scale)
+	quo, rem := new(big.Int).QuoRem(v.unscaled(), divisor, new(big.Int))
+	// round away from zero if the remainder is at least half the divisor
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(divisor) >= 0 {
+		if v.unscaled().Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return Value{Unscaled: quo, Scale: scale}
+}
+
+func (v Value) unscaled() *big.Int {
+	if v.Unscaled == nil {
+		return new(big.Int)
+	}
+	return v.Unscaled
+}
+
+// combineStates returns an unknown Value if either of `a` or `b` is unknown,
+// a null Value if either is null, and false if neither is, in which case
+// the result of an operation on them is known.
+func combineStates(a, b Value) (Value, bool) {
+	scale := a.Scale
+	if b.Scale > scale {
+		scale = b.Scale
+	}
+	if a.Unknown || b.Unknown {
+		return Value{Unknown: true, Scale: scale}, false
+	}
+	if a.Null || b.Null {
+		return Value{Null: true, Scale: scale}, false
+	}
+	return Value{}, true
+}
+
+// alignScales returns the larger of the scales of `a` and `b`, and their
+// unscaled numbers at that scale.
+func alignScales(a, b Value) (int32, *big.Int, *big.Int) {
+	if a.Scale >= b.Scale {
+		return a.Scale, a.unscaled(), b.Round(a.Scale).Unscaled
+	}
+	return b.Scale, a.Round(b.Scale).Unscaled, b.unscaled()
+}
+
+func pow10(n int32) *big.Int {
+	if n < 0 {
+		n = 0
+	}
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// maxDigits returns the number of digits needed after the decimal point to
+// show `r` in full, or a limit of 20 if it has no finite decimal form.
+func maxDigits(r *big.Rat) int {
+	denom := new(big.Int).Set(r.Denom())
+	for digits := 0; digits < 20; digits++ {
+		if denom.Cmp(big.NewInt(1)) == 0 {
+			return digits
+		}
+		for _, f := range []int64{2, 5} {
+			if new(big.Int).Mod(denom, big.NewInt(f)).Sign() == 0 {
+				denom.Quo(denom, big.NewInt(f))
+			}
+		}
+	}
+	return 20
+}
@@ -0,0 +1,217 @@
+package decimal
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func mustParse(t *testing.T, s string, scale int32) Value {
+	t.Helper()
+	v, err := Parse(s, scale)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing %q: %s", s, err)
+	}
+	return v
+}
+
+func TestTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	// Terraform parses numbers from decimal strings with 512 bits of
+	// precision, so 0.1 isn't exact
+	terraformNumber := func(s string) tftypes.Value {
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		return tftypes.NewValue(tftypes.Number, f)
+	}
+
+	type testCase struct {
+		input         tftypes.Value
+		expected      string
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"exact": {
+			input:    terraformNumber("1.5"),
+			expected: "1.50",
+		},
+		"inexact-binary": {
+			input:    terraformNumber("0.1"),
+			expected: "0.10",
+		},
+		"negative": {
+			input:    terraformNumber("-12.34"),
+			expected: "-12.34",
+		},
+		"large": {
+			input:    terraformNumber("123456789012345678901234567890.12"),
+			expected: "123456789012345678901234567890.12",
+		},
+		"too-many-digits": {
+			input:         terraformNumber("1.005"),
+			expectedError: "1.005 has more than 2 digits after the decimal point",
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.Number, nil),
+			expected: "<null>",
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expected: "<unknown>",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Type{Scale: 2}.ValueFromTerraform(context.Background(), tc.input)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %v", tc.expectedError, err)
+				}
+				if diags := (Type{Scale: 2}).Validate(context.Background(), tc.input); len(diags) != 1 {
+					t.Errorf("Expected Validate to return a diagnostic, got %v", diags)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if got.(Value).String() != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+
+			// the value must round trip to the number Terraform has
+			raw, err := got.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if roundTrip := tftypes.NewValue(tftypes.Number, raw); !roundTrip.Equal(tc.input) {
+				t.Errorf("Expected %s to convert back to %s, got %s", got, tc.input, roundTrip)
+			}
+		})
+	}
+}
+
+func TestValueEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		a, b     Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"different-scales": {
+			a:        mustParse(t, "1.50", 2),
+			b:        mustParse(t, "1.5", 1),
+			expected: true,
+		},
+		"different-numbers": {
+			a: mustParse(t, "1.50", 2),
+			b: mustParse(t, "1.51", 2),
+		},
+		"null-zero": {
+			a: Value{Null: true},
+			b: New(0, 2),
+		},
+		"nil-zero": {
+			a:        Value{Scale: 2},
+			b:        New(0, 0),
+			expected: true,
+		},
+		"unknown": {
+			a:        Value{Unknown: true, Scale: 1},
+			b:        Value{Unknown: true, Scale: 2},
+			expected: true,
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.a.Equal(tc.b); got != tc.expected {
+				t.Errorf("Expected %s.Equal(%s) to be %t, got %t", tc.a, tc.b, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueArithmetic(t *testing.T) {
+	t.Parallel()
+
+	price := mustParse(t, "19.99", 2)
+	rate := mustParse(t, "0.075", 3)
+
+	type testCase struct {
+		got      Value
+		expected string
+	}
+	tests := map[string]testCase{
+		"add": {
+			got:      price.Add(mustParse(t, "0.01", 2)),
+			expected: "20.00",
+		},
+		"add-scales": {
+			got:      price.Add(rate),
+			expected: "20.065",
+		},
+		"sub": {
+			got:      price.Sub(mustParse(t, "20", 0)),
+			expected: "-0.01",
+		},
+		"mul": {
+			got:      price.Mul(rate),
+			expected: "1.49925",
+		},
+		"round": {
+			got:      price.Mul(rate).Round(2),
+			expected: "1.50",
+		},
+		"round-half": {
+			got:      mustParse(t, "-0.125", 3).Round(2),
+			expected: "-0.13",
+		},
+		"round-up-scale": {
+			got:      price.Round(4),
+			expected: "19.9900",
+		},
+		"unknown": {
+			got:      price.Add(Value{Unknown: true}),
+			expected: "<unknown>",
+		},
+		"null": {
+			got:      price.Mul(Value{Null: true}),
+			expected: "<null>",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tc.got.String() != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, tc.got)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "abc", "1/3", "1.234"} {
+		if _, err := Parse(s, 2); err == nil {
+			t.Errorf("Expected an error parsing %q", s)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func dynamicValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Dynamic{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return Dynamic{Null: true}, nil
+	}
+	return Dynamic{Value: in}, nil
+}
+
+var _ attr.Value = Dynamic{}
+
+// Dynamic represents a value whose type is only known at runtime. Value holds
+// the underlying value with its concrete type, which can be inspected with
+// Value.Type and read with Value.As.
+type Dynamic struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value tftypes.Value
+}
+
+// NewDynamic returns a Dynamic holding `val`, of any type. Null and unknown
+// values make null and unknown Dynamics.
+func NewDynamic(val tftypes.Value) Dynamic {
+	d, _ := dynamicValueFromTerraform(context.Background(), val)
+	return d.(Dynamic)
+}
+
+// DynamicNull returns a null Dynamic.
+func DynamicNull() Dynamic {
+	return Dynamic{Null: true}
+}
+
+// DynamicUnknown returns an unknown Dynamic.
+func DynamicUnknown() Dynamic {
+	return Dynamic{Unknown: true}
+}
+
+// ToTerraformValue returns the data contained in the Dynamic as a
+// tftypes.Value of its concrete type. If Unknown is true, it returns a
+// tftypes.UnknownValue. If Null is true, it returns nil.
+func (d Dynamic) ToTerraformValue(_ context.Context) (interface{}, error) {
+	if d.Null {
+		return nil, nil
+	}
+	if d.Unknown {
+		return tftypes.UnknownValue, nil
+	}
+	return d.Value, nil
+}
+
+// Equal returns true if `other` is a Dynamic and has the same value, of the
+// same type, as `d`.
+func (d Dynamic) Equal(other attr.Value) bool {
+	o, ok := other.(Dynamic)
+	if !ok {
+		return false
+	}
+	if d.Unknown != o.Unknown {
+		return false
+	}
+	if d.Null != o.Null {
+		return false
+	}
+	if d.Null || d.Unknown {
+		return true
+	}
+	return d.Value.Equal(o.Value)
+}
+
+// IsNull returns true if the Dynamic is null.
+func (d Dynamic) IsNull() bool {
+	return d.Null
+}
+
+// IsUnknown returns true if the Dynamic is unknown.
+func (d Dynamic) IsUnknown() bool {
+	return d.Unknown
+}
+
+// String returns the value the Dynamic holds, rendered like values of its
+// type, or "<null>" or "<unknown>".
+func (d Dynamic) String() string {
+	switch {
+	case d.Null:
+		return "<null>"
+	case d.Unknown:
+		return "<unknown>"
+	}
+	return terraformValueString(d.Value)
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testDynamicObject() tftypes.Value {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"enabled": tftypes.Bool,
+		"tags":    tftypes.List{ElementType: tftypes.String},
+	}}
+	return tftypes.NewValue(typ, map[string]tftypes.Value{
+		"enabled": tftypes.NewValue(tftypes.Bool, true),
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+		}),
+	})
+}
+
+func TestDynamicValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       tftypes.Value
+		expectation attr.Value
+	}
+	tests := map[string]testCase{
+		"string": {
+			input:       tftypes.NewValue(tftypes.String, "hello"),
+			expectation: Dynamic{Value: tftypes.NewValue(tftypes.String, "hello")},
+		},
+		"object": {
+			input:       testDynamicObject(),
+			expectation: Dynamic{Value: testDynamicObject()},
+		},
+		"unknown": {
+			input:       tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			expectation: Dynamic{Unknown: true},
+		},
+		"null": {
+			input:       tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			expectation: Dynamic{Null: true},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DynamicType.ValueFromTerraform(context.Background(), test.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Dynamic
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"value-value-same": {
+			input:       NewDynamic(testDynamicObject()),
+			candidate:   NewDynamic(testDynamicObject()),
+			expectation: true,
+		},
+		"value-value-diff": {
+			input:       NewDynamic(tftypes.NewValue(tftypes.String, "1")),
+			candidate:   NewDynamic(tftypes.NewValue(tftypes.String, "2")),
+			expectation: false,
+		},
+		"value-value-diff-type": {
+			input:       NewDynamic(tftypes.NewValue(tftypes.String, "1")),
+			candidate:   NewDynamic(tftypes.NewValue(tftypes.Number, 1)),
+			expectation: false,
+		},
+		"value-null": {
+			input:       NewDynamic(tftypes.NewValue(tftypes.String, "1")),
+			candidate:   Dynamic{Null: true},
+			expectation: false,
+		},
+		"value-string": {
+			input:       NewDynamic(tftypes.NewValue(tftypes.String, "1")),
+			candidate:   String{Value: "1"},
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       Dynamic{Unknown: true},
+			candidate:   NewDynamic(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			expectation: true,
+		},
+		"null-null": {
+			input:       Dynamic{Null: true},
+			candidate:   Dynamic{Null: true},
+			expectation: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicInObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objType := ObjectType{AttrTypes: map[string]attr.Type{
+		"name":     StringType,
+		"settings": DynamicType,
+	}}
+	type target struct {
+		Name     string  `tfsdk:"name"`
+		Settings Dynamic `tfsdk:"settings"`
+	}
+	in := target{Name: "example", Settings: NewDynamic(testDynamicObject())}
+
+	val, err := refl.OutOf(ctx, objType, in)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	raw, err := val.ToTerraformValue(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	tfType := objType.TerraformType(ctx)
+	dv, err := tfprotov6.NewDynamicValue(tfType, tftypes.NewValue(tfType, raw))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	unmarshaled, err := dv.Unmarshal(tfType)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var got target
+	err = refl.Into(ctx, objType, unmarshaled, &got, refl.Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if got.Name != in.Name || !got.Settings.Equal(in.Settings) {
+		t.Errorf("Expected %+v, got %+v", in, got)
+	}
+}
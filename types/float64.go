@@ -0,0 +1,148 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func float64ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Float64{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return Float64{Null: true}, nil
+	}
+	n := new(big.Float)
+	err := in.As(&n)
+	if err != nil {
+		return nil, err
+	}
+	f, err := bigFloatToFloat64(n)
+	if err != nil {
+		return nil, err
+	}
+	return Float64{Value: f}, nil
+}
+
+// bigFloatToFloat64 returns `n` as a float64, or an error if a float64 can't
+// hold it without losing precision.
+func bigFloatToFloat64(n *big.Float) (float64, error) {
+	f, acc := n.Float64()
+	if math.IsInf(f, 0) {
+		return 0, fmt.Errorf("value %s is out of range for a 64-bit floating point number", n.Text('g', -1))
+	}
+	if acc != big.Exact {
+		// Terraform's numbers are decimals, so most, like 0.1, aren't
+		// exactly a float64; they're fine as long as the float64 is
+		// the closest one to them, which is what they were parsed
+		// from when they're formatted at the same precision
+		d, _, err := big.ParseFloat(strconv.FormatFloat(f, 'g', -1, 64), 10, n.Prec(), big.ToNearestEven)
+		if err != nil || d.Cmp(n) != 0 {
+			return 0, fmt.Errorf("value %s can't be held by a 64-bit floating point number without losing precision", n.Text('g', -1))
+		}
+	}
+	return f, nil
+}
+
+var _ attr.Value = Float64{}
+
+// Float64 represents a floating point value that fits in a float64. It is a
+// number to Terraform, but saves providers from converting *big.Floats for
+// values that are float64s in the APIs they use.
+type Float64 struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value float64
+}
+
+// Float64Value returns a known Float64 holding `value`.
+func Float64Value(value float64) Float64 {
+	return Float64{Value: value}
+}
+
+// Float64Null returns a null Float64.
+func Float64Null() Float64 {
+	return Float64{Null: true}
+}
+
+// Float64Unknown returns an unknown Float64.
+func Float64Unknown() Float64 {
+	return Float64{Unknown: true}
+}
+
+// ToTerraformValue returns the data contained in the Float64 as a
+// *big.Float, with the precision Terraform uses, so 0.1 is the same number
+// as a 0.1 in the configuration. If Unknown is true, it returns a
+// tftypes.UnknownValue. If Null is true, it returns nil. It returns an error
+// if the value is NaN or infinite, which Terraform can't represent.
+func (f Float64) ToTerraformValue(_ context.Context) (interface{}, error) {
+	if f.Null {
+		return nil, nil
+	}
+	if f.Unknown {
+		return tftypes.UnknownValue, nil
+	}
+	if math.IsNaN(f.Value) || math.IsInf(f.Value, 0) {
+		return nil, fmt.Errorf("can't use %v as a number", f.Value)
+	}
+	n, _, err := big.ParseFloat(strconv.FormatFloat(f.Value, 'g', -1, 64), 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// ToFloat64Value returns `f`, to implement Float64Valuable.
+func (f Float64) ToFloat64Value(_ context.Context) (Float64, error) {
+	return f, nil
+}
+
+// Equal returns true if `other` is a Float64 and has the same value as `f`.
+func (f Float64) Equal(other attr.Value) bool {
+	o, ok := other.(Float64)
+	if !ok {
+		return false
+	}
+	if f.Unknown != o.Unknown {
+		return false
+	}
+	if f.Null != o.Null {
+		return false
+	}
+	return f.Value == o.Value
+}
+
+// IsNull returns true if the Float64 is null.
+func (f Float64) IsNull() bool {
+	return f.Null
+}
+
+// IsUnknown returns true if the Float64 is unknown.
+func (f Float64) IsUnknown() bool {
+	return f.Unknown
+}
+
+// String returns the number in its shortest decimal form, like "1.5", or
+// "<null>" or "<unknown>".
+func (f Float64) String() string {
+	switch {
+	case f.Null:
+		return "<null>"
+	case f.Unknown:
+		return "<unknown>"
+	}
+	return strconv.FormatFloat(f.Value, 'g', -1, 64)
+}
@@ -0,0 +1,223 @@
+package types
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testDecimal(t *testing.T, s string) *big.Float {
+	n, err := ParseNumberExact(s)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return n.Value
+}
+
+func TestFloat64ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testFloat64ValueFromTerraform(t, true)
+}
+
+func testFloat64ValueFromTerraform(t *testing.T, direct bool) {
+	type testCase struct {
+		input       tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       tftypes.NewValue(tftypes.Number, 1.5),
+			expectation: Float64{Value: 1.5},
+		},
+		"decimal": {
+			input:       tftypes.NewValue(tftypes.Number, testDecimal(t, "0.1")),
+			expectation: Float64{Value: 0.1},
+		},
+		"integer": {
+			input:       tftypes.NewValue(tftypes.Number, testDecimal(t, "123")),
+			expectation: Float64{Value: 123},
+		},
+		"unknown": {
+			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: Float64{Unknown: true},
+		},
+		"null": {
+			input:       tftypes.NewValue(tftypes.Number, nil),
+			expectation: Float64{Null: true},
+		},
+		"overflow": {
+			input:       tftypes.NewValue(tftypes.Number, testDecimal(t, "1e400")),
+			expectedErr: "value 1e+400 is out of range for a 64-bit floating point number",
+		},
+		"precision": {
+			input:       tftypes.NewValue(tftypes.Number, testDecimal(t, "0.10000000000000000000001")),
+			expectedErr: "value 0.10000000000000000000001 can't be held by a 64-bit floating point number without losing precision",
+		},
+		"large-integer": {
+			input:       tftypes.NewValue(tftypes.Number, testDecimal(t, "9007199254740993")),
+			expectedErr: "value 9.007199254740993e+15 can't be held by a 64-bit floating point number without losing precision",
+		},
+		"wrongType": {
+			input:       tftypes.NewValue(tftypes.String, "oops"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			f := Float64Type.ValueFromTerraform
+			if direct {
+				f = float64ValueFromTerraform
+			}
+			got, err := f(ctx, test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if test.expectedErr != err.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, didn't get an error", test.expectedErr)
+				return
+			}
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat64ToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float64
+		expectation interface{}
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       Float64{Value: 1.5},
+			expectation: big.NewFloat(1.5),
+		},
+		"decimal": {
+			input:       Float64{Value: 0.1},
+			expectation: testDecimal(t, "0.1"),
+		},
+		"unknown": {
+			input:       Float64{Unknown: true},
+			expectation: tftypes.UnknownValue,
+		},
+		"null": {
+			input:       Float64{Null: true},
+			expectation: nil,
+		},
+		"nan": {
+			input:       Float64{Value: math.NaN()},
+			expectedErr: "can't use NaN as a number",
+		},
+		"infinity": {
+			input:       Float64{Value: math.Inf(1)},
+			expectedErr: "can't use +Inf as a number",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := test.input.ToTerraformValue(ctx)
+			if err != nil {
+				if test.expectedErr != err.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, didn't get an error", test.expectedErr)
+				return
+			}
+			if !cmp.Equal(got, test.expectation, cmp.Comparer(numberComparer)) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat64Equal(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float64
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"value-value-same": {
+			input:       Float64{Value: 1.5},
+			candidate:   Float64{Value: 1.5},
+			expectation: true,
+		},
+		"value-value-diff": {
+			input:       Float64{Value: 1.5},
+			candidate:   Float64{Value: 2.5},
+			expectation: false,
+		},
+		"value-unknown": {
+			input:       Float64{Value: 1.5},
+			candidate:   Float64{Unknown: true},
+			expectation: false,
+		},
+		"value-null": {
+			input:       Float64{Value: 1.5},
+			candidate:   Float64{Null: true},
+			expectation: false,
+		},
+		"value-number": {
+			input:       Float64{Value: 1.5},
+			candidate:   Number{Value: big.NewFloat(1.5)},
+			expectation: false,
+		},
+		"value-nil": {
+			input:       Float64{Value: 1.5},
+			candidate:   nil,
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       Float64{Unknown: true},
+			candidate:   Float64{Unknown: true},
+			expectation: true,
+		},
+		"null-null": {
+			input:       Float64{Null: true},
+			candidate:   Float64{Null: true},
+			expectation: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
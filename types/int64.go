@@ -0,0 +1,130 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func int64ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Int64{Unknown: true}, nil
+	}
+	if in.IsNull() {
+		return Int64{Null: true}, nil
+	}
+	n := new(big.Float)
+	err := in.As(&n)
+	if err != nil {
+		return nil, err
+	}
+	i, err := bigFloatToInt64(n)
+	if err != nil {
+		return nil, err
+	}
+	return Int64{Value: i}, nil
+}
+
+// bigFloatToInt64 returns `n` as an int64, or an error if it isn't an integer
+// or doesn't fit in one.
+func bigFloatToInt64(n *big.Float) (int64, error) {
+	if !n.IsInt() {
+		return 0, fmt.Errorf("value %s is not an integer", n.Text('f', -1))
+	}
+	i, acc := n.Int64()
+	if acc != big.Exact {
+		return 0, fmt.Errorf("value %s doesn't fit in a 64-bit integer", n.Text('f', -1))
+	}
+	return i, nil
+}
+
+var _ attr.Value = Int64{}
+
+// Int64 represents an integer value that fits in an int64. It is a number to
+// Terraform, but saves providers from converting *big.Floats for values like
+// IDs and counts that are always integers.
+type Int64 struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Value contains the set value, as long as Unknown and Null are both
+	// false.
+	Value int64
+}
+
+// Int64Value returns a known Int64 holding `value`.
+func Int64Value(value int64) Int64 {
+	return Int64{Value: value}
+}
+
+// Int64Null returns a null Int64.
+func Int64Null() Int64 {
+	return Int64{Null: true}
+}
+
+// Int64Unknown returns an unknown Int64.
+func Int64Unknown() Int64 {
+	return Int64{Unknown: true}
+}
+
+// ToTerraformValue returns the data contained in the Int64 as a *big.Float.
+// If Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
+// returns nil.
+func (i Int64) ToTerraformValue(_ context.Context) (interface{}, error) {
+	if i.Null {
+		return nil, nil
+	}
+	if i.Unknown {
+		return tftypes.UnknownValue, nil
+	}
+	return new(big.Float).SetInt64(i.Value), nil
+}
+
+// ToInt64Value returns `i`, to implement Int64Valuable.
+func (i Int64) ToInt64Value(_ context.Context) (Int64, error) {
+	return i, nil
+}
+
+// Equal returns true if `other` is an Int64 and has the same value as `i`.
+func (i Int64) Equal(other attr.Value) bool {
+	o, ok := other.(Int64)
+	if !ok {
+		return false
+	}
+	if i.Unknown != o.Unknown {
+		return false
+	}
+	if i.Null != o.Null {
+		return false
+	}
+	return i.Value == o.Value
+}
+
+// IsNull returns true if the Int64 is null.
+func (i Int64) IsNull() bool {
+	return i.Null
+}
+
+// IsUnknown returns true if the Int64 is unknown.
+func (i Int64) IsUnknown() bool {
+	return i.Unknown
+}
+
+// String returns the number, like "42", or "<null>" or "<unknown>".
+func (i Int64) String() string {
+	switch {
+	case i.Null:
+		return "<null>"
+	case i.Unknown:
+		return "<unknown>"
+	}
+	return strconv.FormatInt(i.Value, 10)
+}
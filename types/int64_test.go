@@ -0,0 +1,238 @@
+package types
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInt64ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testInt64ValueFromTerraform(t, true)
+}
+
+func testInt64ValueFromTerraform(t *testing.T, direct bool) {
+	tooBig, _, _ := big.ParseFloat("9223372036854775808", 10, 512, big.ToNearestEven)
+
+	type testCase struct {
+		input       tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectation: Int64{Value: 123},
+		},
+		"negative": {
+			input:       tftypes.NewValue(tftypes.Number, -123),
+			expectation: Int64{Value: -123},
+		},
+		"unknown": {
+			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: Int64{Unknown: true},
+		},
+		"null": {
+			input:       tftypes.NewValue(tftypes.Number, nil),
+			expectation: Int64{Null: true},
+		},
+		"fraction": {
+			input:       tftypes.NewValue(tftypes.Number, 1.5),
+			expectedErr: "value 1.5 is not an integer",
+		},
+		"overflow": {
+			input:       tftypes.NewValue(tftypes.Number, tooBig),
+			expectedErr: "value 9223372036854775808 doesn't fit in a 64-bit integer",
+		},
+		"wrongType": {
+			input:       tftypes.NewValue(tftypes.String, "oops"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			f := Int64Type.ValueFromTerraform
+			if direct {
+				f = int64ValueFromTerraform
+			}
+			got, err := f(ctx, test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if test.expectedErr != err.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, didn't get an error", test.expectedErr)
+				return
+			}
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestInt64ToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Int64
+		expectation interface{}
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       Int64{Value: 123},
+			expectation: big.NewFloat(123),
+		},
+		"unknown": {
+			input:       Int64{Unknown: true},
+			expectation: tftypes.UnknownValue,
+		},
+		"null": {
+			input:       Int64{Null: true},
+			expectation: nil,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := test.input.ToTerraformValue(ctx)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if !cmp.Equal(got, test.expectation, cmp.Comparer(numberComparer)) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestInt64Equal(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Int64
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"value-value-same": {
+			input:       Int64{Value: 123},
+			candidate:   Int64{Value: 123},
+			expectation: true,
+		},
+		"value-value-diff": {
+			input:       Int64{Value: 123},
+			candidate:   Int64{Value: 456},
+			expectation: false,
+		},
+		"value-unknown": {
+			input:       Int64{Value: 123},
+			candidate:   Int64{Unknown: true},
+			expectation: false,
+		},
+		"value-null": {
+			input:       Int64{Value: 123},
+			candidate:   Int64{Null: true},
+			expectation: false,
+		},
+		"value-number": {
+			input:       Int64{Value: 123},
+			candidate:   Number{Value: big.NewFloat(123)},
+			expectation: false,
+		},
+		"value-nil": {
+			input:       Int64{Value: 123},
+			candidate:   nil,
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       Int64{Unknown: true},
+			candidate:   Int64{Unknown: true},
+			expectation: true,
+		},
+		"unknown-null": {
+			input:       Int64{Unknown: true},
+			candidate:   Int64{Null: true},
+			expectation: false,
+		},
+		"null-null": {
+			input:       Int64{Null: true},
+			candidate:   Int64{Null: true},
+			expectation: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestInt64Reflection(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objType := ObjectType{AttrTypes: map[string]attr.Type{
+		"id":    Int64Type,
+		"count": Int64Type,
+	}}
+	type target struct {
+		ID    int64 `tfsdk:"id"`
+		Count Int64 `tfsdk:"count"`
+	}
+	val := tftypes.NewValue(objType.TerraformType(ctx), map[string]tftypes.Value{
+		"id":    tftypes.NewValue(tftypes.Number, new(big.Float).SetInt64(9007199254740993)),
+		"count": tftypes.NewValue(tftypes.Number, nil),
+	})
+
+	var got target
+	err := refl.Into(ctx, objType, val, &got, refl.Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := target{ID: 9007199254740993, Count: Int64{Null: true}}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	out, err := refl.OutOf(ctx, objType, got)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expectedOut := Object{
+		AttrTypes: objType.AttrTypes,
+		Attrs: map[string]attr.Value{
+			"id":    Int64{Value: 9007199254740993},
+			"count": Int64{Null: true},
+		},
+	}
+	if !out.Equal(expectedOut) {
+		t.Errorf("Expected %+v, got %+v", expectedOut, out)
+	}
+}
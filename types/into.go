@@ -0,0 +1,247 @@
+package types
+
+import (
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ErrUnknownValue is returned, wrapped in a tftypes.AttributePathError
+// holding the path of the unknown value, by Object.As, List.ElementsAs, and
+// Map.ElementsAs when they're set to treat unknown values as errors.
+var ErrUnknownValue = errors.New("value is unknown")
+
+// ElementsAsOptions is a collection of toggles to control the behavior of
+// List.ElementsAs and Map.ElementsAs.
+type ElementsAsOptions struct {
+	// UnhandledNullAsEmpty controls what happens when ElementsAs needs to
+	// put a null value in a type that has no way to preserve that
+	// distinction. When set to true, the type's empty value will be used.
+	// When set to false, an error will be returned.
+	UnhandledNullAsEmpty bool
+
+	// UnhandledUnknownAsEmpty controls what happens when ElementsAs needs
+	// to put an unknown value in a type that has no way to preserve that
+	// distinction. When set to true, the type's empty value will be used.
+	// When set to false, an error will be returned.
+	UnhandledUnknownAsEmpty bool
+
+	// UnknownAsError makes ElementsAs return an error if the collection,
+	// or any value nested inside it, is unknown, even if `target` could
+	// hold unknown values. The error wraps ErrUnknownValue and is a
+	// tftypes.AttributePathError holding the path of the unknown value.
+	UnknownAsError bool
+}
+
+// findUnknown returns an error wrapping ErrUnknownValue with the path of the
+// first unknown value found in `val`, which is of the type produced by `typ`,
+// or nil if `val` is entirely known.
+func findUnknown(ctx context.Context, typ attr.Type, val attr.Value) error {
+	tfType := typ.TerraformType(ctx)
+	raw, err := val.ToTerraformValue(ctx)
+	if err != nil {
+		return err
+	}
+	tfVal, err := refl.NewTerraformValue(tfType, raw)
+	if err != nil {
+		return err
+	}
+	var found bool
+	var unknownPath *tftypes.AttributePath
+	err = tftypes.Walk(tfVal, func(path *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		if found {
+			return false, nil
+		}
+		if !v.IsKnown() {
+			found = true
+			unknownPath = path
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	if unknownPath == nil {
+		// the value itself is unknown
+		unknownPath = tftypes.NewAttributePath()
+	}
+	return unknownPath.NewError(ErrUnknownValue)
+}
+
+// into populates `target` with the data in `val`, which is of the type
+// produced by `typ`. It is the shared implementation of Object.As,
+// List.ElementsAs, and Map.ElementsAs.
+func into(ctx context.Context, typ attr.Type, val attr.Value, target interface{}, opts refl.Options) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr {
+		return fmt.Errorf("target must be a pointer, got %T, which is a %s", target, v.Kind())
+	}
+	result, err := valueInto(ctx, typ, val, v.Elem(), opts, tftypes.NewAttributePath())
+	if err != nil {
+		return err
+	}
+	v.Elem().Set(result)
+	return nil
+}
+
+// valueInto builds a reflect.Value of the same type as `target`, populated
+// with the data in `val`. Objects, Lists, and Maps are reflected into structs,
+// slices, and maps directly, and attr.Values that already have the type of
+// their target are used as-is, so the data doesn't need to be converted to a
+// tftypes.Value and parsed again. Anything else is converted to a
+// tftypes.Value and handed to the reflection package.
+func valueInto(ctx context.Context, typ attr.Type, val attr.Value, target reflect.Value, opts refl.Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	if !target.IsValid() {
+		return target, path.NewErrorf("invalid target")
+	}
+	if val != nil && reflect.TypeOf(val) == target.Type() {
+		return reflect.ValueOf(val), nil
+	}
+	if val == nil || hasReflectionOverride(target.Type()) {
+		return valueIntoFromTerraform(ctx, typ, val, target, opts, path)
+	}
+	switch v := val.(type) {
+	case Object:
+		if !v.Null && !v.Unknown && target.Kind() == reflect.Struct {
+			return objectIntoStruct(ctx, typ, v, target, opts, path)
+		}
+	case List:
+		if !v.Null && !v.Unknown && target.Kind() == reflect.Slice {
+			return listIntoSlice(ctx, v, target, opts, path)
+		}
+	case Map:
+		if !v.Null && !v.Unknown && target.Kind() == reflect.Map && isPlainStringKey(target.Type().Key()) {
+			return mapIntoMap(ctx, v, target, opts, path)
+		}
+	}
+	return valueIntoFromTerraform(ctx, typ, val, target, opts, path)
+}
+
+// hasReflectionOverride returns true if `typ` customizes how the reflection
+// package populates it, or is a pointer or number type the reflection package
+// handles specially, meaning it must always be handed to the reflection
+// package.
+func hasReflectionOverride(typ reflect.Type) bool {
+	if typ.Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
+		return true
+	}
+	if typ.Implements(reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()) {
+		return true
+	}
+	if typ.Implements(reflect.TypeOf((*refl.Unknownable)(nil)).Elem()) {
+		return true
+	}
+	if typ.Implements(reflect.TypeOf((*refl.Nullable)(nil)).Elem()) {
+		return true
+	}
+	if typ == reflect.TypeOf(big.NewFloat(0)) || typ == reflect.TypeOf(big.NewInt(0)) {
+		return true
+	}
+	return typ.Kind() == reflect.Ptr
+}
+
+// isPlainStringKey returns true if `typ` is a string type that doesn't
+// implement encoding.TextUnmarshaler, meaning map keys can be converted to it
+// directly instead of being parsed by the reflection package.
+func isPlainStringKey(typ reflect.Type) bool {
+	if typ.Kind() != reflect.String {
+		return false
+	}
+	return !reflect.PtrTo(typ).Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem())
+}
+
+// valueIntoFromTerraform converts `val` to a tftypes.Value and uses the
+// reflection package to populate `target` with it.
+func valueIntoFromTerraform(ctx context.Context, typ attr.Type, val attr.Value, target reflect.Value, opts refl.Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	if val == nil {
+		return target, path.NewErrorf("no value set")
+	}
+	raw, err := val.ToTerraformValue(ctx)
+	if err != nil {
+		return target, path.NewError(err)
+	}
+	tfVal, err := refl.NewTerraformValue(typ.TerraformType(ctx), raw)
+	if err != nil {
+		return target, path.NewError(err)
+	}
+	return refl.BuildValue(ctx, typ, tfVal, target, opts, path)
+}
+
+// objectIntoStruct populates a struct of the same type as `target` with the
+// attributes of `val`. If the struct's fields don't exactly match the
+// attributes of `val`, the reflection package is used instead, so it can
+// report the mismatch. Structs with struct tag options are also handed to the
+// reflection package, which knows how to apply them.
+func objectIntoStruct(ctx context.Context, typ attr.Type, val Object, target reflect.Value, opts refl.Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	fields, err := refl.StructFields(ctx, target, path)
+	if err != nil {
+		return target, fmt.Errorf("error retrieving field names from struct tags: %w", err)
+	}
+	if len(fields) != len(val.AttrTypes) {
+		return valueIntoFromTerraform(ctx, typ, val, target, opts, path)
+	}
+	for field, info := range fields {
+		if _, ok := val.AttrTypes[field]; !ok {
+			return valueIntoFromTerraform(ctx, typ, val, target, opts, path)
+		}
+		if len(info.Options) > 0 {
+			return valueIntoFromTerraform(ctx, typ, val, target, opts, path)
+		}
+	}
+	result := reflect.New(target.Type()).Elem()
+	for _, field := range order.Keys(fields) {
+		info := fields[field]
+		structField := result.Field(info.Index)
+		fieldVal, err := valueInto(ctx, val.AttrTypes[field], val.Attrs[field], structField, opts, path.WithAttributeName(field))
+		if err != nil {
+			return target, err
+		}
+		structField.Set(fieldVal)
+	}
+	return result, nil
+}
+
+// listIntoSlice populates a slice of the same type as `target` with the
+// elements of `val`.
+func listIntoSlice(ctx context.Context, val List, target reflect.Value, opts refl.Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	elemType := target.Type().Elem()
+	slice := reflect.MakeSlice(target.Type(), 0, len(val.Elems))
+	for pos, elem := range val.Elems {
+		elemVal, err := valueInto(ctx, val.ElemType, elem, reflect.Zero(elemType), opts, path.WithElementKeyInt(int64(pos)))
+		if err != nil {
+			return target, err
+		}
+		slice = reflect.Append(slice, elemVal)
+	}
+	return slice, nil
+}
+
+// mapIntoMap populates a map of the same type as `target` with the elements
+// of `val`. The keys of `target` must be a string type that doesn't implement
+// encoding.TextUnmarshaler.
+func mapIntoMap(ctx context.Context, val Map, target reflect.Value, opts refl.Options, path *tftypes.AttributePath) (reflect.Value, error) {
+	keyType := target.Type().Key()
+	elemType := target.Type().Elem()
+	m := reflect.MakeMapWithSize(target.Type(), len(val.Elems))
+	for _, key := range order.Keys(val.Elems) {
+		elem := val.Elems[key]
+		elemVal, err := valueInto(ctx, val.ElemType, elem, reflect.Zero(elemType), opts, path.WithElementKeyString(key))
+		if err != nil {
+			return target, err
+		}
+		m.SetMapIndex(reflect.ValueOf(key).Convert(keyType), elemVal)
+	}
+	return m, nil
+}
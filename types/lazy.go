@@ -0,0 +1,105 @@
+package types
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ attr.ValueWithType = Lazy{}
+
+// Lazy holds a tftypes.Value without converting it into an attr.Value until
+// the value is needed. Struct fields of type Lazy tagged with the "lazy"
+// option, like `tfsdk:"rules,lazy"`, are filled in without converting the
+// value, even when the rest of the struct uses Go types. This lets providers
+// put off converting large nested values they rarely use.
+type Lazy struct {
+	typ attr.Type
+	raw tftypes.Value
+}
+
+// NewLazy returns a Lazy holding `val`, which will be converted using `typ`.
+func NewLazy(typ attr.Type, val tftypes.Value) Lazy {
+	return Lazy{
+		typ: typ,
+		raw: val,
+	}
+}
+
+// SetTerraformValue sets the type and value the Lazy holds. It is called when
+// filling in struct fields with the "lazy" tag option.
+func (l *Lazy) SetTerraformValue(_ context.Context, typ attr.Type, val tftypes.Value) error {
+	if typ == nil {
+		return errors.New("can't create a Lazy value with a nil type")
+	}
+	l.typ = typ
+	l.raw = val
+	return nil
+}
+
+// Raw returns the tftypes.Value the Lazy holds, without converting it.
+func (l Lazy) Raw() tftypes.Value {
+	return l.raw
+}
+
+// IsNull returns true if the value the Lazy holds is null, without
+// converting it.
+func (l Lazy) IsNull() bool {
+	return l.raw.IsNull()
+}
+
+// IsUnknown returns true if the value the Lazy holds is unknown, without
+// converting it.
+func (l Lazy) IsUnknown() bool {
+	return !l.raw.IsKnown()
+}
+
+// String returns the value the Lazy holds, rendered like values of its type,
+// without converting it.
+func (l Lazy) String() string {
+	return terraformValueString(l.raw)
+}
+
+// Value converts the value the Lazy holds into an attr.Value using its type.
+// The value is converted again every time Value is called, so callers
+// should keep the result if they need it more than once.
+func (l Lazy) Value(ctx context.Context) (attr.Value, error) {
+	if l.typ == nil {
+		return nil, errors.New("can't convert a Lazy value that was never set")
+	}
+	return l.typ.ValueFromTerraform(ctx, l.raw)
+}
+
+// Type returns the attr.Type the Lazy converts its value with, or nil if it
+// was never set.
+func (l Lazy) Type(_ context.Context) attr.Type {
+	return l.typ
+}
+
+// ToTerraformValue converts the value the Lazy holds, and returns the result
+// of calling ToTerraformValue on it.
+func (l Lazy) ToTerraformValue(ctx context.Context) (interface{}, error) {
+	val, err := l.Value(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return val.ToTerraformValue(ctx)
+}
+
+// Equal returns true if `other` is a Lazy holding an equal value with an
+// equal type. Values are compared without converting them.
+func (l Lazy) Equal(other attr.Value) bool {
+	o, ok := other.(Lazy)
+	if !ok {
+		return false
+	}
+	if (l.typ == nil) != (o.typ == nil) {
+		return false
+	}
+	if l.typ != nil && !l.typ.Equal(o.typ) {
+		return false
+	}
+	return l.raw.Equal(o.raw)
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestLazyValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		lazy            Lazy
+		expected        interface{}
+		expectedNull    bool
+		expectedUnknown bool
+		expectedError   string
+	}
+
+	tests := map[string]testCase{
+		"known": {
+			lazy:     NewLazy(StringType, tftypes.NewValue(tftypes.String, "hello")),
+			expected: String{Value: "hello"},
+		},
+		"null": {
+			lazy:         NewLazy(StringType, tftypes.NewValue(tftypes.String, nil)),
+			expected:     String{Null: true},
+			expectedNull: true,
+		},
+		"unknown": {
+			lazy:            NewLazy(StringType, tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			expected:        String{Unknown: true},
+			expectedUnknown: true,
+		},
+		"never-set": {
+			lazy:          Lazy{},
+			expectedNull:  true,
+			expectedError: "can't convert a Lazy value that was never set",
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.lazy.IsNull(); got != tc.expectedNull {
+				t.Errorf("Expected IsNull to be %v, got %v", tc.expectedNull, got)
+			}
+			if got := tc.lazy.IsUnknown(); got != tc.expectedUnknown {
+				t.Errorf("Expected IsUnknown to be %v, got %v", tc.expectedUnknown, got)
+			}
+			got, err := tc.lazy.Value(context.Background())
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("Expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLazyEqual(t *testing.T) {
+	t.Parallel()
+
+	hello := NewLazy(StringType, tftypes.NewValue(tftypes.String, "hello"))
+	if !hello.Equal(NewLazy(StringType, tftypes.NewValue(tftypes.String, "hello"))) {
+		t.Error("Expected lazy values holding the same value to be equal")
+	}
+	if hello.Equal(NewLazy(StringType, tftypes.NewValue(tftypes.String, "world"))) {
+		t.Error("Expected lazy values holding different values not to be equal")
+	}
+	if hello.Equal(String{Value: "hello"}) {
+		t.Error("Expected a lazy value not to equal a converted value")
+	}
+	if hello.Equal(Lazy{}) {
+		t.Error("Expected a lazy value not to equal a lazy value that was never set")
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// checkLength returns an error if `n`, the number of elements in a value of
+// the collection type `kind`, is less than `min` or greater than `max`. A
+// `min` or `max` of zero or less isn't checked.
+func checkLength(kind string, n, min, max int) error {
+	if min > 0 && n < min {
+		return fmt.Errorf("%s must have at least %d elements, got %d", kind, min, n)
+	}
+	if max > 0 && n > max {
+		return fmt.Errorf("%s must have at most %d elements, got %d", kind, max, n)
+	}
+	return nil
+}
+
+// validateLength returns an error diagnostic if `in`, a list or map of the
+// collection type `kind`, has a number of elements checkLength rejects.
+func validateLength(kind string, in tftypes.Value, min, max int) []*tfprotov6.Diagnostic {
+	if (min <= 0 && max <= 0) || !in.IsKnown() || in.IsNull() {
+		return nil
+	}
+	var n int
+	switch in.Type().(type) {
+	case tftypes.Map:
+		var elems map[string]tftypes.Value
+		if err := in.As(&elems); err != nil {
+			return nil
+		}
+		n = len(elems)
+	default:
+		var elems []tftypes.Value
+		if err := in.As(&elems); err != nil {
+			return nil
+		}
+		n = len(elems)
+	}
+	if err := checkLength(kind, n, min, max); err != nil {
+		return []*tfprotov6.Diagnostic{
+			{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Invalid number of elements",
+				Detail:   fmt.Sprintf("The %s.", err.Error()),
+			},
+		}
+	}
+	return nil
+}
+
+// lengthDescription describes the number of elements values of the
+// collection type `kind` can have, or returns an empty string if there's no
+// limit.
+func lengthDescription(kind string, min, max int) string {
+	switch {
+	case min > 0 && max > 0:
+		return fmt.Sprintf("A %s with between %d and %d elements.", kind, min, max)
+	case min > 0:
+		return fmt.Sprintf("A %s with at least %d elements.", kind, min)
+	case max > 0:
+		return fmt.Sprintf("A %s with at most %d elements.", kind, max)
+	}
+	return ""
+}
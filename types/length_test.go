@@ -0,0 +1,95 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCollectionTypeLength(t *testing.T) {
+	t.Parallel()
+
+	stringList := func(n int) []tftypes.Value {
+		vals := make([]tftypes.Value, 0, n)
+		for i := 0; i < n; i++ {
+			vals = append(vals, tftypes.NewValue(tftypes.String, "value"))
+		}
+		return vals
+	}
+	stringMap := func(keys ...string) map[string]tftypes.Value {
+		vals := make(map[string]tftypes.Value, len(keys))
+		for _, key := range keys {
+			vals[key] = tftypes.NewValue(tftypes.String, "value")
+		}
+		return vals
+	}
+	listType := tftypes.List{ElementType: tftypes.String}
+	mapType := tftypes.Map{AttributeType: tftypes.String}
+
+	type testCase struct {
+		typ                 attr.TypeWithValidate
+		input               tftypes.Value
+		expectedDescription string
+		expectedDetail      string
+	}
+	tests := map[string]testCase{
+		"list-within": {
+			typ:                 ListType{ElemType: StringType, MinElems: 1, MaxElems: 2},
+			input:               tftypes.NewValue(listType, stringList(2)),
+			expectedDescription: "A list with between 1 and 2 elements.",
+		},
+		"list-too-few": {
+			typ:                 ListType{ElemType: StringType, MinElems: 1},
+			input:               tftypes.NewValue(listType, stringList(0)),
+			expectedDescription: "A list with at least 1 elements.",
+			expectedDetail:      "The list must have at least 1 elements, got 0.",
+		},
+		"list-unknown": {
+			typ:                 ListType{ElemType: StringType, MinElems: 1},
+			input:               tftypes.NewValue(listType, tftypes.UnknownValue),
+			expectedDescription: "A list with at least 1 elements.",
+		},
+		"map-too-many": {
+			typ:                 MapType{ElemType: StringType, MaxElems: 1},
+			input:               tftypes.NewValue(mapType, stringMap("a", "b")),
+			expectedDescription: "A map with at most 1 elements.",
+			expectedDetail:      "The map must have at most 1 elements, got 2.",
+		},
+		"map-unconstrained": {
+			typ:   MapType{ElemType: StringType},
+			input: tftypes.NewValue(mapType, stringMap("a", "b")),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			description := test.typ.(attr.TypeWithPlaintextDescription).Description(context.Background())
+			if description != test.expectedDescription {
+				t.Errorf("Expected description %q, got %q", test.expectedDescription, description)
+			}
+
+			diags := test.typ.Validate(context.Background(), test.input)
+			if test.expectedDetail == "" {
+				if len(diags) > 0 {
+					t.Errorf("Unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Detail != test.expectedDetail {
+				t.Errorf("Expected a diagnostic with detail %q, got %v", test.expectedDetail, diags)
+			}
+
+			// the limits are only enforced by Validate, so values
+			// read from existing state never fail
+			_, err := test.typ.ValueFromTerraform(context.Background(), test.input)
+			if err != nil {
+				t.Errorf("Unexpected error from ValueFromTerraform: %s", err)
+			}
+		})
+	}
+}
@@ -3,16 +3,20 @@ package types
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 var (
-	_ attr.Type  = ListType{}
-	_ attr.Value = &List{}
+	_ attr.Type                         = ListType{}
+	_ attr.TypeWithValidate             = ListType{}
+	_ attr.TypeWithPlaintextDescription = ListType{}
+	_ attr.Value                        = &List{}
 )
 
 // ListType is an AttributeType representing a list of values. All values must
@@ -20,6 +24,15 @@ var (
 // property.
 type ListType struct {
 	ElemType attr.Type
+
+	// MinElems and MaxElems, if greater than zero, are the fewest and
+	// most elements lists of the type can have. Lists with a number of
+	// elements outside them are rejected when validating config. They
+	// don't affect the type's equality or how values are read, so state
+	// written before the limits were added can still be read. Null and
+	// unknown lists are always allowed.
+	MinElems int
+	MaxElems int
 }
 
 // ElementType returns the attr.Type elements will be created from.
@@ -30,7 +43,7 @@ func (l ListType) ElementType() attr.Type {
 // WithElementType returns a ListType that is identical to `l`, but with the
 // element type set to `typ`.
 func (l ListType) WithElementType(typ attr.Type) attr.TypeWithElementType {
-	return ListType{ElemType: typ}
+	return ListType{ElemType: typ, MinElems: l.MinElems, MaxElems: l.MaxElems}
 }
 
 // TerraformType returns the tftypes.Type that should be used to
@@ -79,7 +92,13 @@ func (l ListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	return list, nil
 }
 
+// ValueFromList returns `in`, to implement ListTypable.
+func (l ListType) ValueFromList(_ context.Context, in List) (ListValuable, error) {
+	return in, nil
+}
+
 // Equal returns true if `o` is also a ListType and has the same ElemType.
+// MinElems and MaxElems are only used for validation, and are ignored.
 func (l ListType) Equal(o attr.Type) bool {
 	if l.ElemType == nil {
 		return false
@@ -91,6 +110,18 @@ func (l ListType) Equal(o attr.Type) bool {
 	return l.ElemType.Equal(other.ElemType)
 }
 
+// Validate returns an error diagnostic if `in` is a list with a number of
+// elements outside MinElems and MaxElems.
+func (l ListType) Validate(_ context.Context, in tftypes.Value) []*tfprotov6.Diagnostic {
+	return validateLength("list", in, l.MinElems, l.MaxElems)
+}
+
+// Description describes the number of elements lists of the type can have,
+// or returns an empty string if there's no limit.
+func (l ListType) Description(_ context.Context) string {
+	return lengthDescription("list", l.MinElems, l.MaxElems)
+}
+
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
 // list.
 func (l ListType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
@@ -125,20 +156,68 @@ type List struct {
 	ElemType attr.Type
 }
 
+// ListValue returns a known List of `elems`, which are values of `elemType`.
+// It returns an error if any of `elems` is nil or isn't a value of
+// `elemType`.
+func ListValue(ctx context.Context, elemType attr.Type, elems []attr.Value) (List, error) {
+	b := NewListBuilder(elemType, len(elems))
+	for _, elem := range elems {
+		if err := b.Append(ctx, elem); err != nil {
+			return ListUnknown(elemType), err
+		}
+	}
+	return b.Build(), nil
+}
+
+// ListValueMust is like ListValue, but panics instead of returning an error.
+// It is meant for lists built from values fixed in the provider's code, like
+// defaults and test fixtures, where an error is always a bug.
+func ListValueMust(ctx context.Context, elemType attr.Type, elems []attr.Value) List {
+	list, err := ListValue(ctx, elemType, elems)
+	if err != nil {
+		panic(fmt.Sprintf("ListValueMust: can't build a List of %s: %s", elemType.TerraformType(ctx), err))
+	}
+	return list
+}
+
+// ListNull returns a null List of values of `elemType`.
+func ListNull(elemType attr.Type) List {
+	return List{ElemType: elemType, Null: true}
+}
+
+// ListUnknown returns an unknown List of values of `elemType`.
+func ListUnknown(elemType attr.Type) List {
+	return List{ElemType: elemType, Unknown: true}
+}
+
+// ListValueFrom returns a List of values of `elemType`, converted from the
+// elements of `val`, a Go slice or array, or a pointer to one. A nil slice
+// or pointer returns a null List. Elements are converted the same way
+// State.Set converts them.
+func ListValueFrom(ctx context.Context, elemType attr.Type, val interface{}) (List, error) {
+	v, err := reflect.OutOf(ctx, ListType{ElemType: elemType}, val)
+	if err != nil {
+		return ListUnknown(elemType), err
+	}
+	list, ok := v.(List)
+	if !ok {
+		return ListUnknown(elemType), fmt.Errorf("can't build a List from %T", val)
+	}
+	return list, nil
+}
+
 // ElementsAs populates `target` with the elements of the List, throwing an
 // error if the elements cannot be stored in `target`.
-func (l List) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) error {
-	// we need a tftypes.Value for this List to be able to use it with our
-	// reflection code
-	values, err := l.ToTerraformValue(ctx)
-	if err != nil {
-		return err
+func (l List) ElementsAs(ctx context.Context, target interface{}, opts ElementsAsOptions) error {
+	typ := ListType{ElemType: l.ElemType}
+	if opts.UnknownAsError {
+		if err := findUnknown(ctx, typ, l); err != nil {
+			return err
+		}
 	}
-	return reflect.Into(ctx, ListType{ElemType: l.ElemType}, tftypes.NewValue(tftypes.List{
-		ElementType: l.ElemType.TerraformType(ctx),
-	}, values), target, reflect.Options{
-		UnhandledNullAsEmpty:    allowUnhandled,
-		UnhandledUnknownAsEmpty: allowUnhandled,
+	return into(ctx, typ, l, target, reflect.Options{
+		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
 	})
 }
 
@@ -157,15 +236,20 @@ func (l List) ToTerraformValue(ctx context.Context) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
-		err = tftypes.ValidateValue(l.ElemType.TerraformType(ctx), val)
+		tfVal, err := reflect.NewTerraformValue(l.ElemType.TerraformType(ctx), val)
 		if err != nil {
 			return nil, fmt.Errorf("error validating terraform type: %w", err)
 		}
-		vals = append(vals, tftypes.NewValue(l.ElemType.TerraformType(ctx), val))
+		vals = append(vals, tfVal)
 	}
 	return vals, nil
 }
 
+// ToListValue returns `l`, to implement ListValuable.
+func (l List) ToListValue(_ context.Context) (List, error) {
+	return l, nil
+}
+
 // Equal must return true if the AttributeValue is considered
 // semantically equal to the AttributeValue passed as an argument.
 func (l List) Equal(o attr.Value) bool {
@@ -193,3 +277,66 @@ func (l List) Equal(o attr.Value) bool {
 	}
 	return true
 }
+
+// IsNull returns true if the List is null.
+func (l List) IsNull() bool {
+	return l.Null
+}
+
+// IsUnknown returns true if the List is unknown.
+func (l List) IsUnknown() bool {
+	return l.Unknown
+}
+
+// String returns the elements of the List in brackets, like ["a", "b"], or
+// "<null>" or "<unknown>".
+func (l List) String() string {
+	switch {
+	case l.Null:
+		return "<null>"
+	case l.Unknown:
+		return "<unknown>"
+	}
+	elems := make([]string, 0, len(l.Elems))
+	for _, elem := range l.Elems {
+		elems = append(elems, valueString(elem))
+	}
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+// Append returns a copy of `l` with `vals` added to the end of it. `l` is not
+// modified. If `l` is null, the result is a known list holding only `vals`.
+// If `l` is unknown, the result is still unknown, as the length of the list
+// isn't known.
+func (l List) Append(vals ...attr.Value) List {
+	if l.Unknown {
+		return List{Unknown: true, ElemType: l.ElemType}
+	}
+	var existing []attr.Value
+	if !l.Null {
+		existing = l.Elems
+	}
+	elems := make([]attr.Value, 0, len(existing)+len(vals))
+	elems = append(elems, existing...)
+	elems = append(elems, vals...)
+	return List{Elems: elems, ElemType: l.ElemType}
+}
+
+// Replace returns a copy of `l` with the element at `index` set to `val`. `l`
+// is not modified. It returns an error if `l` is null or unknown, or if
+// `index` is out of range.
+func (l List) Replace(index int, val attr.Value) (List, error) {
+	if l.Unknown {
+		return l, fmt.Errorf("can't replace element %d of an unknown list", index)
+	}
+	if l.Null {
+		return l, fmt.Errorf("can't replace element %d of a null list", index)
+	}
+	if index < 0 || index >= len(l.Elems) {
+		return l, fmt.Errorf("can't replace element %d of a list with %d elements", index, len(l.Elems))
+	}
+	elems := make([]attr.Value, len(l.Elems))
+	copy(elems, l.Elems)
+	elems[index] = val
+	return List{Elems: elems, ElemType: l.ElemType}, nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -153,6 +154,55 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 				},
 			},
 		},
+		"too-few-elements-not-validated": {
+			receiver: ListType{
+				ElemType: StringType,
+				MinElems: 2,
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expected: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+				},
+			},
+		},
+		"too-many-elements-not-validated": {
+			receiver: ListType{
+				ElemType: StringType,
+				MaxElems: 1,
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, "world"),
+			}),
+			expected: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+					String{Value: "world"},
+				},
+			},
+		},
+		"null-list-min-elements": {
+			receiver: ListType{
+				ElemType: StringType,
+				MinElems: 1,
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, nil),
+			expected: List{
+				ElemType: StringType,
+				Null:     true,
+			},
+		},
 	}
 	for name, test := range tests {
 		name, test := name, test
@@ -162,8 +212,8 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 				if test.expectedErr != "" {
 					if gotErr.Error() != test.expectedErr {
 						t.Errorf("Expected error to be %q, got %q", test.expectedErr, gotErr.Error())
-						return
 					}
+					return
 				}
 				t.Errorf("Unexpected error: %s", gotErr.Error())
 				return
@@ -198,6 +248,11 @@ func TestListTypeEqual(t *testing.T) {
 			input:    ListType{ElemType: NumberType},
 			expected: false,
 		},
+		"diff-length": {
+			receiver: ListType{ElemType: StringType, MaxElems: 1},
+			input:    ListType{ElemType: StringType},
+			expected: true,
+		},
 		"wrongType": {
 			receiver: ListType{ElemType: StringType},
 			input:    NumberType,
@@ -240,7 +295,7 @@ func TestListElementsAs_stringSlice(t *testing.T) {
 		Elems: []attr.Value{
 			String{Value: "hello"},
 			String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+		}}).ElementsAs(context.Background(), &stringSlice, ElementsAsOptions{})
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err.Error())
 	}
@@ -263,7 +318,7 @@ func TestListElementsAs_attributeValueSlice(t *testing.T) {
 		Elems: []attr.Value{
 			String{Value: "hello"},
 			String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+		}}).ElementsAs(context.Background(), &stringSlice, ElementsAsOptions{})
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err.Error())
 	}
@@ -272,6 +327,69 @@ func TestListElementsAs_attributeValueSlice(t *testing.T) {
 	}
 }
 
+func TestListElementsAs_options(t *testing.T) {
+	t.Parallel()
+
+	list := List{
+		ElemType: StringType,
+		Elems: []attr.Value{
+			String{Value: "hello"},
+			String{Null: true},
+			String{Unknown: true},
+		},
+	}
+
+	var stringSlice []string
+	err := list.ElementsAs(context.Background(), &stringSlice, ElementsAsOptions{
+		UnhandledNullAsEmpty: true,
+	})
+	if err == nil || err.Error() != "ElementKeyInt(2): unhandled unknown value" {
+		t.Errorf("Expected unhandled unknown error, got %v", err)
+	}
+
+	err = list.ElementsAs(context.Background(), &stringSlice, ElementsAsOptions{
+		UnhandledUnknownAsEmpty: true,
+	})
+	if err == nil || err.Error() != "ElementKeyInt(1): unhandled null value" {
+		t.Errorf("Expected unhandled null error, got %v", err)
+	}
+
+	err = list.ElementsAs(context.Background(), &stringSlice, ElementsAsOptions{
+		UnhandledNullAsEmpty:    true,
+		UnhandledUnknownAsEmpty: true,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(stringSlice, []string{"hello", "", ""}); diff != "" {
+		t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+	}
+
+	// []String could hold the unknown value, but UnknownAsError should
+	// still reject it
+	var valueSlice []String
+	err = list.ElementsAs(context.Background(), &valueSlice, ElementsAsOptions{
+		UnknownAsError: true,
+	})
+	if !errors.Is(err, ErrUnknownValue) {
+		t.Fatalf("Expected ErrUnknownValue, got %v", err)
+	}
+	var pathErr tftypes.AttributePathError
+	if !errors.As(err, &pathErr) {
+		t.Fatalf("Expected a tftypes.AttributePathError, got %T", err)
+	}
+	if !pathErr.Path.Equal(tftypes.NewAttributePath().WithElementKeyInt(2)) {
+		t.Errorf("Expected path to element 2, got %s", pathErr.Path)
+	}
+
+	err = List{ElemType: StringType, Unknown: true}.ElementsAs(context.Background(), &valueSlice, ElementsAsOptions{
+		UnknownAsError: true,
+	})
+	if err == nil || err.Error() != "value is unknown" {
+		t.Errorf("Expected unknown list error, got %v", err)
+	}
+}
+
 func TestListToTerraformValue(t *testing.T) {
 	t.Parallel()
 
@@ -640,3 +758,270 @@ func TestListEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestListAppend(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver List
+		input    []attr.Value
+		expected List
+	}
+	tests := map[string]testCase{
+		"value": {
+			receiver: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+				},
+			},
+			input: []attr.Value{String{Value: "world"}, String{Value: "test"}},
+			expected: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+					String{Value: "world"},
+					String{Value: "test"},
+				},
+			},
+		},
+		"null": {
+			receiver: List{ElemType: StringType, Null: true},
+			input:    []attr.Value{String{Value: "hello"}},
+			expected: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+				},
+			},
+		},
+		"unknown": {
+			receiver: List{ElemType: StringType, Unknown: true},
+			input:    []attr.Value{String{Value: "hello"}},
+			expected: List{ElemType: StringType, Unknown: true},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var before []attr.Value
+			before = append(before, test.receiver.Elems...)
+			got := test.receiver.Append(test.input...)
+			if !got.Equal(test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, got)
+			}
+			if diff := cmp.Diff(before, test.receiver.Elems); diff != "" {
+				t.Errorf("Receiver was modified (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListAppend_noSharedBackingArray(t *testing.T) {
+	t.Parallel()
+
+	elems := make([]attr.Value, 1, 4)
+	elems[0] = String{Value: "hello"}
+	l := List{ElemType: StringType, Elems: elems}
+
+	first := l.Append(String{Value: "first"})
+	second := l.Append(String{Value: "second"})
+	if !first.Elems[1].Equal(String{Value: "first"}) {
+		t.Errorf("Expected first append to be unaffected by second, got %+v", first.Elems)
+	}
+	if !second.Elems[1].Equal(String{Value: "second"}) {
+		t.Errorf("Expected second append to hold its own value, got %+v", second.Elems)
+	}
+}
+
+func TestListReplace(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver      List
+		index         int
+		expected      List
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"value": {
+			receiver: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+					String{Value: "world"},
+				},
+			},
+			index: 1,
+			expected: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+					String{Value: "new"},
+				},
+			},
+		},
+		"out-of-range": {
+			receiver: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+				},
+			},
+			index:         1,
+			expectedError: "can't replace element 1 of a list with 1 elements",
+		},
+		"negative": {
+			receiver: List{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+				},
+			},
+			index:         -1,
+			expectedError: "can't replace element -1 of a list with 1 elements",
+		},
+		"null": {
+			receiver:      List{ElemType: StringType, Null: true},
+			expectedError: "can't replace element 0 of a null list",
+		},
+		"unknown": {
+			receiver:      List{ElemType: StringType, Unknown: true},
+			expectedError: "can't replace element 0 of an unknown list",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var before []attr.Value
+			before = append(before, test.receiver.Elems...)
+			got, err := test.receiver.Replace(test.index, String{Value: "new"})
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if err.Error() != test.expectedError {
+					t.Fatalf("Expected error %q, got %q", test.expectedError, err.Error())
+				}
+				return
+			}
+			if test.expectedError != "" {
+				t.Fatalf("Expected error %q, got none", test.expectedError)
+			}
+			if !got.Equal(test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, got)
+			}
+			if diff := cmp.Diff(before, test.receiver.Elems); diff != "" {
+				t.Errorf("Receiver was modified (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got, err := ListValue(ctx, StringType, []attr.Value{StringValue("hello"), StringNull()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := List{
+		ElemType: StringType,
+		Elems:    []attr.Value{String{Value: "hello"}, String{Null: true}},
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	got, err = ListValue(ctx, StringType, []attr.Value{StringValue("hello"), BoolValue(true)})
+	if err == nil {
+		t.Fatalf("Expected error, got %+v", got)
+	}
+	if diff := cmp.Diff(`ElementKeyInt(1): tftypes.NewValue can't use bool as a tftypes.String; expected types are: string or *string`, err.Error()); diff != "" {
+		t.Errorf("Unexpected error (+wanted, -got): %s", diff)
+	}
+	if !got.Equal(ListUnknown(StringType)) {
+		t.Errorf("Expected an unknown list on error, got %+v", got)
+	}
+
+	if !ListNull(StringType).Equal(List{ElemType: StringType, Null: true}) {
+		t.Errorf("Expected a null list from ListNull")
+	}
+}
+
+func TestListValueMust(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got := ListValueMust(ctx, StringType, []attr.Value{StringValue("hello")})
+	expected := List{ElemType: StringType, Elems: []attr.Value{String{Value: "hello"}}}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	defer func() {
+		r := recover()
+		if diff := cmp.Diff(`ListValueMust: can't build a List of tftypes.String: ElementKeyInt(0): tftypes.NewValue can't use bool as a tftypes.String; expected types are: string or *string`, r); diff != "" {
+			t.Errorf("Unexpected panic (+wanted, -got): %s", diff)
+		}
+	}()
+	ListValueMust(ctx, StringType, []attr.Value{BoolValue(true)})
+}
+
+func TestListValueFrom(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val           interface{}
+		expected      List
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"slice": {
+			val: []string{"a", "b"},
+			expected: List{
+				ElemType: StringType,
+				Elems:    []attr.Value{String{Value: "a"}, String{Value: "b"}},
+			},
+		},
+		"array": {
+			val: [1]string{"a"},
+			expected: List{
+				ElemType: StringType,
+				Elems:    []attr.Value{String{Value: "a"}},
+			},
+		},
+		"nil": {
+			val:      []string(nil),
+			expected: ListNull(StringType),
+		},
+		"wrong-type": {
+			val:           []bool{true},
+			expected:      ListUnknown(StringType),
+			expectedError: "ElementKeyInt(0): can't unmarshal tftypes.Bool into *string, expected string",
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ListValueFrom(context.Background(), StringType, tc.val)
+			if err != nil {
+				if diff := cmp.Diff(tc.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (+wanted, -got): %s", diff)
+				}
+			} else if tc.expectedError != "" {
+				t.Errorf("Expected error %q, got none", tc.expectedError)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
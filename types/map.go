@@ -3,24 +3,43 @@ package types
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
-
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+var (
+	_ attr.TypeWithValidate             = MapType{}
+	_ attr.TypeWithPlaintextDescription = MapType{}
+)
+
 // MapType is an AttributeType representing a map of values. All values must
 // be of the same type, which the provider must specify as the ElemType
 // property. Keys will always be strings.
 type MapType struct {
 	ElemType attr.Type
+
+	// MinElems and MaxElems, if greater than zero, are the fewest and
+	// most elements maps of the type can have. Maps with a number of
+	// elements outside them are rejected when validating config. They
+	// don't affect the type's equality or how values are read, so state
+	// written before the limits were added can still be read. Null and
+	// unknown maps are always allowed.
+	MinElems int
+	MaxElems int
 }
 
 // WithElementType returns a new copy of the type with its element type set.
 func (m MapType) WithElementType(typ attr.Type) attr.TypeWithElementType {
 	return MapType{
 		ElemType: typ,
+		MinElems: m.MinElems,
+		MaxElems: m.MaxElems,
 	}
 }
 
@@ -66,7 +85,8 @@ func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr
 		return nil, err
 	}
 	elems := make(map[string]attr.Value, len(val))
-	for key, elem := range val {
+	for _, key := range order.Keys(val) {
+		elem := val[key]
 		av, err := m.ElemType.ValueFromTerraform(ctx, elem)
 		if err != nil {
 			return nil, err
@@ -77,7 +97,13 @@ func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr
 	return ma, nil
 }
 
+// ValueFromMap returns `in`, to implement MapTypable.
+func (m MapType) ValueFromMap(_ context.Context, in Map) (MapValuable, error) {
+	return in, nil
+}
+
 // Equal returns true if `o` is also a MapType and has the same ElemType.
+// MinElems and MaxElems are only used for validation, and are ignored.
 func (m MapType) Equal(o attr.Type) bool {
 	if m.ElemType == nil {
 		return false
@@ -89,6 +115,18 @@ func (m MapType) Equal(o attr.Type) bool {
 	return m.ElemType.Equal(other.ElemType)
 }
 
+// Validate returns an error diagnostic if `in` is a map with a number of
+// elements outside MinElems and MaxElems.
+func (m MapType) Validate(_ context.Context, in tftypes.Value) []*tfprotov6.Diagnostic {
+	return validateLength("map", in, m.MinElems, m.MaxElems)
+}
+
+// Description describes the number of elements maps of the type can have,
+// or returns an empty string if there's no limit.
+func (m MapType) Description(_ context.Context) string {
+	return lengthDescription("map", m.MinElems, m.MaxElems)
+}
+
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
 // map.
 func (m MapType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
@@ -123,28 +161,69 @@ type Map struct {
 	ElemType attr.Type
 }
 
+// MapValue returns a known Map of `elems`, which are values of `elemType`. It
+// returns an error if any of `elems` is nil or isn't a value of `elemType`.
+func MapValue(ctx context.Context, elemType attr.Type, elems map[string]attr.Value) (Map, error) {
+	vals := make(map[string]attr.Value, len(elems))
+	for _, key := range order.Keys(elems) {
+		elem := elems[key]
+		if err := validateBuilderElement(ctx, elemType, key, elem, nil); err != nil {
+			return MapUnknown(elemType), tftypes.NewAttributePath().WithElementKeyString(key).NewError(err)
+		}
+		vals[key] = elem
+	}
+	return Map{ElemType: elemType, Elems: vals}, nil
+}
+
+// MapValueMust is like MapValue, but panics instead of returning an error. It
+// is meant for maps built from values fixed in the provider's code, like
+// defaults and test fixtures, where an error is always a bug.
+func MapValueMust(ctx context.Context, elemType attr.Type, elems map[string]attr.Value) Map {
+	m, err := MapValue(ctx, elemType, elems)
+	if err != nil {
+		panic(fmt.Sprintf("MapValueMust: can't build a Map of %s: %s", elemType.TerraformType(ctx), err))
+	}
+	return m
+}
+
+// MapNull returns a null Map of values of `elemType`.
+func MapNull(elemType attr.Type) Map {
+	return Map{ElemType: elemType, Null: true}
+}
+
+// MapUnknown returns an unknown Map of values of `elemType`.
+func MapUnknown(elemType attr.Type) Map {
+	return Map{ElemType: elemType, Unknown: true}
+}
+
+// MapValueFrom returns a Map of values of `elemType`, converted from the
+// elements of `val`, a Go map with string keys, or a pointer to one. A nil
+// map or pointer returns a null Map. Elements are converted the same way
+// State.Set converts them.
+func MapValueFrom(ctx context.Context, elemType attr.Type, val interface{}) (Map, error) {
+	v, err := reflect.OutOf(ctx, MapType{ElemType: elemType}, val)
+	if err != nil {
+		return MapUnknown(elemType), err
+	}
+	m, ok := v.(Map)
+	if !ok {
+		return MapUnknown(elemType), fmt.Errorf("can't build a Map from %T", val)
+	}
+	return m, nil
+}
+
 // ElementsAs populates `target` with the elements of the Map, throwing an
 // error if the elements cannot be stored in `target`.
-func (m Map) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) error {
-	// we need a tftypes.Value for this Map to be able to use it with our
-	// reflection code
-	values := make(map[string]tftypes.Value, len(m.Elems))
-	for key, elem := range m.Elems {
-		val, err := elem.ToTerraformValue(ctx)
-		if err != nil {
-			return fmt.Errorf("error getting Terraform value for element %q: %w", key, err)
+func (m Map) ElementsAs(ctx context.Context, target interface{}, opts ElementsAsOptions) error {
+	typ := MapType{ElemType: m.ElemType}
+	if opts.UnknownAsError {
+		if err := findUnknown(ctx, typ, m); err != nil {
+			return err
 		}
-		err = tftypes.ValidateValue(m.ElemType.TerraformType(ctx), val)
-		if err != nil {
-			return fmt.Errorf("error using created Terraform value for element %q: %w", key, err)
-		}
-		values[key] = tftypes.NewValue(m.ElemType.TerraformType(ctx), val)
 	}
-	return reflect.Into(ctx, MapType{ElemType: m.ElemType}, tftypes.NewValue(tftypes.Map{
-		AttributeType: m.ElemType.TerraformType(ctx),
-	}, values), target, reflect.Options{
-		UnhandledNullAsEmpty:    allowUnhandled,
-		UnhandledUnknownAsEmpty: allowUnhandled,
+	return into(ctx, typ, m, target, reflect.Options{
+		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
 	})
 }
 
@@ -158,20 +237,25 @@ func (m Map) ToTerraformValue(ctx context.Context) (interface{}, error) {
 		return nil, nil
 	}
 	vals := make(map[string]tftypes.Value, len(m.Elems))
-	for key, elem := range m.Elems {
+	for _, key := range order.Keys(m.Elems) {
+		elem := m.Elems[key]
 		val, err := elem.ToTerraformValue(ctx)
 		if err != nil {
 			return nil, err
 		}
-		err = tftypes.ValidateValue(m.ElemType.TerraformType(ctx), val)
+		vals[key], err = reflect.NewTerraformValue(m.ElemType.TerraformType(ctx), val)
 		if err != nil {
 			return nil, err
 		}
-		vals[key] = tftypes.NewValue(m.ElemType.TerraformType(ctx), val)
 	}
 	return vals, nil
 }
 
+// ToMapValue returns `m`, to implement MapValuable.
+func (m Map) ToMapValue(_ context.Context) (Map, error) {
+	return m, nil
+}
+
 // Equal must return true if the AttributeValue is considered semantically
 // equal to the AttributeValue passed as an argument.
 func (m Map) Equal(o attr.Value) bool {
@@ -202,3 +286,62 @@ func (m Map) Equal(o attr.Value) bool {
 	}
 	return true
 }
+
+// IsNull returns true if the Map is null.
+func (m Map) IsNull() bool {
+	return m.Null
+}
+
+// IsUnknown returns true if the Map is unknown.
+func (m Map) IsUnknown() bool {
+	return m.Unknown
+}
+
+// String returns the elements of the Map in braces, sorted by key, like
+// {"a" = 1, "b" = 2}, or "<null>" or "<unknown>".
+func (m Map) String() string {
+	switch {
+	case m.Null:
+		return "<null>"
+	case m.Unknown:
+		return "<unknown>"
+	}
+	elems := make([]string, 0, len(m.Elems))
+	for _, key := range order.Keys(m.Elems) {
+		elems = append(elems, strconv.Quote(key)+" = "+valueString(m.Elems[key]))
+	}
+	return "{" + strings.Join(elems, ", ") + "}"
+}
+
+// With returns a copy of `m` with the element at `key` set to `val`, adding
+// it if it isn't already in the map. `m` is not modified. If `m` is null, the
+// result is a known map holding only `val`. If `m` is unknown, the result is
+// still unknown, as the other elements of the map aren't known.
+func (m Map) With(key string, val attr.Value) Map {
+	if m.Unknown {
+		return Map{Unknown: true, ElemType: m.ElemType}
+	}
+	elems := make(map[string]attr.Value, len(m.Elems)+1)
+	if !m.Null {
+		for k, v := range m.Elems {
+			elems[k] = v
+		}
+	}
+	elems[key] = val
+	return Map{Elems: elems, ElemType: m.ElemType}
+}
+
+// Without returns a copy of `m` without the element at `key`. `m` is not
+// modified. Null and unknown maps are returned unchanged.
+func (m Map) Without(key string) Map {
+	if m.Unknown || m.Null {
+		return Map{Unknown: m.Unknown, Null: m.Null, ElemType: m.ElemType}
+	}
+	elems := make(map[string]attr.Value, len(m.Elems))
+	for k, v := range m.Elems {
+		if k != key {
+			elems[k] = v
+		}
+	}
+	return Map{Elems: elems, ElemType: m.ElemType}
+}
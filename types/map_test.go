@@ -240,7 +240,7 @@ func TestMapElementsAs_mapStringString(t *testing.T) {
 		Elems: map[string]attr.Value{
 			"h": String{Value: "hello"},
 			"w": String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+		}}).ElementsAs(context.Background(), &stringSlice, ElementsAsOptions{})
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err.Error())
 	}
@@ -263,7 +263,7 @@ func TestMapElementsAs_mapStringAttributeValue(t *testing.T) {
 		Elems: map[string]attr.Value{
 			"h": String{Value: "hello"},
 			"w": String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+		}}).ElementsAs(context.Background(), &stringSlice, ElementsAsOptions{})
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err.Error())
 	}
@@ -657,3 +657,227 @@ func TestMapEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestMapWith(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver Map
+		key      string
+		expected Map
+	}
+	tests := map[string]testCase{
+		"add": {
+			receiver: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"h": String{Value: "hello"},
+				},
+			},
+			key: "n",
+			expected: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"h": String{Value: "hello"},
+					"n": String{Value: "new"},
+				},
+			},
+		},
+		"replace": {
+			receiver: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"h": String{Value: "hello"},
+				},
+			},
+			key: "h",
+			expected: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"h": String{Value: "new"},
+				},
+			},
+		},
+		"null": {
+			receiver: Map{ElemType: StringType, Null: true},
+			key:      "n",
+			expected: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"n": String{Value: "new"},
+				},
+			},
+		},
+		"unknown": {
+			receiver: Map{ElemType: StringType, Unknown: true},
+			key:      "n",
+			expected: Map{ElemType: StringType, Unknown: true},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			before := map[string]attr.Value{}
+			for k, v := range test.receiver.Elems {
+				before[k] = v
+			}
+			got := test.receiver.With(test.key, String{Value: "new"})
+			if !got.Equal(test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, got)
+			}
+			if len(before) != len(test.receiver.Elems) {
+				t.Errorf("Receiver was modified, expected %+v, got %+v", before, test.receiver.Elems)
+			}
+			for k, v := range before {
+				if !v.Equal(test.receiver.Elems[k]) {
+					t.Errorf("Receiver was modified, expected %+v, got %+v", before, test.receiver.Elems)
+				}
+			}
+		})
+	}
+}
+
+func TestMapWithout(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver Map
+		key      string
+		expected Map
+	}
+	tests := map[string]testCase{
+		"remove": {
+			receiver: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"h": String{Value: "hello"},
+					"w": String{Value: "world"},
+				},
+			},
+			key: "h",
+			expected: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"w": String{Value: "world"},
+				},
+			},
+		},
+		"missing": {
+			receiver: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"h": String{Value: "hello"},
+				},
+			},
+			key: "w",
+			expected: Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"h": String{Value: "hello"},
+				},
+			},
+		},
+		"null": {
+			receiver: Map{ElemType: StringType, Null: true},
+			key:      "h",
+			expected: Map{ElemType: StringType, Null: true},
+		},
+		"unknown": {
+			receiver: Map{ElemType: StringType, Unknown: true},
+			key:      "h",
+			expected: Map{ElemType: StringType, Unknown: true},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			length := len(test.receiver.Elems)
+			got := test.receiver.Without(test.key)
+			if !got.Equal(test.expected) {
+				t.Errorf("Expected %+v, got %+v", test.expected, got)
+			}
+			if len(test.receiver.Elems) != length {
+				t.Errorf("Receiver was modified, got %+v", test.receiver.Elems)
+			}
+		})
+	}
+}
+
+func TestMapValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got, err := MapValue(ctx, Int64Type, map[string]attr.Value{"a": Int64Value(1), "b": Int64Unknown()})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := Map{
+		ElemType: Int64Type,
+		Elems:    map[string]attr.Value{"a": Int64{Value: 1}, "b": Int64{Unknown: true}},
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	got, err = MapValue(ctx, Int64Type, map[string]attr.Value{"a": nil})
+	if err == nil {
+		t.Fatalf("Expected error, got %+v", got)
+	}
+	if diff := cmp.Diff(`ElementKeyString("a"): can't use nil as a value of tftypes.Number`, err.Error()); diff != "" {
+		t.Errorf("Unexpected error (+wanted, -got): %s", diff)
+	}
+	if !got.Equal(MapUnknown(Int64Type)) {
+		t.Errorf("Expected an unknown map on error, got %+v", got)
+	}
+
+	if !MapNull(Int64Type).Equal(Map{ElemType: Int64Type, Null: true}) {
+		t.Errorf("Expected a null map from MapNull")
+	}
+}
+
+func TestMapValueMust(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	got := MapValueMust(ctx, Int64Type, map[string]attr.Value{"a": Int64Value(1)})
+	expected := Map{ElemType: Int64Type, Elems: map[string]attr.Value{"a": Int64{Value: 1}}}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	defer func() {
+		r := recover()
+		if diff := cmp.Diff(`MapValueMust: can't build a Map of tftypes.Number: ElementKeyString("a"): can't use nil as a value of tftypes.Number`, r); diff != "" {
+			t.Errorf("Unexpected panic (+wanted, -got): %s", diff)
+		}
+	}()
+	MapValueMust(ctx, Int64Type, map[string]attr.Value{"a": nil})
+}
+
+func TestMapValueFrom(t *testing.T) {
+	t.Parallel()
+
+	got, err := MapValueFrom(context.Background(), Int64Type, map[string]int64{"a": 1, "b": 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := Map{
+		ElemType: Int64Type,
+		Elems:    map[string]attr.Value{"a": Int64{Value: 1}, "b": Int64{Value: 2}},
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	got, err = MapValueFrom(context.Background(), Int64Type, (*map[string]int64)(nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !got.Equal(MapNull(Int64Type)) {
+		t.Errorf("Expected a null map, got %+v", got)
+	}
+}
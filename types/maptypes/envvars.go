@@ -0,0 +1,265 @@
+// Package maptypes provides custom types for maps with rules about their
+// keys, like maps of environment variables.
+package maptypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.TypeWithValidate             = EnvVarsType{}
+	_ attr.TypeWithPlaintextDescription = EnvVarsType{}
+)
+
+// EnvVarsType is an attr.Type for environment variables, like the
+// environment of a container: maps of strings, keyed by variable names. Names
+// must follow POSIX, starting with a letter or underscore, followed by
+// letters, digits, and underscores. They're maps of strings in Terraform.
+type EnvVarsType struct {
+	// Uppercase requires names to have no lower case letters, like PATH
+	// and HOME, which is what POSIX recommends for portable names.
+	Uppercase bool
+
+	// SensitiveValues keeps the values of the variables out of the
+	// String method of values, for environments that hold secrets. Mark
+	// the attribute Sensitive too, to keep them out of Terraform's
+	// output.
+	SensitiveValues bool
+}
+
+// TerraformType returns a tftypes.Map of tftypes.Strings.
+func (t EnvVarsType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.Map{AttributeType: tftypes.String}
+}
+
+// ValueFromTerraform returns an EnvVars holding the variables in `in`. It
+// doesn't check their names; that is left to Validate, so values already in
+// state can always be read.
+func (t EnvVarsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.Type().Is(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+	result := EnvVars{SensitiveValues: t.SensitiveValues}
+	if !in.IsKnown() {
+		result.Unknown = true
+		return result, nil
+	}
+	if in.IsNull() {
+		result.Null = true
+		return result, nil
+	}
+	var vals map[string]tftypes.Value
+	if err := in.As(&vals); err != nil {
+		return nil, err
+	}
+	result.Elems = make(map[string]types.String, len(vals))
+	for _, name := range order.Keys(vals) {
+		elem, err := types.StringType.ValueFromTerraform(ctx, vals[name])
+		if err != nil {
+			return nil, tftypes.NewAttributePath().WithElementKeyString(name).NewError(err)
+		}
+		result.Elems[name] = elem.(types.String)
+	}
+	return result, nil
+}
+
+// Equal returns true if `o` is an EnvVarsType with the same rules.
+func (t EnvVarsType) Equal(o attr.Type) bool {
+	other, ok := o.(EnvVarsType)
+	return ok && other == t
+}
+
+// ApplyTerraform5AttributePathStep returns types.StringType for element
+// keys, the type of the variables' values.
+func (t EnvVarsType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	if _, ok := step.(tftypes.ElementKeyString); !ok {
+		return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t)
+	}
+	return types.StringType, nil
+}
+
+// String returns a human-readable representation of the type.
+func (t EnvVarsType) String() string {
+	return fmt.Sprintf("maptypes.EnvVarsType{Uppercase: %t, SensitiveValues: %t}", t.Uppercase, t.SensitiveValues)
+}
+
+// Description describes the names the type accepts.
+func (t EnvVarsType) Description(_ context.Context) string {
+	if t.Uppercase {
+		return "A map of environment variables. Names must start with an upper case letter or underscore, followed by upper case letters, digits, and underscores."
+	}
+	return "A map of environment variables. Names must start with a letter or underscore, followed by letters, digits, and underscores."
+}
+
+// Validate returns an error for each variable in `in` whose name doesn't
+// follow the type's rules.
+func (t EnvVarsType) Validate(ctx context.Context, in tftypes.Value) []*tfprotov6.Diagnostic {
+	if !in.Type().Is(t.TerraformType(ctx)) || !in.IsKnown() || in.IsNull() {
+		return nil
+	}
+	var vals map[string]tftypes.Value
+	if err := in.As(&vals); err != nil {
+		return nil
+	}
+	var diags []*tfprotov6.Diagnostic
+	for _, name := range order.Keys(vals) {
+		if problem := t.checkName(name); problem != "" {
+			diags = append(diags, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Invalid environment variable name",
+				Detail:   fmt.Sprintf("The environment variable name %q %s.", name, problem),
+			})
+		}
+	}
+	return diags
+}
+
+// checkName returns what is wrong with the variable name `name`, or an empty
+// string if it follows the type's rules.
+func (t EnvVarsType) checkName(name string) string {
+	if name == "" {
+		return "is empty"
+	}
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z':
+		case r >= 'a' && r <= 'z':
+			if t.Uppercase {
+				return "must not have lower case letters"
+			}
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				return "must not start with a digit"
+			}
+		default:
+			return fmt.Sprintf("must only have letters, digits, and underscores, not %q", r)
+		}
+	}
+	return ""
+}
+
+// EnvVars is a map of environment variables, keyed by name.
+type EnvVars struct {
+	// Unknown will be true if the value is not yet known.
+	Unknown bool
+
+	// Null will be true if the value was not set, or was explicitly set to
+	// null.
+	Null bool
+
+	// Elems are the variables' values, keyed by name. Values may be null
+	// or unknown.
+	Elems map[string]types.String
+
+	// SensitiveValues hides the values of the variables in String. It is
+	// set from the EnvVarsType the value was read with.
+	SensitiveValues bool
+}
+
+// ToTerraformValue returns the variables as a map[string]tftypes.Value. If
+// Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
+// returns nil.
+func (v EnvVars) ToTerraformValue(ctx context.Context) (interface{}, error) {
+	if v.Null {
+		return nil, nil
+	}
+	if v.Unknown {
+		return tftypes.UnknownValue, nil
+	}
+	vals := make(map[string]tftypes.Value, len(v.Elems))
+	for _, name := range order.Keys(v.Elems) {
+		raw, err := v.Elems[name].ToTerraformValue(ctx)
+		if err != nil {
+			return nil, tftypes.NewAttributePath().WithElementKeyString(name).NewError(err)
+		}
+		vals[name] = tftypes.NewValue(tftypes.String, raw)
+	}
+	return vals, nil
+}
+
+// Equal returns true if `other` is an EnvVars with the same variables, set
+// to equal values. SensitiveValues isn't compared.
+func (v EnvVars) Equal(other attr.Value) bool {
+	o, ok := other.(EnvVars)
+	if !ok {
+		return false
+	}
+	if v.Unknown != o.Unknown || v.Null != o.Null {
+		return false
+	}
+	if len(v.Elems) != len(o.Elems) {
+		return false
+	}
+	for name, elem := range v.Elems {
+		otherElem, ok := o.Elems[name]
+		if !ok || !elem.Equal(otherElem) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNull returns true if the EnvVars is null.
+func (v EnvVars) IsNull() bool {
+	return v.Null
+}
+
+// IsUnknown returns true if the EnvVars is unknown.
+func (v EnvVars) IsUnknown() bool {
+	return v.Unknown
+}
+
+// Environ returns the known, non-null variables in the form "NAME=value",
+// sorted by name, like os.Environ, for passing to exec.Cmd.Env. It returns
+// nil if the value is null or unknown.
+func (v EnvVars) Environ() []string {
+	if v.Null || v.Unknown {
+		return nil
+	}
+	var env []string
+	for _, name := range order.Keys(v.Elems) {
+		elem := v.Elems[name]
+		if elem.Null || elem.Unknown {
+			continue
+		}
+		env = append(env, name+"="+elem.Value)
+	}
+	return env
+}
+
+// String returns the variables in the form {NAME="value", ...}, sorted by
+// name, with their values replaced by <sensitive> if SensitiveValues is set,
+// or "<null>" or "<unknown>".
+func (v EnvVars) String() string {
+	if v.Null {
+		return "<null>"
+	}
+	if v.Unknown {
+		return "<unknown>"
+	}
+	parts := make([]string, 0, len(v.Elems))
+	for _, name := range order.Keys(v.Elems) {
+		elem := v.Elems[name]
+		var s string
+		switch {
+		case elem.Unknown:
+			s = "<unknown>"
+		case elem.Null:
+			s = "<null>"
+		case v.SensitiveValues:
+			s = "<sensitive>"
+		default:
+			s = fmt.Sprintf("%q", elem.Value)
+		}
+		parts = append(parts, name+"="+s)
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
@@ -0,0 +1,167 @@
+package maptypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func envVarsValue(vals interface{}) tftypes.Value {
+	return tftypes.NewValue(tftypes.Map{AttributeType: tftypes.String}, vals)
+}
+
+func TestEnvVarsTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		typ      EnvVarsType
+		input    tftypes.Value
+		expected EnvVars
+	}
+	tests := map[string]testCase{
+		"known": {
+			input: envVarsValue(map[string]tftypes.Value{
+				"PATH":  tftypes.NewValue(tftypes.String, "/bin"),
+				"TOKEN": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: EnvVars{
+				Elems: map[string]types.String{
+					"PATH":  {Value: "/bin"},
+					"TOKEN": {Unknown: true},
+				},
+			},
+		},
+		"null": {
+			input:    envVarsValue(nil),
+			expected: EnvVars{Null: true},
+		},
+		"unknown": {
+			input:    envVarsValue(tftypes.UnknownValue),
+			expected: EnvVars{Unknown: true},
+		},
+		"sensitive": {
+			typ:      EnvVarsType{SensitiveValues: true},
+			input:    envVarsValue(map[string]tftypes.Value{}),
+			expected: EnvVars{Elems: map[string]types.String{}, SensitiveValues: true},
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.typ.ValueFromTerraform(context.Background(), tc.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			// the value must convert back to the same Terraform value
+			raw, err := got.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if roundTrip := tftypes.NewValue(tc.input.Type(), raw); !roundTrip.Equal(tc.input) {
+				t.Errorf("Expected %s after a round trip, got %s", tc.input, roundTrip)
+			}
+		})
+	}
+}
+
+func TestEnvVarsTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	invalid := func(detail string) *tfprotov6.Diagnostic {
+		return &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Invalid environment variable name",
+			Detail:   detail,
+		}
+	}
+
+	type testCase struct {
+		typ           EnvVarsType
+		names         []string
+		expectedDiags []*tfprotov6.Diagnostic
+	}
+	tests := map[string]testCase{
+		"valid": {
+			names: []string{"PATH", "_private", "http_proxy", "GO111MODULE"},
+		},
+		"invalid": {
+			names: []string{"", "1ST", "MY-VAR", "ok"},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				invalid(`The environment variable name "" is empty.`),
+				invalid(`The environment variable name "1ST" must not start with a digit.`),
+				invalid(`The environment variable name "MY-VAR" must only have letters, digits, and underscores, not '-'.`),
+			},
+		},
+		"uppercase": {
+			typ:   EnvVarsType{Uppercase: true},
+			names: []string{"PATH", "http_proxy"},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				invalid(`The environment variable name "http_proxy" must not have lower case letters.`),
+			},
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			vals := make(map[string]tftypes.Value, len(tc.names))
+			for _, name := range tc.names {
+				vals[name] = tftypes.NewValue(tftypes.String, "value")
+			}
+			diags := tc.typ.Validate(context.Background(), envVarsValue(vals))
+			if diff := cmp.Diff(tc.expectedDiags, diags); diff != "" {
+				t.Errorf("Unexpected diff in diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEnvVarsEqual(t *testing.T) {
+	t.Parallel()
+
+	a := EnvVars{Elems: map[string]types.String{"A": {Value: "1"}, "B": {Value: "2"}}}
+	b := EnvVars{Elems: map[string]types.String{"B": {Value: "2"}, "A": {Value: "1"}}, SensitiveValues: true}
+	if !a.Equal(b) {
+		t.Errorf("Expected %s to equal %s", a, b)
+	}
+	c := EnvVars{Elems: map[string]types.String{"A": {Value: "1"}, "C": {Value: "2"}}}
+	if a.Equal(c) {
+		t.Errorf("Expected %s not to equal %s", a, c)
+	}
+	if a.Equal(EnvVars{Null: true}) {
+		t.Errorf("Expected %s not to equal a null value", a)
+	}
+}
+
+func TestEnvVarsStringAndEnviron(t *testing.T) {
+	t.Parallel()
+
+	v := EnvVars{
+		Elems: map[string]types.String{
+			"TOKEN": {Value: "secret"},
+			"HOME":  {Value: "/root"},
+			"NEXT":  {Unknown: true},
+		},
+	}
+	if diff := cmp.Diff(`{HOME="/root", NEXT=<unknown>, TOKEN="secret"}`, v.String()); diff != "" {
+		t.Errorf("Unexpected diff in String (+wanted, -got): %s", diff)
+	}
+	v.SensitiveValues = true
+	if diff := cmp.Diff(`{HOME=<sensitive>, NEXT=<unknown>, TOKEN=<sensitive>}`, v.String()); diff != "" {
+		t.Errorf("Unexpected diff in sensitive String (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff([]string{"HOME=/root", "TOKEN=secret"}, v.Environ()); diff != "" {
+		t.Errorf("Unexpected diff in Environ (+wanted, -got): %s", diff)
+	}
+}
@@ -2,9 +2,12 @@ package types
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -15,7 +18,9 @@ func numberValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value
 	if in.IsNull() {
 		return Number{Null: true}, nil
 	}
-	n := big.NewFloat(0)
+	// use a zero-precision big.Float, so it takes the precision of the
+	// value Terraform sent instead of rounding it to a float64's
+	n := new(big.Float)
 	err := in.As(&n)
 	if err != nil {
 		return nil, err
@@ -40,6 +45,25 @@ type Number struct {
 	Value *big.Float
 }
 
+// NumberValue returns a known Number holding `value`, or a null Number if
+// `value` is nil.
+func NumberValue(value *big.Float) Number {
+	if value == nil {
+		return NumberNull()
+	}
+	return Number{Value: value}
+}
+
+// NumberNull returns a null Number.
+func NumberNull() Number {
+	return Number{Null: true}
+}
+
+// NumberUnknown returns an unknown Number.
+func NumberUnknown() Number {
+	return Number{Unknown: true}
+}
+
 // ToTerraformValue returns the data contained in the *Number as a *big.Float.
 // If Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
 // returns nil.
@@ -53,6 +77,11 @@ func (n Number) ToTerraformValue(_ context.Context) (interface{}, error) {
 	return n.Value, nil
 }
 
+// ToNumberValue returns `n`, to implement NumberValuable.
+func (n Number) ToNumberValue(_ context.Context) (Number, error) {
+	return n, nil
+}
+
 // Equal returns true if `other` is a *Number and has the same value as `n`.
 func (n Number) Equal(other attr.Value) bool {
 	o, ok := other.(Number)
@@ -73,3 +102,133 @@ func (n Number) Equal(other attr.Value) bool {
 	}
 	return n.Value.Cmp(o.Value) == 0
 }
+
+// IsNull returns true if the Number is null.
+func (n Number) IsNull() bool {
+	return n.Null
+}
+
+// IsUnknown returns true if the Number is unknown.
+func (n Number) IsUnknown() bool {
+	return n.Unknown
+}
+
+// String returns the number in its shortest decimal form, like "1.5", or
+// "<null>" or "<unknown>".
+func (n Number) String() string {
+	switch {
+	case n.Null || n.Value == nil:
+		return "<null>"
+	case n.Unknown:
+		return "<unknown>"
+	}
+	return n.Value.Text('g', -1)
+}
+
+// numberPrecision is the precision, in bits, Terraform uses for the numbers
+// it sends to providers.
+const numberPrecision = 512
+
+// ParseNumberExact returns a Number holding the decimal number in `s`, like
+// "0.1" or "12345678901234567890", parsed with the same precision Terraform
+// uses. Numbers created this way round-trip through StringExact unchanged.
+func ParseNumberExact(s string) (Number, error) {
+	f, _, err := big.ParseFloat(s, 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		return Number{}, fmt.Errorf("can't parse %q as a number: %w", s, err)
+	}
+	return Number{Value: f}, nil
+}
+
+// StringExact returns the decimal representation of the number, without an
+// exponent, using the fewest digits that uniquely identify the value at its
+// precision. Unlike the output of (*big.Float).String, large integers keep all
+// their digits and decimals like 0.1 are not shown with float artifacts. It
+// returns an empty string if the number is null, unknown, or has no value.
+func (n Number) StringExact() string {
+	if n.Null || n.Unknown || n.Value == nil {
+		return ""
+	}
+	return n.Value.Text('f', -1)
+}
+
+// ValueInt64 returns the number as an int64. If it's null, unknown, not an
+// integer, or doesn't fit in an int64, it returns 0 and an error diagnostic.
+func (n Number) ValueInt64() (int64, []*tfprotov6.Diagnostic) {
+	if diags := n.checkKnown("an int64"); diags != nil {
+		return 0, diags
+	}
+	i, err := bigFloatToInt64(n.Value)
+	if err != nil {
+		return 0, numberConversionDiags("an int64", err)
+	}
+	return i, nil
+}
+
+// ValueUint64 returns the number as a uint64. If it's null, unknown, not an
+// integer, or doesn't fit in a uint64, it returns 0 and an error diagnostic.
+func (n Number) ValueUint64() (uint64, []*tfprotov6.Diagnostic) {
+	if diags := n.checkKnown("a uint64"); diags != nil {
+		return 0, diags
+	}
+	if !n.Value.IsInt() {
+		return 0, numberConversionDiags("a uint64", fmt.Errorf("value %s is not an integer", n.Value.Text('f', -1)))
+	}
+	u, acc := n.Value.Uint64()
+	if acc != big.Exact {
+		return 0, numberConversionDiags("a uint64", fmt.Errorf("value %s doesn't fit in a 64-bit unsigned integer", n.Value.Text('f', -1)))
+	}
+	return u, nil
+}
+
+// ValueFloat64 returns the number as a float64. If it's null, unknown, or a
+// float64 can't hold it without losing precision, it returns 0 and an error
+// diagnostic. Decimals like 0.1 are returned as the closest float64, as long
+// as it's the one they were parsed from.
+func (n Number) ValueFloat64() (float64, []*tfprotov6.Diagnostic) {
+	if diags := n.checkKnown("a float64"); diags != nil {
+		return 0, diags
+	}
+	f, err := bigFloatToFloat64(n.Value)
+	if err != nil {
+		return 0, numberConversionDiags("a float64", err)
+	}
+	return f, nil
+}
+
+// ValueBigInt returns the number as a *big.Int. If it's null, unknown, or not
+// an integer, it returns nil and an error diagnostic.
+func (n Number) ValueBigInt() (*big.Int, []*tfprotov6.Diagnostic) {
+	if diags := n.checkKnown("a *big.Int"); diags != nil {
+		return nil, diags
+	}
+	if !n.Value.IsInt() {
+		return nil, numberConversionDiags("a *big.Int", fmt.Errorf("value %s is not an integer", n.Value.Text('f', -1)))
+	}
+	i, _ := n.Value.Int(nil)
+	return i, nil
+}
+
+// checkKnown returns an error diagnostic if the number is null, unknown, or
+// has no value, so it can't be converted to `goType`.
+func (n Number) checkKnown(goType string) []*tfprotov6.Diagnostic {
+	switch {
+	case n.Unknown:
+		return numberConversionDiags(goType, errors.New("value is unknown"))
+	case n.Null || n.Value == nil:
+		return numberConversionDiags(goType, errors.New("value is null"))
+	}
+	return nil
+}
+
+// numberConversionDiags returns an error diagnostic saying a Number couldn't
+// be converted to `goType` because of `err`.
+func numberConversionDiags(goType string, err error) []*tfprotov6.Diagnostic {
+	return []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Error converting number",
+			Detail:   fmt.Sprintf("The number can't be converted to %s: %s.", goType, err),
+		},
+	}
+}
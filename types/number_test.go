@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -236,3 +237,235 @@ func TestNumberEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberStringExact(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Number
+		expectation string
+	}
+	tests := map[string]testCase{
+		"decimal": {
+			input:       Number{Value: big.NewFloat(0.5)},
+			expectation: "0.5",
+		},
+		"large-integer": {
+			input:       Number{Value: new(big.Float).SetInt64(9007199254740993)},
+			expectation: "9007199254740993",
+		},
+		"large-float": {
+			input:       Number{Value: big.NewFloat(1e21)},
+			expectation: "1000000000000000000000",
+		},
+		"negative": {
+			input:       Number{Value: big.NewFloat(-12.25)},
+			expectation: "-12.25",
+		},
+		"unknown": {
+			input:       Number{Unknown: true},
+			expectation: "",
+		},
+		"null": {
+			input:       Number{Null: true},
+			expectation: "",
+		},
+		"nilValue": {
+			input:       Number{},
+			expectation: "",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.StringExact()
+			if got != test.expectation {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestParseNumberExact(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input         string
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"decimal": {
+			input: "0.1",
+		},
+		"money": {
+			input: "19.99",
+		},
+		"large-integer": {
+			input: "123456789012345678901234567890",
+		},
+		"small-decimal": {
+			input: "0.000000000000000000001",
+		},
+		"negative": {
+			input: "-3.3",
+		},
+		"invalid": {
+			input:         "one",
+			expectedError: `can't parse "one" as a number: number has no digits`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseNumberExact(test.input)
+			if err != nil {
+				if test.expectedError == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if err.Error() != test.expectedError {
+					t.Fatalf("Expected error %q, got %q", test.expectedError, err.Error())
+				}
+				return
+			}
+			if test.expectedError != "" {
+				t.Fatalf("Expected error %q, got none", test.expectedError)
+			}
+			if got.StringExact() != test.input {
+				t.Errorf("Expected %q to round-trip, got %q", test.input, got.StringExact())
+			}
+
+			// the value should survive being sent to and from Terraform
+			tfVal, err := got.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			roundTripped, err := NumberType.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.Number, tfVal))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if roundTripped.(Number).StringExact() != test.input {
+				t.Errorf("Expected %q to round-trip through Terraform, got %q", test.input, roundTripped.(Number).StringExact())
+			}
+		})
+	}
+}
+
+func TestNumberValueAccessors(t *testing.T) {
+	t.Parallel()
+
+	valueInt64 := func(n Number) (interface{}, []*tfprotov6.Diagnostic) { return n.ValueInt64() }
+	valueUint64 := func(n Number) (interface{}, []*tfprotov6.Diagnostic) { return n.ValueUint64() }
+	valueFloat64 := func(n Number) (interface{}, []*tfprotov6.Diagnostic) { return n.ValueFloat64() }
+	valueBigInt := func(n Number) (interface{}, []*tfprotov6.Diagnostic) { return n.ValueBigInt() }
+	mustParse := func(s string) Number {
+		n, err := ParseNumberExact(s)
+		if err != nil {
+			panic(err)
+		}
+		return n
+	}
+
+	type testCase struct {
+		number         Number
+		get            func(Number) (interface{}, []*tfprotov6.Diagnostic)
+		expected       interface{}
+		expectedDetail string
+	}
+	tests := map[string]testCase{
+		"int64": {
+			number:   NumberValue(big.NewFloat(-123)),
+			get:      valueInt64,
+			expected: int64(-123),
+		},
+		"int64-not-integer": {
+			number:         NumberValue(big.NewFloat(1.5)),
+			get:            valueInt64,
+			expected:       int64(0),
+			expectedDetail: "The number can't be converted to an int64: value 1.5 is not an integer.",
+		},
+		"int64-overflow": {
+			number:         mustParse("9223372036854775808"),
+			get:            valueInt64,
+			expected:       int64(0),
+			expectedDetail: "The number can't be converted to an int64: value 9223372036854775808 doesn't fit in a 64-bit integer.",
+		},
+		"int64-null": {
+			number:         NumberNull(),
+			get:            valueInt64,
+			expected:       int64(0),
+			expectedDetail: "The number can't be converted to an int64: value is null.",
+		},
+		"int64-unknown": {
+			number:         NumberUnknown(),
+			get:            valueInt64,
+			expected:       int64(0),
+			expectedDetail: "The number can't be converted to an int64: value is unknown.",
+		},
+		"uint64": {
+			number:   mustParse("18446744073709551615"),
+			get:      valueUint64,
+			expected: uint64(18446744073709551615),
+		},
+		"uint64-negative": {
+			number:         NumberValue(big.NewFloat(-1)),
+			get:            valueUint64,
+			expected:       uint64(0),
+			expectedDetail: "The number can't be converted to a uint64: value -1 doesn't fit in a 64-bit unsigned integer.",
+		},
+		"float64": {
+			number:   mustParse("0.1"),
+			get:      valueFloat64,
+			expected: 0.1,
+		},
+		"float64-precision": {
+			number:         mustParse("0.10000000000000000000001"),
+			get:            valueFloat64,
+			expected:       float64(0),
+			expectedDetail: "The number can't be converted to a float64: value 0.10000000000000000000001 can't be held by a 64-bit floating point number without losing precision.",
+		},
+		"big-int": {
+			number:   mustParse("123456789012345678901234567890"),
+			get:      valueBigInt,
+			expected: "123456789012345678901234567890",
+		},
+		"big-int-not-integer": {
+			number:         NumberValue(big.NewFloat(0.5)),
+			get:            valueBigInt,
+			expected:       (*big.Int)(nil),
+			expectedDetail: "The number can't be converted to a *big.Int: value 0.5 is not an integer.",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.get(test.number)
+			if i, ok := got.(*big.Int); ok && i != nil {
+				got = i.String()
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+			if test.expectedDetail == "" {
+				if len(diags) > 0 {
+					t.Errorf("Unexpected diagnostics: %+v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("Expected one diagnostic, got %+v", diags)
+			}
+			if diags[0].Severity != tfprotov6.DiagnosticSeverityError || diags[0].Summary != "Error converting number" {
+				t.Errorf("Unexpected diagnostic: %+v", diags[0])
+			}
+			if diags[0].Detail != test.expectedDetail {
+				t.Errorf("Expected detail %q, got %q", test.expectedDetail, diags[0].Detail)
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package types
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -68,7 +70,8 @@ func (o ObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 		return nil, err
 	}
 
-	for k, v := range val {
+	for _, k := range order.Keys(val) {
+		v := val[k]
 		a, err := object.AttrTypes[k].ValueFromTerraform(ctx, v)
 		if err != nil {
 			return nil, err
@@ -79,6 +82,11 @@ func (o ObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	return object, nil
 }
 
+// ValueFromObject returns `in`, to implement ObjectTypable.
+func (o ObjectType) ValueFromObject(_ context.Context, in Object) (ObjectValuable, error) {
+	return in, nil
+}
+
 // Equal returns true if `candidate` is also an ObjectType and has the same
 // AttributeTypes.
 func (o ObjectType) Equal(candidate attr.Type) bool {
@@ -131,6 +139,64 @@ type Object struct {
 	AttrTypes map[string]attr.Type
 }
 
+// ObjectValue returns a known Object with the attributes described by
+// `attrTypes`, set to `attrs`. It returns an error if `attrs` doesn't have
+// exactly the attributes in `attrTypes`, or any of them is nil or isn't a
+// value of its attribute's type.
+func ObjectValue(ctx context.Context, attrTypes map[string]attr.Type, attrs map[string]attr.Value) (Object, error) {
+	b := NewObjectBuilder(attrTypes)
+	for _, name := range order.Keys(attrs) {
+		if err := b.Set(ctx, name, attrs[name]); err != nil {
+			return ObjectUnknown(attrTypes), err
+		}
+	}
+	obj, err := b.Build()
+	if err != nil {
+		return ObjectUnknown(attrTypes), err
+	}
+	return obj, nil
+}
+
+// ObjectValueMust is like ObjectValue, but panics instead of returning an
+// error. It is meant for objects built from values fixed in the provider's
+// code, like defaults and test fixtures, where an error is always a bug.
+func ObjectValueMust(ctx context.Context, attrTypes map[string]attr.Type, attrs map[string]attr.Value) Object {
+	obj, err := ObjectValue(ctx, attrTypes, attrs)
+	if err != nil {
+		panic(fmt.Sprintf("ObjectValueMust: can't build an Object of %s: %s", ObjectType{AttrTypes: attrTypes}.TerraformType(ctx), err))
+	}
+	return obj
+}
+
+// ObjectNull returns a null Object with the attributes described by
+// `attrTypes`.
+func ObjectNull(attrTypes map[string]attr.Type) Object {
+	return Object{AttrTypes: attrTypes, Null: true}
+}
+
+// ObjectUnknown returns an unknown Object with the attributes described by
+// `attrTypes`.
+func ObjectUnknown(attrTypes map[string]attr.Type) Object {
+	return Object{AttrTypes: attrTypes, Unknown: true}
+}
+
+// ObjectValueFrom returns an Object with the attributes described by
+// `attrTypes`, set from the fields of `val`, a struct with tfsdk tags, like
+// an API response, or a pointer to one. A nil pointer returns a null Object.
+// Fields are converted the same way State.Set converts them, and `val` must
+// have a field for exactly the attributes in `attrTypes`.
+func ObjectValueFrom(ctx context.Context, attrTypes map[string]attr.Type, val interface{}) (Object, error) {
+	v, err := reflect.OutOf(ctx, ObjectType{AttrTypes: attrTypes}, val)
+	if err != nil {
+		return ObjectUnknown(attrTypes), err
+	}
+	obj, ok := v.(Object)
+	if !ok {
+		return ObjectUnknown(attrTypes), fmt.Errorf("can't build an Object from %T", val)
+	}
+	return obj, nil
+}
+
 // ObjectAsOptions is a collection of toggles to control the behavior of
 // Object.As.
 type ObjectAsOptions struct {
@@ -145,24 +211,23 @@ type ObjectAsOptions struct {
 	// distinction. When set to true, the type's empty value will be used.
 	// When set to false, an error will be returned.
 	UnhandledUnknownAsEmpty bool
+
+	// UnknownAsError makes As return an error if the Object, or any value
+	// nested inside it, is unknown, even if `target` could hold unknown
+	// values. The error wraps ErrUnknownValue and is a
+	// tftypes.AttributePathError holding the path of the unknown value.
+	UnknownAsError bool
 }
 
 // As populates `target` with the data in the Object, throwing an error if the
 // data cannot be stored in `target`.
 func (o Object) As(ctx context.Context, target interface{}, opts ObjectAsOptions) error {
-	// we need a tftypes.Value for this Object to be able to use it with
-	// our reflection code
-	obj := ObjectType{AttrTypes: o.AttrTypes}
-	typ := obj.TerraformType(ctx)
-	val, err := o.ToTerraformValue(ctx)
-	if err != nil {
-		return err
-	}
-	err = tftypes.ValidateValue(typ, val)
-	if err != nil {
-		return err
+	if opts.UnknownAsError {
+		if err := findUnknown(ctx, ObjectType{AttrTypes: o.AttrTypes}, o); err != nil {
+			return err
+		}
 	}
-	return reflect.Into(ctx, obj, tftypes.NewValue(typ, val), target, reflect.Options{
+	return into(ctx, ObjectType{AttrTypes: o.AttrTypes}, o, target, reflect.Options{
 		UnhandledNullAsEmpty:    opts.UnhandledNullAsEmpty,
 		UnhandledUnknownAsEmpty: opts.UnhandledUnknownAsEmpty,
 	})
@@ -179,20 +244,25 @@ func (o Object) ToTerraformValue(ctx context.Context) (interface{}, error) {
 	}
 	vals := map[string]tftypes.Value{}
 
-	for k, v := range o.Attrs {
+	for _, k := range order.Keys(o.Attrs) {
+		v := o.Attrs[k]
 		val, err := v.ToTerraformValue(ctx)
 		if err != nil {
 			return nil, err
 		}
-		err = tftypes.ValidateValue(o.AttrTypes[k].TerraformType(ctx), val)
+		vals[k], err = reflect.NewTerraformValue(o.AttrTypes[k].TerraformType(ctx), val)
 		if err != nil {
 			return nil, err
 		}
-		vals[k] = tftypes.NewValue(o.AttrTypes[k].TerraformType(ctx), val)
 	}
 	return vals, nil
 }
 
+// ToObjectValue returns `o`, to implement ObjectValuable.
+func (o Object) ToObjectValue(_ context.Context) (Object, error) {
+	return o, nil
+}
+
 // Equal must return true if the AttributeValue is considered
 // semantically equal to the AttributeValue passed as an argument.
 func (o Object) Equal(c attr.Value) bool {
@@ -233,3 +303,254 @@ func (o Object) Equal(c attr.Value) bool {
 
 	return true
 }
+
+// IsNull returns true if the Object is null.
+func (o Object) IsNull() bool {
+	return o.Null
+}
+
+// IsUnknown returns true if the Object is unknown.
+func (o Object) IsUnknown() bool {
+	return o.Unknown
+}
+
+// String returns the attributes of the Object in braces, sorted by name,
+// like {id = "abc", size = 2}, or "<null>" or "<unknown>".
+func (o Object) String() string {
+	switch {
+	case o.Null:
+		return "<null>"
+	case o.Unknown:
+		return "<unknown>"
+	}
+	attrs := make([]string, 0, len(o.Attrs))
+	for _, name := range order.Keys(o.Attrs) {
+		attrs = append(attrs, name+" = "+valueString(o.Attrs[name]))
+	}
+	return "{" + strings.Join(attrs, ", ") + "}"
+}
+
+// ObjectMerge returns a new Object with the attributes of `base`, replacing
+// any attribute that is set to a non-null value in `overlay` with the value
+// from `overlay`. Attributes that are non-null, known Objects in both `base`
+// and `overlay` are merged recursively instead of being replaced. This is
+// useful for applying partial data, like an API response that only includes
+// some fields, on top of a value from the prior state.
+//
+// If `base` is null or unknown, `overlay` is returned. If `overlay` is null,
+// `base` is returned. `overlay` may only set attributes that exist in `base`,
+// and they must be of the same type.
+func ObjectMerge(ctx context.Context, base, overlay Object) (Object, error) {
+	return objectMerge(ctx, base, overlay, tftypes.NewAttributePath())
+}
+
+func objectMerge(ctx context.Context, base, overlay Object, path *tftypes.AttributePath) (Object, error) {
+	// check the types first, so they're checked even when the overlay is
+	// returned as is
+	if err := checkObjectMergeTypes(ctx, base, overlay, path); err != nil {
+		return Object{}, err
+	}
+	if base.Null || base.Unknown {
+		return overlay, nil
+	}
+	if overlay.Null {
+		return base, nil
+	}
+	if overlay.Unknown {
+		return overlay, nil
+	}
+
+	result := Object{
+		Attrs:     make(map[string]attr.Value, len(base.Attrs)),
+		AttrTypes: make(map[string]attr.Type, len(base.AttrTypes)),
+	}
+	for name, typ := range base.AttrTypes {
+		result.AttrTypes[name] = typ
+	}
+	for name, val := range base.Attrs {
+		result.Attrs[name] = val
+	}
+
+	for _, name := range order.Keys(overlay.Attrs) {
+		val := overlay.Attrs[name]
+		path := path.WithAttributeName(name)
+		// the types of attributes with type information were
+		// checked by checkObjectMergeTypes
+		if _, ok := base.AttrTypes[name]; !ok {
+			return Object{}, path.NewErrorf("can't merge attribute, it isn't an attribute of the base object")
+		}
+		if overlay.AttrTypes[name] == nil {
+			return Object{}, path.NewErrorf("can't merge attribute, no type information in the overlay object")
+		}
+		if val == nil {
+			continue
+		}
+		raw, err := val.ToTerraformValue(ctx)
+		if err != nil {
+			return Object{}, path.NewError(err)
+		}
+		if raw == nil {
+			// null overlay attributes leave the base attribute
+			// unchanged
+			continue
+		}
+		baseObj, baseIsObj := result.Attrs[name].(Object)
+		overlayObj, overlayIsObj := val.(Object)
+		if baseIsObj && overlayIsObj {
+			merged, err := objectMerge(ctx, baseObj, overlayObj, path)
+			if err != nil {
+				return Object{}, err
+			}
+			result.Attrs[name] = merged
+			continue
+		}
+		result.Attrs[name] = val
+	}
+	return result, nil
+}
+
+// checkObjectMergeTypes returns an error if `overlay` has an attribute type
+// that `base` doesn't have, or that isn't the same as in `base`.
+func checkObjectMergeTypes(ctx context.Context, base, overlay Object, path *tftypes.AttributePath) error {
+	for _, name := range order.Keys(overlay.AttrTypes) {
+		path := path.WithAttributeName(name)
+		typ, ok := base.AttrTypes[name]
+		if !ok {
+			return path.NewErrorf("can't merge attribute, it isn't an attribute of the base object")
+		}
+		overlayType := overlay.AttrTypes[name]
+		if overlayType != nil && !typ.Equal(overlayType) {
+			return path.NewErrorf("can't merge attribute of type %s into attribute of type %s", overlayType.TerraformType(ctx), typ.TerraformType(ctx))
+		}
+	}
+	return nil
+}
+
+// ObjectResolve returns the value to store in state for a computed Object
+// after apply, given its `planned` value, which may be unknown or have
+// unknown attributes, and the `applied` value, which may only set some
+// attributes, like an API response that only includes some fields.
+//
+// Attributes set to a non-null value in `applied` take that value, and
+// Objects known in both `planned` and `applied` are resolved recursively.
+// Every other attribute keeps its planned value, with any unknown values in
+// it replaced by nulls of the right type. The result always has every
+// attribute of `planned`'s type and contains no unknown values, so it can be
+// stored in state without Terraform reporting an inconsistent result.
+//
+// `applied` may only set attributes that exist in `planned`, they must be of
+// the same type, and they can't contain unknown values. A null `applied`
+// keeps all of `planned`.
+func ObjectResolve(ctx context.Context, planned, applied Object) (Object, error) {
+	return objectResolve(ctx, planned, applied, tftypes.NewAttributePath())
+}
+
+func objectResolve(ctx context.Context, planned, applied Object, path *tftypes.AttributePath) (Object, error) {
+	if applied.Unknown {
+		return Object{}, path.NewError(ErrUnknownValue)
+	}
+	if applied.Null && !planned.Unknown {
+		resolved, err := unknownsToNulls(ctx, ObjectType{AttrTypes: planned.AttrTypes}, planned)
+		if err != nil {
+			return Object{}, path.NewError(err)
+		}
+		return resolved.(Object), nil
+	}
+
+	result := Object{
+		AttrTypes: make(map[string]attr.Type, len(planned.AttrTypes)),
+	}
+	for name, typ := range planned.AttrTypes {
+		result.AttrTypes[name] = typ
+	}
+	if applied.Null {
+		// the planned value is unknown and the apply didn't set it
+		result.Null = true
+		return result, nil
+	}
+	result.Attrs = make(map[string]attr.Value, len(planned.AttrTypes))
+
+	for _, name := range order.Keys(applied.Attrs) {
+		path := path.WithAttributeName(name)
+		typ, ok := planned.AttrTypes[name]
+		if !ok {
+			return Object{}, path.NewErrorf("can't resolve attribute, it isn't an attribute of the planned object")
+		}
+		appliedType := applied.AttrTypes[name]
+		if appliedType == nil {
+			return Object{}, path.NewErrorf("can't resolve attribute, no type information in the applied object")
+		}
+		if !typ.Equal(appliedType) {
+			return Object{}, path.NewErrorf("can't resolve attribute of type %s into attribute of type %s", appliedType.TerraformType(ctx), typ.TerraformType(ctx))
+		}
+	}
+
+	for _, name := range order.Keys(planned.AttrTypes) {
+		typ := planned.AttrTypes[name]
+		path := path.WithAttributeName(name)
+		var plannedVal attr.Value
+		if !planned.Null && !planned.Unknown {
+			plannedVal = planned.Attrs[name]
+		}
+		appliedVal := applied.Attrs[name]
+		appliedNull := appliedVal == nil
+		if !appliedNull {
+			raw, err := appliedVal.ToTerraformValue(ctx)
+			if err != nil {
+				return Object{}, path.NewError(err)
+			}
+			appliedNull = raw == nil
+		}
+		if appliedNull {
+			resolved, err := unknownsToNulls(ctx, typ, plannedVal)
+			if err != nil {
+				return Object{}, path.NewError(err)
+			}
+			result.Attrs[name] = resolved
+			continue
+		}
+		plannedObj, plannedIsObj := plannedVal.(Object)
+		appliedObj, appliedIsObj := appliedVal.(Object)
+		if plannedIsObj && appliedIsObj {
+			resolved, err := objectResolve(ctx, plannedObj, appliedObj, path)
+			if err != nil {
+				return Object{}, err
+			}
+			result.Attrs[name] = resolved
+			continue
+		}
+		if err := findUnknown(ctx, typ, appliedVal); err != nil {
+			return Object{}, path.NewErrorf("can't resolve attribute, the applied value contains unknown values")
+		}
+		result.Attrs[name] = appliedVal
+	}
+	return result, nil
+}
+
+// unknownsToNulls returns `val`, which is of the type produced by `typ`, with
+// any unknown values in it replaced by nulls. A nil `val` returns a null
+// value of the type.
+func unknownsToNulls(ctx context.Context, typ attr.Type, val attr.Value) (attr.Value, error) {
+	tfType := typ.TerraformType(ctx)
+	if val == nil {
+		return typ.ValueFromTerraform(ctx, tftypes.NewValue(tfType, nil))
+	}
+	raw, err := val.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tfVal, err := reflect.NewTerraformValue(tfType, raw)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := tftypes.Transform(tfVal, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return typ.ValueFromTerraform(ctx, resolved)
+}
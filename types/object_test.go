@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -608,6 +609,189 @@ func TestObjectAs_struct(t *testing.T) {
 	}
 }
 
+type testAnnotatedString struct {
+	String
+
+	// Annotation is not part of the Terraform value, so it would be lost
+	// if the value was converted to a tftypes.Value and back.
+	Annotation string
+}
+
+func (s testAnnotatedString) Equal(o attr.Value) bool {
+	other, ok := o.(testAnnotatedString)
+	if !ok {
+		return false
+	}
+	return s.String.Equal(other.String) && s.Annotation == other.Annotation
+}
+
+func TestObjectAs_unknownAsError(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Name String `tfsdk:"name"`
+		Tags Map    `tfsdk:"tags"`
+	}
+
+	object := Object{
+		AttrTypes: map[string]attr.Type{
+			"name": StringType,
+			"tags": MapType{ElemType: StringType},
+		},
+		Attrs: map[string]attr.Value{
+			"name": String{Value: "hello"},
+			"tags": Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"env": String{Unknown: true},
+				},
+			},
+		},
+	}
+
+	var target myStruct
+	err := object.As(context.Background(), &target, ObjectAsOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	err = object.As(context.Background(), &target, ObjectAsOptions{
+		UnknownAsError: true,
+	})
+	if !errors.Is(err, ErrUnknownValue) {
+		t.Fatalf("Expected ErrUnknownValue, got %v", err)
+	}
+	expected := `AttributeName("tags").ElementKeyString("env"): value is unknown`
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
+
+// dynamicConverter is a tftypes.ValueConverter that keeps the value it was
+// converted from.
+type dynamicConverter struct {
+	val tftypes.Value
+}
+
+func (d *dynamicConverter) FromTerraform5Value(val tftypes.Value) error {
+	d.val = val
+	return nil
+}
+
+func TestObjectAs_dynamicUnknownAsError(t *testing.T) {
+	t.Parallel()
+
+	type myStruct struct {
+		Settings Dynamic           `tfsdk:"settings"`
+		Extra    *dynamicConverter `tfsdk:"extra"`
+	}
+
+	settingsType := tftypes.Map{AttributeType: tftypes.String}
+	object := Object{
+		AttrTypes: map[string]attr.Type{
+			"settings": DynamicType,
+			"extra":    DynamicType,
+		},
+		Attrs: map[string]attr.Value{
+			"settings": NewDynamic(tftypes.NewValue(settingsType, map[string]tftypes.Value{
+				"env": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})),
+			"extra": NewDynamic(tftypes.NewValue(tftypes.Bool, true)),
+		},
+	}
+
+	var target myStruct
+	err := object.As(context.Background(), &target, ObjectAsOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if target.Extra == nil || !target.Extra.val.Equal(tftypes.NewValue(tftypes.Bool, true)) {
+		t.Errorf("Expected extra to be converted, got %+v", target.Extra)
+	}
+
+	err = object.As(context.Background(), &target, ObjectAsOptions{
+		UnknownAsError: true,
+	})
+	if !errors.Is(err, ErrUnknownValue) {
+		t.Fatalf("Expected ErrUnknownValue, got %v", err)
+	}
+	expected := `AttributeName("settings").ElementKeyString("env"): value is unknown`
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err.Error())
+	}
+}
+
+func TestObjectAs_preservesValues(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		Annotated testAnnotatedString `tfsdk:"annotated"`
+	}
+	type myStruct struct {
+		Annotated testAnnotatedString            `tfsdk:"annotated"`
+		List      []testAnnotatedString          `tfsdk:"list"`
+		Map       map[string]testAnnotatedString `tfsdk:"map"`
+		Nested    nested                         `tfsdk:"nested"`
+	}
+
+	annotated := testAnnotatedString{
+		String:     String{Value: "hello"},
+		Annotation: "world",
+	}
+	object := Object{
+		AttrTypes: map[string]attr.Type{
+			"annotated": StringType,
+			"list":      ListType{ElemType: StringType},
+			"map":       MapType{ElemType: StringType},
+			"nested": ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"annotated": StringType,
+				},
+			},
+		},
+		Attrs: map[string]attr.Value{
+			"annotated": annotated,
+			"list": List{
+				ElemType: StringType,
+				Elems:    []attr.Value{annotated},
+			},
+			"map": Map{
+				ElemType: StringType,
+				Elems: map[string]attr.Value{
+					"key": annotated,
+				},
+			},
+			"nested": Object{
+				AttrTypes: map[string]attr.Type{
+					"annotated": StringType,
+				},
+				Attrs: map[string]attr.Value{
+					"annotated": annotated,
+				},
+			},
+		},
+	}
+	var target myStruct
+	err := object.As(context.Background(), &target, ObjectAsOptions{})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	expected := myStruct{
+		Annotated: annotated,
+		List:      []testAnnotatedString{annotated},
+		Map: map[string]testAnnotatedString{
+			"key": annotated,
+		},
+		Nested: nested{
+			Annotated: annotated,
+		},
+	}
+	if diff := cmp.Diff(expected, target); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
 func TestObjectToTerraformValue(t *testing.T) {
 	t.Parallel()
 	type testCase struct {
@@ -1302,3 +1486,556 @@ func TestObjectEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectMerge(t *testing.T) {
+	t.Parallel()
+
+	nestedTypes := map[string]attr.Type{
+		"a": StringType,
+		"b": StringType,
+	}
+	attrTypes := map[string]attr.Type{
+		"name":   StringType,
+		"size":   NumberType,
+		"nested": ObjectType{AttrTypes: nestedTypes},
+	}
+
+	type testCase struct {
+		base          Object
+		overlay       Object
+		expected      Object
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"replace-non-null": {
+			base: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "old"},
+					"size":   Number{Value: big.NewFloat(1)},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+			overlay: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "new"},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "new"},
+					"size":   Number{Value: big.NewFloat(1)},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+		},
+		"nested": {
+			base: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name": String{Value: "old"},
+					"size": Number{Value: big.NewFloat(1)},
+					"nested": Object{
+						AttrTypes: nestedTypes,
+						Attrs: map[string]attr.Value{
+							"a": String{Value: "base-a"},
+							"b": String{Value: "base-b"},
+						},
+					},
+				},
+			},
+			overlay: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"nested": Object{
+						AttrTypes: nestedTypes,
+						Attrs: map[string]attr.Value{
+							"a": String{Null: true},
+							"b": String{Unknown: true},
+						},
+					},
+				},
+			},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name": String{Value: "old"},
+					"size": Number{Value: big.NewFloat(1)},
+					"nested": Object{
+						AttrTypes: nestedTypes,
+						Attrs: map[string]attr.Value{
+							"a": String{Value: "base-a"},
+							"b": String{Unknown: true},
+						},
+					},
+				},
+			},
+		},
+		"null-base": {
+			base: Object{AttrTypes: attrTypes, Null: true},
+			overlay: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "new"},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "new"},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+		},
+		"null-overlay": {
+			base: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "old"},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+			overlay: Object{AttrTypes: attrTypes, Null: true},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "old"},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+		},
+		"unknown-attribute": {
+			base: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "old"},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+			overlay: Object{
+				AttrTypes: map[string]attr.Type{
+					"nme": StringType,
+				},
+				Attrs: map[string]attr.Value{
+					"nme": String{Value: "new"},
+				},
+			},
+			expectedError: `AttributeName("nme"): can't merge attribute, it isn't an attribute of the base object`,
+		},
+		"type-mismatch": {
+			base: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "old"},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+			overlay: Object{
+				AttrTypes: map[string]attr.Type{
+					"name": NumberType,
+				},
+				Attrs: map[string]attr.Value{
+					"name": Number{Value: big.NewFloat(1)},
+				},
+			},
+			expectedError: `AttributeName("name"): can't merge attribute of type tftypes.Number into attribute of type tftypes.String`,
+		},
+		"null-base-unknown-attribute": {
+			base: Object{AttrTypes: attrTypes, Null: true},
+			overlay: Object{
+				AttrTypes: map[string]attr.Type{
+					"nme": StringType,
+				},
+				Attrs: map[string]attr.Value{
+					"nme": String{Value: "new"},
+				},
+			},
+			expectedError: `AttributeName("nme"): can't merge attribute, it isn't an attribute of the base object`,
+		},
+		"unknown-base-type-mismatch": {
+			base: Object{AttrTypes: attrTypes, Unknown: true},
+			overlay: Object{
+				AttrTypes: map[string]attr.Type{
+					"name": NumberType,
+				},
+				Attrs: map[string]attr.Value{
+					"name": Number{Value: big.NewFloat(1)},
+				},
+			},
+			expectedError: `AttributeName("name"): can't merge attribute of type tftypes.Number into attribute of type tftypes.String`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ObjectMerge(context.Background(), test.base, test.overlay)
+			if test.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error %q, got nil", test.expectedError)
+				}
+				if err.Error() != test.expectedError {
+					t.Errorf("Expected error %q, got %q", test.expectedError, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectResolve(t *testing.T) {
+	t.Parallel()
+
+	nestedTypes := map[string]attr.Type{
+		"a": StringType,
+		"b": StringType,
+	}
+	attrTypes := map[string]attr.Type{
+		"name":   StringType,
+		"id":     StringType,
+		"size":   NumberType,
+		"nested": ObjectType{AttrTypes: nestedTypes},
+	}
+	planned := Object{
+		AttrTypes: attrTypes,
+		Attrs: map[string]attr.Value{
+			"name":   String{Value: "example"},
+			"id":     String{Unknown: true},
+			"size":   Number{Unknown: true},
+			"nested": Object{AttrTypes: nestedTypes, Unknown: true},
+		},
+	}
+
+	type testCase struct {
+		planned       Object
+		applied       Object
+		expected      Object
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"partial": {
+			planned: planned,
+			applied: Object{
+				AttrTypes: map[string]attr.Type{
+					"id":     StringType,
+					"nested": ObjectType{AttrTypes: nestedTypes},
+				},
+				Attrs: map[string]attr.Value{
+					"id": String{Value: "abc123"},
+					"nested": Object{
+						AttrTypes: nestedTypes,
+						Attrs: map[string]attr.Value{
+							"a": String{Value: "applied-a"},
+						},
+					},
+				},
+			},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name": String{Value: "example"},
+					"id":   String{Value: "abc123"},
+					"size": Number{Null: true},
+					"nested": Object{
+						AttrTypes: nestedTypes,
+						Attrs: map[string]attr.Value{
+							"a": String{Value: "applied-a"},
+							"b": String{Null: true},
+						},
+					},
+				},
+			},
+		},
+		"null-applied": {
+			planned: planned,
+			applied: Object{AttrTypes: attrTypes, Null: true},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name":   String{Value: "example"},
+					"id":     String{Null: true},
+					"size":   Number{Null: true},
+					"nested": Object{AttrTypes: nestedTypes, Null: true},
+				},
+			},
+		},
+		"unknown-planned": {
+			planned:  Object{AttrTypes: attrTypes, Unknown: true},
+			applied:  Object{AttrTypes: attrTypes, Null: true},
+			expected: Object{AttrTypes: attrTypes, Null: true},
+		},
+		"unknown-applied": {
+			planned: planned,
+			applied: Object{
+				AttrTypes: map[string]attr.Type{
+					"id": StringType,
+				},
+				Attrs: map[string]attr.Value{
+					"id": String{Unknown: true},
+				},
+			},
+			expectedError: `AttributeName("id"): can't resolve attribute, the applied value contains unknown values`,
+		},
+		"unknown-attribute": {
+			planned: planned,
+			applied: Object{
+				AttrTypes: map[string]attr.Type{
+					"nme": StringType,
+				},
+				Attrs: map[string]attr.Value{
+					"nme": String{Value: "new"},
+				},
+			},
+			expectedError: `AttributeName("nme"): can't resolve attribute, it isn't an attribute of the planned object`,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ObjectResolve(context.Background(), test.planned, test.applied)
+			if test.expectedError != "" {
+				if err == nil {
+					t.Fatalf("Expected error %q, got nil", test.expectedError)
+				}
+				if err.Error() != test.expectedError {
+					t.Errorf("Expected error %q, got %q", test.expectedError, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectResolve_dynamic(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"settings": DynamicType,
+	}
+	settings := func(b interface{}) Dynamic {
+		typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+			"b": tftypes.String,
+		}}
+		return NewDynamic(tftypes.NewValue(typ, map[string]tftypes.Value{
+			"a": tftypes.NewValue(tftypes.String, "planned-a"),
+			"b": tftypes.NewValue(tftypes.String, b),
+		}))
+	}
+	planned := Object{
+		AttrTypes: attrTypes,
+		Attrs: map[string]attr.Value{
+			"settings": settings(tftypes.UnknownValue),
+		},
+	}
+
+	got, err := ObjectResolve(context.Background(), planned, Object{AttrTypes: map[string]attr.Type{}, Attrs: map[string]attr.Value{}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := Object{
+		AttrTypes: attrTypes,
+		Attrs: map[string]attr.Value{
+			"settings": settings(nil),
+		},
+	}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestObjectValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{"name": StringType, "enabled": BoolType}
+
+	type testCase struct {
+		attrs         map[string]attr.Value
+		expected      Object
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"valid": {
+			attrs: map[string]attr.Value{"name": StringValue("x"), "enabled": BoolNull()},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs:     map[string]attr.Value{"name": String{Value: "x"}, "enabled": Bool{Null: true}},
+			},
+		},
+		"missing": {
+			attrs:         map[string]attr.Value{"name": StringValue("x")},
+			expected:      ObjectUnknown(attrTypes),
+			expectedError: "can't build object, attributes not set: enabled",
+		},
+		"extra": {
+			attrs:         map[string]attr.Value{"name": StringValue("x"), "enabled": BoolValue(true), "other": StringValue("y")},
+			expected:      ObjectUnknown(attrTypes),
+			expectedError: `AttributeName("other"): can't set attribute, it isn't an attribute of the object`,
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ObjectValue(ctx, attrTypes, tc.attrs)
+			if err != nil {
+				if diff := cmp.Diff(tc.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (+wanted, -got): %s", diff)
+				}
+			} else if tc.expectedError != "" {
+				t.Errorf("Expected error %q, got none", tc.expectedError)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+
+	if !ObjectNull(attrTypes).Equal(Object{AttrTypes: attrTypes, Null: true}) {
+		t.Errorf("Expected a null object from ObjectNull")
+	}
+}
+
+func TestObjectValueMust(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{"name": StringType}
+	got := ObjectValueMust(ctx, attrTypes, map[string]attr.Value{"name": StringValue("x")})
+	expected := Object{AttrTypes: attrTypes, Attrs: map[string]attr.Value{"name": String{Value: "x"}}}
+	if !got.Equal(expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	defer func() {
+		r := recover()
+		if diff := cmp.Diff(`ObjectValueMust: can't build an Object of tftypes.Object["name":tftypes.String]: can't build object, attributes not set: name`, r); diff != "" {
+			t.Errorf("Unexpected panic (+wanted, -got): %s", diff)
+		}
+	}()
+	ObjectValueMust(ctx, attrTypes, map[string]attr.Value{})
+}
+
+func TestObjectToTerraformValue_errorOrder(t *testing.T) {
+	t.Parallel()
+
+	// both attributes are invalid, so the error must always be for the
+	// first attribute by name
+	obj := Object{
+		AttrTypes: map[string]attr.Type{"a": StringType, "b": StringType},
+		Attrs:     map[string]attr.Value{"a": Bool{Value: true}, "b": Number{Value: big.NewFloat(1)}},
+	}
+	for i := 0; i < 20; i++ {
+		_, err := obj.ToTerraformValue(context.Background())
+		if err == nil {
+			t.Fatal("Expected error, got none")
+		}
+		if diff := cmp.Diff("tftypes.NewValue can't use bool as a tftypes.String; expected types are: string or *string", err.Error()); diff != "" {
+			t.Fatalf("Unexpected error (+wanted, -got): %s", diff)
+		}
+	}
+}
+
+func TestObjectValueFrom(t *testing.T) {
+	t.Parallel()
+
+	type server struct {
+		Name    string   `tfsdk:"name"`
+		Port    *int64   `tfsdk:"port"`
+		Tags    []string `tfsdk:"tags"`
+		Enabled Bool     `tfsdk:"enabled"`
+	}
+	attrTypes := map[string]attr.Type{
+		"name":    StringType,
+		"port":    NumberType,
+		"tags":    ListType{ElemType: StringType},
+		"enabled": BoolType,
+	}
+
+	type testCase struct {
+		val           interface{}
+		expected      Object
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"struct": {
+			val: server{
+				Name:    "example",
+				Tags:    []string{"a", "b"},
+				Enabled: BoolUnknown(),
+			},
+			expected: Object{
+				AttrTypes: attrTypes,
+				Attrs: map[string]attr.Value{
+					"name": String{Value: "example"},
+					"port": Number{Null: true},
+					"tags": List{
+						ElemType: StringType,
+						Elems:    []attr.Value{String{Value: "a"}, String{Value: "b"}},
+					},
+					"enabled": Bool{Unknown: true},
+				},
+			},
+		},
+		"nil-pointer": {
+			val:      (*server)(nil),
+			expected: ObjectNull(attrTypes),
+		},
+		"extra-field": {
+			val: struct {
+				server
+				Other string `tfsdk:"other"`
+			}{},
+			expected:      ObjectUnknown(attrTypes),
+			expectedError: `AttributeName("other"): couldn't find type information for attribute in supplied attr.Type types.ObjectType`,
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ObjectValueFrom(context.Background(), attrTypes, tc.val)
+			if err != nil {
+				if diff := cmp.Diff(tc.expectedError, err.Error()); diff != "" {
+					t.Errorf("Unexpected error (+wanted, -got): %s", diff)
+				}
+			} else if tc.expectedError != "" {
+				t.Errorf("Expected error %q, got none", tc.expectedError)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
@@ -19,12 +19,28 @@ const (
 
 	// BoolType represents a boolean type.
 	BoolType
+
+	// Int64Type represents a number type that only accepts integers that
+	// fit in an int64.
+	Int64Type
+
+	// Float64Type represents a number type that only accepts numbers a
+	// float64 can hold without losing precision.
+	Float64Type
+
+	// DynamicType represents a value whose type is only known at runtime,
+	// like an arbitrary JSON document. It is tftypes.DynamicPseudoType to
+	// Terraform.
+	DynamicType
 )
 
 var (
 	_ attr.Type = StringType
 	_ attr.Type = NumberType
 	_ attr.Type = BoolType
+	_ attr.Type = Int64Type
+	_ attr.Type = Float64Type
+	_ attr.Type = DynamicType
 )
 
 func (p primitive) String() string {
@@ -35,6 +51,12 @@ func (p primitive) String() string {
 		return "types.NumberType"
 	case BoolType:
 		return "types.BoolType"
+	case Int64Type:
+		return "types.Int64Type"
+	case Float64Type:
+		return "types.Float64Type"
+	case DynamicType:
+		return "types.DynamicType"
 	default:
 		return fmt.Sprintf("unknown primitive %d", p)
 	}
@@ -48,10 +70,12 @@ func (p primitive) TerraformType(_ context.Context) tftypes.Type {
 	switch p {
 	case StringType:
 		return tftypes.String
-	case NumberType:
+	case NumberType, Int64Type, Float64Type:
 		return tftypes.Number
 	case BoolType:
 		return tftypes.Bool
+	case DynamicType:
+		return tftypes.DynamicPseudoType
 	default:
 		panic(fmt.Sprintf("unknown primitive %d", p))
 	}
@@ -68,6 +92,12 @@ func (p primitive) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 		return numberValueFromTerraform(ctx, in)
 	case BoolType:
 		return boolValueFromTerraform(ctx, in)
+	case Int64Type:
+		return int64ValueFromTerraform(ctx, in)
+	case Float64Type:
+		return float64ValueFromTerraform(ctx, in)
+	case DynamicType:
+		return dynamicValueFromTerraform(ctx, in)
 	default:
 		panic(fmt.Sprintf("unknown primitive %d", p))
 	}
@@ -81,7 +111,7 @@ func (p primitive) Equal(o attr.Type) bool {
 		return false
 	}
 	switch p {
-	case StringType, NumberType, BoolType:
+	case StringType, NumberType, BoolType, Int64Type, Float64Type, DynamicType:
 		return p == other
 	default:
 		// unrecognized types are never equal to anything.
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -12,9 +13,12 @@ func TestPrimitiveTerraformType(t *testing.T) {
 	t.Parallel()
 
 	tests := map[primitive]tftypes.Type{
-		StringType: tftypes.String,
-		NumberType: tftypes.Number,
-		BoolType:   tftypes.Bool,
+		StringType:  tftypes.String,
+		NumberType:  tftypes.Number,
+		BoolType:    tftypes.Bool,
+		Int64Type:   tftypes.Number,
+		Float64Type: tftypes.Number,
+		DynamicType: tftypes.DynamicPseudoType,
 	}
 	for prim, expected := range tests {
 		prim, expected := prim, expected
@@ -49,6 +53,18 @@ func TestPrimitiveValueFromTerraform(t *testing.T) {
 
 		testBoolValueFromTerraform(t, false)
 	})
+
+	t.Run(Int64Type.String(), func(t *testing.T) {
+		t.Parallel()
+
+		testInt64ValueFromTerraform(t, false)
+	})
+
+	t.Run(Float64Type.String(), func(t *testing.T) {
+		t.Parallel()
+
+		testFloat64ValueFromTerraform(t, false)
+	})
 }
 
 // testAttributeType is a dummy attribute type to compare against with Equal to
@@ -130,6 +146,36 @@ func TestPrimitiveEqual(t *testing.T) {
 			candidate: testAttributeType{},
 			expected:  false,
 		},
+		"number-int64": {
+			prim:      NumberType,
+			candidate: Int64Type,
+			expected:  false,
+		},
+		"int64-int64": {
+			prim:      Int64Type,
+			candidate: Int64Type,
+			expected:  true,
+		},
+		"int64-float64": {
+			prim:      Int64Type,
+			candidate: Float64Type,
+			expected:  false,
+		},
+		"float64-float64": {
+			prim:      Float64Type,
+			candidate: Float64Type,
+			expected:  true,
+		},
+		"dynamic-dynamic": {
+			prim:      DynamicType,
+			candidate: DynamicType,
+			expected:  true,
+		},
+		"dynamic-string": {
+			prim:      DynamicType,
+			candidate: StringType,
+			expected:  false,
+		},
 		"bool-string": {
 			prim:      BoolType,
 			candidate: StringType,
@@ -193,3 +239,43 @@ func TestPrimitiveEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestPrimitiveConstructors(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		got      attr.Value
+		expected attr.Value
+	}
+	tests := map[string]testCase{
+		"string-value":   {got: StringValue("hello"), expected: String{Value: "hello"}},
+		"string-null":    {got: StringNull(), expected: String{Null: true}},
+		"string-unknown": {got: StringUnknown(), expected: String{Unknown: true}},
+		"bool-value":     {got: BoolValue(true), expected: Bool{Value: true}},
+		"bool-null":      {got: BoolNull(), expected: Bool{Null: true}},
+		"bool-unknown":   {got: BoolUnknown(), expected: Bool{Unknown: true}},
+		"number-value":   {got: NumberValue(big.NewFloat(1.5)), expected: Number{Value: big.NewFloat(1.5)}},
+		"number-nil":     {got: NumberValue(nil), expected: Number{Null: true}},
+		"number-null":    {got: NumberNull(), expected: Number{Null: true}},
+		"number-unknown": {got: NumberUnknown(), expected: Number{Unknown: true}},
+		"int64-value":    {got: Int64Value(123), expected: Int64{Value: 123}},
+		"int64-null":     {got: Int64Null(), expected: Int64{Null: true}},
+		"int64-unknown":  {got: Int64Unknown(), expected: Int64{Unknown: true}},
+		"float64-value":  {got: Float64Value(1.5), expected: Float64{Value: 1.5}},
+		"float64-null":   {got: Float64Null(), expected: Float64{Null: true}},
+		"float64-unknown": {
+			got:      Float64Unknown(),
+			expected: Float64{Unknown: true},
+		},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if !tc.got.Equal(tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, tc.got)
+			}
+		})
+	}
+}
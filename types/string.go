@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -38,6 +39,21 @@ type String struct {
 	Value string
 }
 
+// StringValue returns a known String holding `value`.
+func StringValue(value string) String {
+	return String{Value: value}
+}
+
+// StringNull returns a null String.
+func StringNull() String {
+	return String{Null: true}
+}
+
+// StringUnknown returns an unknown String.
+func StringUnknown() String {
+	return String{Unknown: true}
+}
+
 // ToTerraformValue returns the data contained in the *String as a string. If
 // Unknown is true, it returns a tftypes.UnknownValue. If Null is true, it
 // returns nil.
@@ -51,6 +67,11 @@ func (s String) ToTerraformValue(_ context.Context) (interface{}, error) {
 	return s.Value, nil
 }
 
+// ToStringValue returns `s`, to implement StringValuable.
+func (s String) ToStringValue(_ context.Context) (String, error) {
+	return s, nil
+}
+
 // Equal returns true if `other` is a *String and has the same value as `s`.
 func (s String) Equal(other attr.Value) bool {
 	o, ok := other.(String)
@@ -65,3 +86,25 @@ func (s String) Equal(other attr.Value) bool {
 	}
 	return s.Value == o.Value
 }
+
+// IsNull returns true if the String is null.
+func (s String) IsNull() bool {
+	return s.Null
+}
+
+// IsUnknown returns true if the String is unknown.
+func (s String) IsUnknown() bool {
+	return s.Unknown
+}
+
+// String returns the string quoted, like "\"hello\"", or "<null>" or
+// "<unknown>".
+func (s String) String() string {
+	switch {
+	case s.Null:
+		return "<null>"
+	case s.Unknown:
+		return "<unknown>"
+	}
+	return strconv.Quote(s.Value)
+}
@@ -0,0 +1,382 @@
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The Typable and Valuable interfaces let custom types be built on the types
+// in this package, overriding only what they need. A custom type embeds the
+// type it is built on, like StringTypeBase or ListType, and a custom value
+// embeds the value it is built on, like String or List. The custom type then
+// only needs to implement ValueFromTerraform, Equal, String, and the
+// Typable's conversion method, and the custom value only Equal and Type:
+//
+//	type EmailType struct {
+//		types.StringTypeBase
+//	}
+//
+//	func (t EmailType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+//		val, err := t.StringTypeBase.ValueFromTerraform(ctx, in)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return t.ValueFromString(ctx, val.(types.String))
+//	}
+//
+//	func (t EmailType) ValueFromString(_ context.Context, in types.String) (types.StringValuable, error) {
+//		return Email{String: in}, nil
+//	}
+//
+// Code that works with any string, like a validator, can then convert both
+// String and Email values with the StringValuable interface.
+
+// BoolTypable is an attr.Type whose values are bools, which can be built
+// from a Bool.
+type BoolTypable interface {
+	attr.Type
+
+	// ValueFromBool returns the value of the type holding the same
+	// data as `in`.
+	ValueFromBool(ctx context.Context, in Bool) (BoolValuable, error)
+}
+
+// BoolValuable is an attr.Value that can be converted to a Bool.
+type BoolValuable interface {
+	attr.Value
+
+	// ToBoolValue returns the value as a Bool.
+	ToBoolValue(ctx context.Context) (Bool, error)
+}
+
+// NumberTypable is an attr.Type whose values are numbers, which can be built
+// from a Number.
+type NumberTypable interface {
+	attr.Type
+
+	// ValueFromNumber returns the value of the type holding the same
+	// data as `in`.
+	ValueFromNumber(ctx context.Context, in Number) (NumberValuable, error)
+}
+
+// NumberValuable is an attr.Value that can be converted to a Number.
+type NumberValuable interface {
+	attr.Value
+
+	// ToNumberValue returns the value as a Number.
+	ToNumberValue(ctx context.Context) (Number, error)
+}
+
+// Int64Typable is an attr.Type whose values are int64s, which can be built
+// from an Int64.
+type Int64Typable interface {
+	attr.Type
+
+	// ValueFromInt64 returns the value of the type holding the same
+	// data as `in`.
+	ValueFromInt64(ctx context.Context, in Int64) (Int64Valuable, error)
+}
+
+// Int64Valuable is an attr.Value that can be converted to an Int64.
+type Int64Valuable interface {
+	attr.Value
+
+	// ToInt64Value returns the value as an Int64.
+	ToInt64Value(ctx context.Context) (Int64, error)
+}
+
+// Float64Typable is an attr.Type whose values are float64s, which can be built
+// from a Float64.
+type Float64Typable interface {
+	attr.Type
+
+	// ValueFromFloat64 returns the value of the type holding the same
+	// data as `in`.
+	ValueFromFloat64(ctx context.Context, in Float64) (Float64Valuable, error)
+}
+
+// Float64Valuable is an attr.Value that can be converted to a Float64.
+type Float64Valuable interface {
+	attr.Value
+
+	// ToFloat64Value returns the value as a Float64.
+	ToFloat64Value(ctx context.Context) (Float64, error)
+}
+
+// StringTypable is an attr.Type whose values are strings, which can be built
+// from a String.
+type StringTypable interface {
+	attr.Type
+
+	// ValueFromString returns the value of the type holding the same
+	// data as `in`.
+	ValueFromString(ctx context.Context, in String) (StringValuable, error)
+}
+
+// StringValuable is an attr.Value that can be converted to a String.
+type StringValuable interface {
+	attr.Value
+
+	// ToStringValue returns the value as a String.
+	ToStringValue(ctx context.Context) (String, error)
+}
+
+// ListTypable is an attr.Type whose values are lists, which can be built
+// from a List.
+type ListTypable interface {
+	attr.Type
+
+	// ValueFromList returns the value of the type holding the same
+	// data as `in`.
+	ValueFromList(ctx context.Context, in List) (ListValuable, error)
+}
+
+// ListValuable is an attr.Value that can be converted to a List.
+type ListValuable interface {
+	attr.Value
+
+	// ToListValue returns the value as a List.
+	ToListValue(ctx context.Context) (List, error)
+}
+
+// MapTypable is an attr.Type whose values are maps, which can be built
+// from a Map.
+type MapTypable interface {
+	attr.Type
+
+	// ValueFromMap returns the value of the type holding the same
+	// data as `in`.
+	ValueFromMap(ctx context.Context, in Map) (MapValuable, error)
+}
+
+// MapValuable is an attr.Value that can be converted to a Map.
+type MapValuable interface {
+	attr.Value
+
+	// ToMapValue returns the value as a Map.
+	ToMapValue(ctx context.Context) (Map, error)
+}
+
+// ObjectTypable is an attr.Type whose values are objects, which can be built
+// from an Object.
+type ObjectTypable interface {
+	attr.Type
+
+	// ValueFromObject returns the value of the type holding the same
+	// data as `in`.
+	ValueFromObject(ctx context.Context, in Object) (ObjectValuable, error)
+}
+
+// ObjectValuable is an attr.Value that can be converted to an Object.
+type ObjectValuable interface {
+	attr.Value
+
+	// ToObjectValue returns the value as an Object.
+	ToObjectValue(ctx context.Context) (Object, error)
+}
+
+var (
+	_ BoolTypable     = BoolTypeBase{}
+	_ NumberTypable   = NumberTypeBase{}
+	_ Int64Typable    = Int64TypeBase{}
+	_ Float64Typable  = Float64TypeBase{}
+	_ StringTypable   = StringTypeBase{}
+	_ ListTypable     = ListType{}
+	_ MapTypable      = MapType{}
+	_ ObjectTypable   = ObjectType{}
+	_ BoolValuable    = Bool{}
+	_ NumberValuable  = Number{}
+	_ Int64Valuable   = Int64{}
+	_ Float64Valuable = Float64{}
+	_ StringValuable  = String{}
+	_ ListValuable    = List{}
+	_ MapValuable     = Map{}
+	_ ObjectValuable  = Object{}
+)
+
+// BoolTypeBase is BoolType as a struct, for embedding in custom types whose
+// values are bools. BoolType itself can't be embedded.
+type BoolTypeBase struct{}
+
+// TerraformType returns the tftypes.Type of BoolType.
+func (t BoolTypeBase) TerraformType(ctx context.Context) tftypes.Type {
+	return BoolType.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns a Bool given a tftypes.Value.
+func (t BoolTypeBase) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return BoolType.ValueFromTerraform(ctx, in)
+}
+
+// ValueFromBool returns `in`.
+func (t BoolTypeBase) ValueFromBool(_ context.Context, in Bool) (BoolValuable, error) {
+	return in, nil
+}
+
+// Equal returns true if `o` is also a BoolTypeBase. Custom types should
+// override it, so they're only equal to themselves.
+func (t BoolTypeBase) Equal(o attr.Type) bool {
+	_, ok := o.(BoolTypeBase)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t BoolTypeBase) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return BoolType.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the type.
+func (t BoolTypeBase) String() string {
+	return "types.BoolTypeBase"
+}
+
+// NumberTypeBase is NumberType as a struct, for embedding in custom types whose
+// values are numbers. NumberType itself can't be embedded.
+type NumberTypeBase struct{}
+
+// TerraformType returns the tftypes.Type of NumberType.
+func (t NumberTypeBase) TerraformType(ctx context.Context) tftypes.Type {
+	return NumberType.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns a Number given a tftypes.Value.
+func (t NumberTypeBase) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return NumberType.ValueFromTerraform(ctx, in)
+}
+
+// ValueFromNumber returns `in`.
+func (t NumberTypeBase) ValueFromNumber(_ context.Context, in Number) (NumberValuable, error) {
+	return in, nil
+}
+
+// Equal returns true if `o` is also a NumberTypeBase. Custom types should
+// override it, so they're only equal to themselves.
+func (t NumberTypeBase) Equal(o attr.Type) bool {
+	_, ok := o.(NumberTypeBase)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t NumberTypeBase) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return NumberType.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the type.
+func (t NumberTypeBase) String() string {
+	return "types.NumberTypeBase"
+}
+
+// Int64TypeBase is Int64Type as a struct, for embedding in custom types whose
+// values are int64s. Int64Type itself can't be embedded.
+type Int64TypeBase struct{}
+
+// TerraformType returns the tftypes.Type of Int64Type.
+func (t Int64TypeBase) TerraformType(ctx context.Context) tftypes.Type {
+	return Int64Type.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns an Int64 given a tftypes.Value.
+func (t Int64TypeBase) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return Int64Type.ValueFromTerraform(ctx, in)
+}
+
+// ValueFromInt64 returns `in`.
+func (t Int64TypeBase) ValueFromInt64(_ context.Context, in Int64) (Int64Valuable, error) {
+	return in, nil
+}
+
+// Equal returns true if `o` is also an Int64TypeBase. Custom types should
+// override it, so they're only equal to themselves.
+func (t Int64TypeBase) Equal(o attr.Type) bool {
+	_, ok := o.(Int64TypeBase)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t Int64TypeBase) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return Int64Type.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the type.
+func (t Int64TypeBase) String() string {
+	return "types.Int64TypeBase"
+}
+
+// Float64TypeBase is Float64Type as a struct, for embedding in custom types whose
+// values are float64s. Float64Type itself can't be embedded.
+type Float64TypeBase struct{}
+
+// TerraformType returns the tftypes.Type of Float64Type.
+func (t Float64TypeBase) TerraformType(ctx context.Context) tftypes.Type {
+	return Float64Type.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns a Float64 given a tftypes.Value.
+func (t Float64TypeBase) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return Float64Type.ValueFromTerraform(ctx, in)
+}
+
+// ValueFromFloat64 returns `in`.
+func (t Float64TypeBase) ValueFromFloat64(_ context.Context, in Float64) (Float64Valuable, error) {
+	return in, nil
+}
+
+// Equal returns true if `o` is also a Float64TypeBase. Custom types should
+// override it, so they're only equal to themselves.
+func (t Float64TypeBase) Equal(o attr.Type) bool {
+	_, ok := o.(Float64TypeBase)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t Float64TypeBase) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return Float64Type.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the type.
+func (t Float64TypeBase) String() string {
+	return "types.Float64TypeBase"
+}
+
+// StringTypeBase is StringType as a struct, for embedding in custom types whose
+// values are strings. StringType itself can't be embedded.
+type StringTypeBase struct{}
+
+// TerraformType returns the tftypes.Type of StringType.
+func (t StringTypeBase) TerraformType(ctx context.Context) tftypes.Type {
+	return StringType.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns a String given a tftypes.Value.
+func (t StringTypeBase) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return StringType.ValueFromTerraform(ctx, in)
+}
+
+// ValueFromString returns `in`.
+func (t StringTypeBase) ValueFromString(_ context.Context, in String) (StringValuable, error) {
+	return in, nil
+}
+
+// Equal returns true if `o` is also a StringTypeBase. Custom types should
+// override it, so they're only equal to themselves.
+func (t StringTypeBase) Equal(o attr.Type) bool {
+	_, ok := o.(StringTypeBase)
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type.
+func (t StringTypeBase) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return StringType.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the type.
+func (t StringTypeBase) String() string {
+	return "types.StringTypeBase"
+}
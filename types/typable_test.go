@@ -0,0 +1,174 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testEmailType struct {
+	StringTypeBase
+}
+
+func (t testEmailType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.StringTypeBase.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return t.ValueFromString(ctx, val.(String))
+}
+
+func (t testEmailType) ValueFromString(_ context.Context, in String) (StringValuable, error) {
+	return testEmail{String: in}, nil
+}
+
+func (t testEmailType) Equal(o attr.Type) bool {
+	_, ok := o.(testEmailType)
+	return ok
+}
+
+func (t testEmailType) String() string {
+	return "testEmailType"
+}
+
+type testEmail struct {
+	String
+}
+
+func (e testEmail) Equal(o attr.Value) bool {
+	other, ok := o.(testEmail)
+	if !ok {
+		return false
+	}
+	return e.String.Equal(other.String)
+}
+
+func (e testEmail) Type(_ context.Context) attr.Type {
+	return testEmailType{}
+}
+
+func TestTypableCustomType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var typ attr.Type = testEmailType{}
+	if !typ.TerraformType(ctx).Is(tftypes.String) {
+		t.Errorf("Expected Terraform type %s, got %s", tftypes.String, typ.TerraformType(ctx))
+	}
+	if typ.Equal(StringTypeBase{}) || typ.Equal(StringType) || !typ.Equal(testEmailType{}) {
+		t.Error("Expected the custom type to only be equal to itself")
+	}
+
+	val, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "user@example.com"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	expected := testEmail{String: String{Value: "user@example.com"}}
+	if diff := cmp.Diff(val, expected); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	valuable, ok := val.(StringValuable)
+	if !ok {
+		t.Fatalf("Expected %T to implement StringValuable", val)
+	}
+	str, err := valuable.ToStringValue(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(str, String{Value: "user@example.com"}); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	raw, err := val.ToTerraformValue(ctx)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if raw != "user@example.com" {
+		t.Errorf("Expected %q, got %v", "user@example.com", raw)
+	}
+}
+
+func TestTypableBuiltInTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type testCase struct {
+		typ     attr.Type
+		val     attr.Value
+		convert func(attr.Type, attr.Value) (attr.Value, attr.Value, error)
+	}
+	tests := map[string]testCase{
+		"string": {
+			typ: StringTypeBase{},
+			val: String{Value: "hello"},
+			convert: func(typ attr.Type, val attr.Value) (attr.Value, attr.Value, error) {
+				str, err := val.(StringValuable).ToStringValue(ctx)
+				if err != nil {
+					return nil, nil, err
+				}
+				res, err := typ.(StringTypable).ValueFromString(ctx, str)
+				return str, res, err
+			},
+		},
+		"int64": {
+			typ: Int64TypeBase{},
+			val: Int64{Value: 123},
+			convert: func(typ attr.Type, val attr.Value) (attr.Value, attr.Value, error) {
+				i, err := val.(Int64Valuable).ToInt64Value(ctx)
+				if err != nil {
+					return nil, nil, err
+				}
+				res, err := typ.(Int64Typable).ValueFromInt64(ctx, i)
+				return i, res, err
+			},
+		},
+		"list": {
+			typ: ListType{ElemType: StringType},
+			val: List{ElemType: StringType, Elems: []attr.Value{String{Value: "hello"}}},
+			convert: func(typ attr.Type, val attr.Value) (attr.Value, attr.Value, error) {
+				l, err := val.(ListValuable).ToListValue(ctx)
+				if err != nil {
+					return nil, nil, err
+				}
+				res, err := typ.(ListTypable).ValueFromList(ctx, l)
+				return l, res, err
+			},
+		},
+		"object": {
+			typ: ObjectType{AttrTypes: map[string]attr.Type{"a": BoolType}},
+			val: Object{AttrTypes: map[string]attr.Type{"a": BoolType}, Attrs: map[string]attr.Value{"a": Bool{Value: true}}},
+			convert: func(typ attr.Type, val attr.Value) (attr.Value, attr.Value, error) {
+				o, err := val.(ObjectValuable).ToObjectValue(ctx)
+				if err != nil {
+					return nil, nil, err
+				}
+				res, err := typ.(ObjectTypable).ValueFromObject(ctx, o)
+				return o, res, err
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			base, got, err := tc.convert(tc.typ, tc.val)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !base.Equal(tc.val) {
+				t.Errorf("Expected %s, got %s", tc.val, base)
+			}
+			if !got.Equal(tc.val) {
+				t.Errorf("Expected %s, got %s", tc.val, got)
+			}
+		})
+	}
+}
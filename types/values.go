@@ -0,0 +1,103 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/order"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NullValue returns a null value of `typ`, which can be any attr.Type,
+// including those defined outside this package. Nested types, like the
+// element type of a list, are filled in from `typ`.
+func NullValue(ctx context.Context, typ attr.Type) (attr.Value, error) {
+	return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+}
+
+// UnknownValue returns an unknown value of `typ`, which can be any
+// attr.Type, including those defined outside this package. Nested types, like
+// the element type of a list, are filled in from `typ`.
+func UnknownValue(ctx context.Context, typ attr.Type) (attr.Value, error) {
+	return typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), tftypes.UnknownValue))
+}
+
+// valueString returns the String of `v`, used to render the values nested in
+// lists, maps, and objects. attr.Values that don't implement fmt.Stringer are
+// rendered from their Terraform values when possible.
+func valueString(v attr.Value) string {
+	if v == nil {
+		return "<nil>"
+	}
+	if s, ok := v.(fmt.Stringer); ok {
+		return s.String()
+	}
+	if withType, ok := v.(attr.ValueWithType); ok && withType.Type(context.Background()) != nil {
+		raw, err := v.ToTerraformValue(context.Background())
+		if err == nil {
+			tfVal, err := reflect.NewTerraformValue(withType.Type(context.Background()).TerraformType(context.Background()), raw)
+			if err == nil {
+				return terraformValueString(tfVal)
+			}
+		}
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+// terraformValueString renders `v` the way the String methods of the values
+// in this package render values of its type.
+func terraformValueString(v tftypes.Value) string {
+	if !v.IsKnown() {
+		return "<unknown>"
+	}
+	if v.Type() == nil || v.IsNull() {
+		return "<null>"
+	}
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err == nil {
+			return strconv.Quote(s)
+		}
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err == nil {
+			return n.Text('g', -1)
+		}
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err == nil {
+			return strconv.FormatBool(b)
+		}
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var vals []tftypes.Value
+		if err := v.As(&vals); err == nil {
+			elems := make([]string, 0, len(vals))
+			for _, val := range vals {
+				elems = append(elems, terraformValueString(val))
+			}
+			return "[" + strings.Join(elems, ", ") + "]"
+		}
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var vals map[string]tftypes.Value
+		if err := v.As(&vals); err == nil {
+			_, isMap := typ.(tftypes.Map)
+			elems := make([]string, 0, len(vals))
+			for _, key := range order.Keys(vals) {
+				name := key
+				if isMap {
+					name = strconv.Quote(key)
+				}
+				elems = append(elems, name+" = "+terraformValueString(vals[key]))
+			}
+			return "{" + strings.Join(elems, ", ") + "}"
+		}
+	}
+	return v.String()
+}
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNullValue(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{"name": StringType}
+
+	type testCase struct {
+		typ      attr.Type
+		expected attr.Value
+	}
+	tests := map[string]testCase{
+		"string":  {typ: StringType, expected: StringNull()},
+		"int64":   {typ: Int64Type, expected: Int64Null()},
+		"dynamic": {typ: DynamicType, expected: DynamicNull()},
+		"list":    {typ: ListType{ElemType: StringType}, expected: ListNull(StringType)},
+		"map":     {typ: MapType{ElemType: BoolType}, expected: MapNull(BoolType)},
+		"object":  {typ: ObjectType{AttrTypes: attrTypes}, expected: ObjectNull(attrTypes)},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NullValue(context.Background(), tc.typ)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnknownValue(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{"name": StringType}
+
+	type testCase struct {
+		typ      attr.Type
+		expected attr.Value
+	}
+	tests := map[string]testCase{
+		"string":  {typ: StringType, expected: StringUnknown()},
+		"float64": {typ: Float64Type, expected: Float64Unknown()},
+		"dynamic": {typ: DynamicType, expected: DynamicUnknown()},
+		"list":    {typ: ListType{ElemType: StringType}, expected: ListUnknown(StringType)},
+		"map":     {typ: MapType{ElemType: BoolType}, expected: MapUnknown(BoolType)},
+		"object":  {typ: ObjectType{AttrTypes: attrTypes}, expected: ObjectUnknown(attrTypes)},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := UnknownValue(context.Background(), tc.typ)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestValueIsNullIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	typs := map[string]attr.Type{
+		"bool":    BoolType,
+		"number":  NumberType,
+		"int64":   Int64Type,
+		"float64": Float64Type,
+		"string":  StringType,
+		"dynamic": DynamicType,
+		"list":    ListType{ElemType: StringType},
+		"map":     MapType{ElemType: StringType},
+		"object":  ObjectType{AttrTypes: map[string]attr.Type{"name": StringType}},
+	}
+	for name, typ := range typs {
+		name, typ := name, typ
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			null, err := NullValue(ctx, typ)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if !null.IsNull() || null.IsUnknown() {
+				t.Errorf("Expected %+v to be null and known", null)
+			}
+			unknown, err := UnknownValue(ctx, typ)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if unknown.IsNull() || !unknown.IsUnknown() {
+				t.Errorf("Expected %+v to be unknown and not null", unknown)
+			}
+		})
+	}
+
+	// values holding unknown values aren't unknown themselves
+	list := ListValueMust(context.Background(), StringType, []attr.Value{StringUnknown()})
+	if list.IsNull() || list.IsUnknown() {
+		t.Errorf("Expected %+v to be known and not null", list)
+	}
+}
+
+func TestValueString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{"name": StringType, "tags": ListType{ElemType: StringType}}
+	dynamicType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"ports": tftypes.List{ElementType: tftypes.Number},
+		"env":   tftypes.Map{AttributeType: tftypes.String},
+	}}
+
+	type testCase struct {
+		val      fmt.Stringer
+		expected string
+	}
+	tests := map[string]testCase{
+		"bool":           {val: BoolValue(true), expected: "true"},
+		"number":         {val: NumberValue(big.NewFloat(1.5)), expected: "1.5"},
+		"int64":          {val: Int64Value(-42), expected: "-42"},
+		"float64":        {val: Float64Value(0.25), expected: "0.25"},
+		"string":         {val: StringValue(`say "hi"`), expected: `"say \"hi\""`},
+		"null":           {val: StringNull(), expected: "<null>"},
+		"unknown":        {val: Int64Unknown(), expected: "<unknown>"},
+		"list":           {val: ListValueMust(ctx, StringType, []attr.Value{StringValue("a"), StringUnknown()}), expected: `["a", <unknown>]`},
+		"empty-list":     {val: ListValueMust(ctx, StringType, nil), expected: "[]"},
+		"null-list":      {val: ListNull(StringType), expected: "<null>"},
+		"map":            {val: MapValueMust(ctx, Int64Type, map[string]attr.Value{"b": Int64Value(2), "a": Int64Null()}), expected: `{"a" = <null>, "b" = 2}`},
+		"unknown-object": {val: ObjectUnknown(attrTypes), expected: "<unknown>"},
+		"object": {
+			val: ObjectValueMust(ctx, attrTypes, map[string]attr.Value{
+				"name": StringValue("web"),
+				"tags": ListValueMust(ctx, StringType, []attr.Value{StringValue("x")}),
+			}),
+			expected: `{name = "web", tags = ["x"]}`,
+		},
+		"dynamic": {
+			val: NewDynamic(tftypes.NewValue(dynamicType, map[string]tftypes.Value{
+				"ports": tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Number, 80),
+				}),
+				"env": tftypes.NewValue(tftypes.Map{AttributeType: tftypes.String}, map[string]tftypes.Value{
+					"HOME": tftypes.NewValue(tftypes.String, nil),
+				}),
+			})),
+			expected: `{env = {"HOME" = <null>}, ports = [80]}`,
+		},
+		"lazy": {val: NewLazy(StringType, tftypes.NewValue(tftypes.String, "later")), expected: `"later"`},
+	}
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(tc.expected, tc.val.String()); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}